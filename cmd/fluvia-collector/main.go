@@ -0,0 +1,212 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"github.com/nttcom/fluvia/internal/pkg/version"
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+type flags struct {
+	address          string
+	port             string
+	transport        string
+	format           string
+	templateLifetime int
+	file             string
+	ieFile           string
+}
+
+func main() {
+	// Check if --version flag was passed
+	if len(os.Args) > 1 && os.Args[1] == "--version" {
+		fmt.Println("fluvia-collector " + version.Version())
+		return
+	}
+
+	// Parse flags
+	f := &flags{}
+	flag.StringVar(&f.address, "a", "", "Specify a listen address")
+	flag.StringVar(&f.port, "p", "4739", "Specify a listen port")
+	flag.StringVar(&f.transport, "t", "all", "Specify a transport (udp, tcp or all)")
+	flag.StringVar(&f.format, "o", "text", "Specify an output format (text or json)")
+	flag.IntVar(&f.templateLifetime, "l", int(ipfix.DEFAULT_TEMPLATE_LIFETIME/time.Second), "Specify a template lifetime for UDP (seconds)")
+	flag.StringVar(&f.file, "r", "", "Specify an IPFIX file to read instead of listening")
+	flag.StringVar(&f.ieFile, "i", "", "Specify a YAML file of enterprise-specific Information Elements")
+	flag.Parse()
+
+	if f.ieFile != "" {
+		if err := registry.LoadFile(f.ieFile); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	p, err := newPrinter(os.Stdout, f.format)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c := &collector{
+		printer:          p,
+		templateLifetime: time.Duration(f.templateLifetime) * time.Second,
+	}
+
+	if f.file != "" {
+		if err := c.ReadFile(f.file); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	addr := net.JoinHostPort(f.address, f.port)
+	errChan := make(chan error)
+	if f.transport == "udp" || f.transport == "all" {
+		go func() {
+			errChan <- c.ListenUDP(addr)
+		}()
+	}
+	if f.transport == "tcp" || f.transport == "all" {
+		go func() {
+			errChan <- c.ListenTCP(addr)
+		}()
+	}
+	if f.transport != "udp" && f.transport != "tcp" && f.transport != "all" {
+		log.Fatalf("unknown transport: %s", f.transport)
+	}
+
+	log.Fatal(<-errChan)
+}
+
+type collector struct {
+	printer          *printer
+	templateLifetime time.Duration
+}
+
+// ListenUDP receives IPFIX Messages over UDP (RFC7011 10.3). Each exporter
+// address is handled as its own Transport Session.
+func (c *collector) ListenUDP(addr string) error {
+	laddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return err
+	}
+	conn, err := net.ListenUDP("udp", laddr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Printf("failed to close connection: %v", err)
+		}
+	}()
+	log.Printf("Listening on udp %s", conn.LocalAddr())
+
+	sessions := make(map[string]*ipfix.Session)
+	buf := make([]uint8, 65535)
+	for {
+		n, raddr, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			return err
+		}
+
+		s, ok := sessions[raddr.String()]
+		if !ok {
+			s = ipfix.NewSession(c.templateLifetime)
+			sessions[raddr.String()] = s
+		}
+
+		m, err := s.DecodeMessage(buf[:n])
+		if err != nil {
+			log.Printf("Could not decode message from %s: %s", raddr, err)
+			continue
+		}
+		c.printer.Print(raddr.String(), m)
+	}
+}
+
+// ListenTCP receives IPFIX Messages over TCP (RFC7011 10.4). Each connection
+// is handled as its own Transport Session.
+func (c *collector) ListenTCP(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			log.Printf("failed to close listener: %v", err)
+		}
+	}()
+	log.Printf("Listening on tcp %s", ln.Addr())
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go c.handleStream(conn)
+	}
+}
+
+// ReadFile prints the IPFIX Messages of an IPFIX File (RFC5655).
+func (c *collector) ReadFile(name string) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("failed to close file %s: %v", name, err)
+		}
+	}()
+
+	r := ipfix.NewFileReader(file)
+	for {
+		m, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not read message from %s: %w", name, err)
+		}
+		c.printer.Print(name, m)
+	}
+}
+
+func (c *collector) handleStream(conn net.Conn) {
+	raddr := conn.RemoteAddr().String()
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Printf("failed to close connection: %v", err)
+		}
+	}()
+	log.Printf("Accepted connection from %s", raddr)
+
+	s := ipfix.NewSession(0)
+	for {
+		data, err := ipfix.ReadMessage(conn)
+		if err != nil {
+			if err != io.EOF {
+				log.Printf("Could not read message from %s: %s", raddr, err)
+			}
+			return
+		}
+
+		m, err := s.DecodeMessage(data)
+		if err != nil {
+			// RFC7011 10.4.1.2: the collector resets the session on malformed messages
+			log.Printf("Could not decode message from %s: %s", raddr, err)
+			return
+		}
+		c.printer.Print(raddr, m)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+// Information Elements known by the collector, which may be extended with
+// enterprise-specific ones
+var registry = ipfix.NewRegistry()
+
+// Renders the records naming the Information Elements of registry
+var formatter = ipfix.NewFormatter(registry)
+
+type recordOutput struct {
+	Exporter            string    `json:"exporter"`
+	ExportTime          time.Time `json:"exportTime"`
+	SequenceNumber      uint32    `json:"sequenceNumber"`
+	ObservationDomainID uint32    `json:"observationDomainId"`
+	SetID               uint16    `json:"setId"`
+	ipfix.FormattedRecord
+}
+
+type printer struct {
+	mu     sync.Mutex
+	w      io.Writer
+	format string
+}
+
+func newPrinter(w io.Writer, format string) (*printer, error) {
+	if format != "text" && format != "json" {
+		return nil, fmt.Errorf("unknown output format: %s", format)
+	}
+	return &printer{w: w, format: format}, nil
+}
+
+// Print writes every record of the Message in the output format.
+func (p *printer) Print(exporter string, m *ipfix.Message) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, s := range m.Sets {
+		for _, r := range s.Records {
+			out := recordOutput{
+				Exporter:            exporter,
+				ExportTime:          time.Unix(int64(m.ExportTime), 0).UTC(),
+				SequenceNumber:      m.SequenceNumber,
+				ObservationDomainID: m.ObservationDomainID,
+				SetID:               s.SetID,
+				FormattedRecord:     formatter.Record(r),
+			}
+
+			var err error
+			if p.format == "json" {
+				err = json.NewEncoder(p.w).Encode(out)
+			} else {
+				_, err = fmt.Fprint(p.w, formatText(out))
+			}
+			if err != nil {
+				log.Printf("failed to print record: %v", err)
+			}
+		}
+	}
+}
+
+func formatText(out recordOutput) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s seq=%d domain=%d set=%d %s",
+		out.ExportTime.Format(time.RFC3339), out.Exporter, out.SequenceNumber, out.ObservationDomainID, out.SetID, out.Type)
+	if out.TemplateID != 0 {
+		fmt.Fprintf(&b, " template=%d", out.TemplateID)
+	}
+	if out.ScopeFieldCount != 0 {
+		fmt.Fprintf(&b, " scope=%d", out.ScopeFieldCount)
+	}
+	b.WriteString("\n")
+
+	for _, f := range out.Fields {
+		if f.Value != nil {
+			fmt.Fprintf(&b, "    %s = %v\n", f.Name, f.Value)
+		} else {
+			fmt.Fprintf(&b, "    %s (length %d)\n", f.Name, f.Length)
+		}
+	}
+	if out.Data != "" {
+		fmt.Fprintf(&b, "    %s\n", out.Data)
+	}
+	return b.String()
+}
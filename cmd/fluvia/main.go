@@ -11,10 +11,13 @@ import (
 	"log"
 	"net"
 	"os"
+	"time"
 
+	"github.com/cilium/ebpf"
 	"github.com/nttcom/fluvia/internal/config"
 	"github.com/nttcom/fluvia/internal/pkg/version"
 	"github.com/nttcom/fluvia/pkg/client"
+	"github.com/nttcom/fluvia/pkg/netflow9"
 )
 
 type flags struct {
@@ -41,11 +44,6 @@ func main() {
 		log.Panic(err)
 	}
 
-	raddr, err := net.ResolveUDPAddr("udp", c.Ipfix.Address+":"+c.Ipfix.Port)
-	if err != nil {
-		log.Panic(err)
-	}
-
 	ingressIfName := f.ingressIfName
 	if f.ingressIfName == "" {
 		ingressIfName = c.Ipfix.IngressInterface
@@ -56,5 +54,107 @@ func main() {
 		interval = 1
 	}
 
-	client.New(ingressIfName, raddr, interval)
+	templateRefreshInterval := time.Duration(c.Ipfix.TemplateRefreshInterval) * time.Second
+	if templateRefreshInterval <= 0 {
+		templateRefreshInterval = client.DEFAULT_TEMPLATE_REFRESH_INTERVAL
+	}
+
+	statisticsInterval := time.Duration(c.Ipfix.StatisticsInterval) * time.Second
+	if statisticsInterval == 0 {
+		statisticsInterval = client.DEFAULT_STATISTICS_INTERVAL
+	} else if statisticsInterval < 0 {
+		statisticsInterval = 0
+	}
+
+	opts := client.ExporterOptions{
+		TemplateRefreshInterval: templateRefreshInterval,
+		TemplateRefreshPackets:  c.Ipfix.TemplateRefreshPackets,
+		MTU:                     c.Ipfix.MTU,
+		Transport:               c.Ipfix.Transport,
+		ReconnectInterval:       time.Duration(c.Ipfix.ReconnectInterval) * time.Second,
+		MaxReconnectInterval:    time.Duration(c.Ipfix.MaxReconnectInterval) * time.Second,
+		BufferPolicy:            c.Ipfix.BufferPolicy,
+		BufferSize:              c.Ipfix.BufferSize,
+		StatisticsInterval:      statisticsInterval,
+		RotateSize:              c.Ipfix.RotateSize,
+		RotateInterval:          time.Duration(c.Ipfix.RotateInterval) * time.Second,
+		Protocol:                c.Ipfix.Protocol,
+	}
+	if len(c.Ipfix.NetflowV9FieldTypes) > 0 {
+		opts.NetflowV9FieldTypes = make(map[netflow9.Element]uint16)
+		for _, ft := range c.Ipfix.NetflowV9FieldTypes {
+			opts.NetflowV9FieldTypes[netflow9.Element{EnterpriseNumber: ft.EnterpriseNumber, ElementID: ft.ElementID}] = ft.FieldType
+		}
+	}
+
+	if c.Ipfix.DebugLog.Path != "" {
+		f, err := os.OpenFile(c.Ipfix.DebugLog.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			log.Panic(err)
+		}
+		opts.DebugLog, err = client.NewDebugLog(f, c.Ipfix.DebugLog.Format)
+		if err != nil {
+			log.Panic(err)
+		}
+	}
+
+	cs := c.Ipfix.Collectors
+	if len(cs) == 0 {
+		cs = []config.Collector{{Address: c.Ipfix.Address, Port: c.Ipfix.Port, Path: c.Ipfix.Path}}
+	}
+
+	var collectors []client.Collector
+	for _, cc := range cs {
+		o := opts
+		if cc.Transport != "" {
+			o.Transport = cc.Transport
+		}
+		if cc.MTU > 0 {
+			o.MTU = cc.MTU
+		}
+		if cc.Protocol != "" {
+			o.Protocol = cc.Protocol
+		}
+		t := c.Ipfix.TLS
+		if cc.TLS != nil {
+			t = *cc.TLS
+		}
+		if o.Transport == client.TRANSPORT_TLS || o.Transport == client.TRANSPORT_DTLS {
+			o.TLSConfig, err = client.NewTLSConfig(t.Cert, t.Key, t.CA, t.ServerName)
+			if err != nil {
+				log.Panic(err)
+			}
+		}
+		address := net.JoinHostPort(cc.Address, cc.Port)
+		if o.Transport == client.TRANSPORT_FILE {
+			address = cc.Path
+		}
+		collectors = append(collectors, client.Collector{
+			Address: address,
+			Options: o,
+		})
+	}
+
+	dispatchOpts := client.DispatchOptions{
+		Policy:        c.Ipfix.CollectorPolicy,
+		FailbackDelay: time.Duration(c.Ipfix.FailbackDelay) * time.Second,
+	}
+
+	meterOpts := client.MeterOptions{
+		Aggregation:      c.Xdp.Aggregation,
+		ProbeTransport:   c.Xdp.ProbeTransport,
+		BufferSize:       c.Xdp.BufferSize,
+		SnapLength:       c.Xdp.SnapLength,
+		AttachMode:       c.Xdp.AttachMode,
+		VerifierLogLevel: ebpf.LogLevel(c.Xdp.VerifierLogLevel),
+		VerifierLogSize:  c.Xdp.VerifierLogSize,
+		Sampling:         c.Xdp.Sampling,
+		SamplingInterval: c.Xdp.SamplingInterval,
+		HashInitialiser:  c.Xdp.HashInitialiser,
+		HashRangeMin:     c.Xdp.HashRangeMin,
+		HashRangeMax:     c.Xdp.HashRangeMax,
+	}
+
+	clientError := client.New(ingressIfName, meterOpts, collectors, dispatchOpts, interval)
+	log.Fatalf("%s: %s", clientError.Component, clientError.Error)
 }
@@ -12,15 +12,89 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+type TLS struct {
+	Cert       string `yaml:"cert"`
+	Key        string `yaml:"key"`
+	CA         string `yaml:"ca"`
+	ServerName string `yaml:"server-name"`
+}
+
+// DebugLog mirrors the messages sent into a file
+type DebugLog struct {
+	Path   string `yaml:"path"`
+	Format string `yaml:"format"`
+}
+
+// NetflowV9FieldType remaps an enterprise-specific Information Element to a
+// NetFlow v9 field type
+type NetflowV9FieldType struct {
+	EnterpriseNumber uint32 `yaml:"enterprise-number"`
+	ElementID        uint16 `yaml:"element-id"`
+	FieldType        uint16 `yaml:"field-type"`
+}
+
+// Collector overrides the transport settings of Ipfix for one collector
+type Collector struct {
+	Address   string `yaml:"address"`
+	Port      string `yaml:"port"`
+	Path      string `yaml:"path"`
+	Transport string `yaml:"transport"`
+	TLS       *TLS   `yaml:"tls"`
+	MTU       int    `yaml:"mtu"`
+	Protocol  string `yaml:"protocol"`
+}
+
 type Ipfix struct {
-	Address          string `yaml:"address"`
-	Port             string `yaml:"port"`
-	IngressInterface string `yaml:"ingress-interface"`
-	Interval         int    `yaml:"interval"`
+	Address                 string `yaml:"address"`
+	Port                    string `yaml:"port"`
+	IngressInterface        string `yaml:"ingress-interface"`
+	Interval                int    `yaml:"interval"`
+	TemplateRefreshInterval int    `yaml:"template-refresh-interval"`
+	TemplateRefreshPackets  uint32 `yaml:"template-refresh-packets"`
+	MTU                     int    `yaml:"mtu"`
+	Transport               string `yaml:"transport"`
+	TLS                     TLS    `yaml:"tls"`
+	ReconnectInterval       int    `yaml:"reconnect-interval"`
+	MaxReconnectInterval    int    `yaml:"max-reconnect-interval"`
+	BufferPolicy            string `yaml:"buffer-policy"`
+	BufferSize              int    `yaml:"buffer-size"`
+	StatisticsInterval      int    `yaml:"statistics-interval"`
+	Path                    string `yaml:"path"`
+	RotateSize              int64  `yaml:"rotate-size"`
+	RotateInterval          int    `yaml:"rotate-interval"`
+	// Address, Port and Path are ignored if Collectors is not empty
+	Collectors      []Collector `yaml:"collectors"`
+	CollectorPolicy string      `yaml:"collector-policy"`
+	FailbackDelay   int         `yaml:"failback-delay"`
+	DebugLog        DebugLog    `yaml:"debug-log"`
+	// ipfix or netflow-v9
+	Protocol            string               `yaml:"protocol"`
+	NetflowV9FieldTypes []NetflowV9FieldType `yaml:"netflow-v9-field-types"`
+}
+
+type Xdp struct {
+	// kernel or user
+	Aggregation string `yaml:"aggregation"`
+	// auto, ringbuf or perf
+	ProbeTransport string `yaml:"probe-transport"`
+	BufferSize     int    `yaml:"buffer-size"`
+	SnapLength     int    `yaml:"snap-length"`
+	// generic or native (or driver); offload is not supported
+	AttachMode string `yaml:"attach-mode"`
+	// Bitmask of 1 (branch), 2 (instruction) and 4 (stats)
+	VerifierLogLevel uint32 `yaml:"verifier-log-level"`
+	VerifierLogSize  uint32 `yaml:"verifier-log-size"`
+	// systematic, random or hash
+	Sampling         string `yaml:"sampling"`
+	SamplingInterval uint32 `yaml:"sampling-interval"`
+	HashInitialiser  uint32 `yaml:"hash-initialiser"`
+	HashRangeMin     uint32 `yaml:"hash-range-min"`
+	HashRangeMax     uint32 `yaml:"hash-range-max"`
 }
 
 type Config struct {
 	Ipfix Ipfix `yaml:"ipfix"`
+	Xdp   Xdp   `yaml:"xdp"`
 }
 
 func ReadConfigFile(configFile string) (*Config, error) {
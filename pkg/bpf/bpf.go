@@ -8,11 +8,16 @@ package bpf
 
 import (
 	"errors"
+	"fmt"
 	"net"
+	"net/netip"
+	"os"
 
 	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/features"
 	"github.com/cilium/ebpf/link"
 	"github.com/cilium/ebpf/perf"
+	"github.com/nttcom/fluvia/internal/pkg/meter"
 )
 
 //go:generate go run github.com/cilium/ebpf/cmd/bpf2go -no-global-types -cc $BPF_CLANG -cflags $BPF_CFLAGS xdp ../../src/main.c -- -I../../src
@@ -23,55 +28,409 @@ type XdpMetaData struct {
 	SentSubsec   uint32
 }
 
+// xdp_config flags
+const (
+	XDP_CONFIG_F_AGGREGATE uint32 = 1 << 0
+	XDP_CONFIG_F_REPORT    uint32 = 1 << 1 // send the selected packets also when aggregating
+)
+
+// XdpConfig is the value of the xdp_config map
+type XdpConfig struct {
+	Flags        uint32
+	SnapLen      uint32 // bytes of a packet sent to user space, 0 for MAX_SNAP_LEN
+	BootTimeNano int64  // CLOCK_REALTIME at boot
+	// ipfix.SELECTOR_ALGORITHM_SYSTEMATIC_COUNT,
+	// ipfix.SELECTOR_ALGORITHM_UNIFORM_PROBABILISTIC or
+	// ipfix.SELECTOR_ALGORITHM_HASH_BOB, 0 to select every packet
+	SelectorAlgorithm uint32
+	SamplingInterval  uint32 // 1 in SamplingInterval packets is selected
+	// The hash selects the packets whose PacketDigest is in the range
+	HashInitialiser uint32
+	HashRangeMin    uint32
+	HashRangeMax    uint32
+	_               uint32
+}
+
+// XdpProbeKey is the key of the probe_stats map. Tag is in host byte order
+// and the segments after LastEntry are zero.
+type XdpProbeKey struct {
+	HSource      [6]uint8
+	HDest        [6]uint8
+	V6Srcaddr    [16]uint8
+	V6Dstaddr    [16]uint8
+	NextHdr      uint8
+	HdrExtLen    uint8
+	RoutingType  uint8
+	SegmentsLeft uint8
+	LastEntry    uint8
+	Flags        uint8
+	Tag          uint16
+	Segments     [meter.MAX_SEGMENTLIST_ENTRIES][16]uint8
+}
+
+// XdpProbeStats is the value of the probe_stats map, in nanoseconds
+type XdpProbeStats struct {
+	Count    uint64
+	DelayMin int64
+	DelayMax int64
+	DelaySum int64
+}
+
+const (
+	PROBE_TRANSPORT_AUTO    = "auto"    // ringbuf if the kernel supports it, perf otherwise
+	PROBE_TRANSPORT_RINGBUF = "ringbuf" // BPF_MAP_TYPE_RINGBUF, Linux 5.8 or later
+	PROBE_TRANSPORT_PERF    = "perf"    // BPF_MAP_TYPE_PERF_EVENT_ARRAY
+)
+
+const (
+	DEFAULT_RINGBUF_SIZE = 256 * 1024
+	DEFAULT_PERF_SIZE    = 4096 // of each CPU
+)
+
+// MAX_SNAP_LEN is the maximum number of bytes of a packet sent to user space,
+// as a perf event or to the ring buffer
+const MAX_SNAP_LEN = 512
+
+type XdpOptions struct {
+	Collection ebpf.CollectionOptions
+	// PROBE_TRANSPORT_AUTO by default
+	Transport string
+	// Of the ring buffer, or of the perf buffer of each CPU, in bytes.
+	// The ring buffer size is rounded up to a power of 2 pages.
+	BufferSize int
+}
+
 type Xdp struct {
-	objs *xdpObjects
-	link link.Link
+	prog    *ebpf.Program
+	perf    *ebpf.Map
+	ringbuf *ebpf.Map // nil if the probe packets are sent as perf events
+	link    link.Link
+
+	bufferSize int
+
+	// Maps of the in-kernel aggregation and sampling, nil if the object
+	// predates them
+	probeStats    *ebpf.Map
+	probeLost     *ebpf.Map
+	config        *ebpf.Map
+	samplingStats *ebpf.Map
+	samplingCount *ebpf.Map
 }
 
-func ReadXdpObjects(ops *ebpf.CollectionOptions) (*Xdp, error) {
-	obj := &xdpObjects{}
-	err := loadXdpObjects(obj, ops)
+func ReadXdpObjects(opts XdpOptions) (*Xdp, error) {
+	spec, err := loadXdp()
+	if err != nil {
+		return nil, err
+	}
+
+	ringbuf, err := useRingbuf(spec, opts.Transport)
 	if err != nil {
 		return nil, err
 	}
+	progName := "xdp_prog"
+	bufferSize := opts.BufferSize
+	if ringbuf {
+		progName = "xdp_prog_ringbuf"
+		if bufferSize <= 0 {
+			bufferSize = DEFAULT_RINGBUF_SIZE
+		}
+		bufferSize = ringbufSize(bufferSize)
+		spec.Maps["packet_probe_ringbuf"].MaxEntries = uint32(bufferSize)
+	} else {
+		if bufferSize <= 0 {
+			bufferSize = DEFAULT_PERF_SIZE
+		}
+		delete(spec.Programs, "xdp_prog_ringbuf")
+		delete(spec.Maps, "packet_probe_ringbuf")
+	}
 
-	// TODO: BPF log level remove hardcoding. yaml in config
+	coll, err := ebpf.NewCollectionWithOptions(spec, opts.Collection)
 	if err != nil {
 		return nil, err
 	}
+	// Detached objects are owned by x and not closed with coll
+	defer coll.Close()
 
-	return &Xdp{
-		objs: obj,
-	}, nil
+	x := &Xdp{
+		prog:          coll.DetachProgram(progName),
+		perf:          coll.DetachMap("packet_probe_perf"),
+		ringbuf:       coll.DetachMap("packet_probe_ringbuf"),
+		bufferSize:    bufferSize,
+		probeStats:    coll.DetachMap("probe_stats"),
+		probeLost:     coll.DetachMap("probe_lost"),
+		config:        coll.DetachMap("xdp_config"),
+		samplingStats: coll.DetachMap("sampling_stats"),
+		samplingCount: coll.DetachMap("sampling_count"),
+	}
+	if x.prog == nil || x.perf == nil {
+		err := fmt.Errorf("the XDP object has no %s program or packet_probe_perf map", progName)
+		return nil, errors.Join(err, x.Close())
+	}
+	return x, nil
 }
 
-func (x *Xdp) Attach(iface *net.Interface) error {
-	l, err := link.AttachXDP(link.XDPOptions{
-		Program:   x.objs.XdpProg,
-		Interface: iface.Index,
-		Flags:     link.XDPGenericMode,
-	})
+// useRingbuf reports whether the probe packets are sent to the ring buffer.
+func useRingbuf(spec *ebpf.CollectionSpec, transport string) (bool, error) {
+	switch transport {
+	case "", PROBE_TRANSPORT_AUTO, PROBE_TRANSPORT_RINGBUF:
+	case PROBE_TRANSPORT_PERF:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown probe transport: %s", transport)
+	}
+
+	err := features.HaveMapType(ebpf.RingBuf)
+	if _, ok := spec.Programs["xdp_prog_ringbuf"]; !ok {
+		err = fmt.Errorf("the XDP object has no xdp_prog_ringbuf program")
+	}
 	if err != nil {
-		return err
+		if transport == PROBE_TRANSPORT_RINGBUF {
+			return false, fmt.Errorf("ring buffer is not supported: %w", err)
+		}
+		return false, nil
 	}
+	return true, nil
+}
 
-	x.link = l
+// ringbufSize rounds size up to a power of 2 multiple of the page size, as
+// required by BPF_MAP_TYPE_RINGBUF.
+func ringbufSize(size int) int {
+	n := os.Getpagesize()
+	for n < size {
+		n <<= 1
+	}
+	return n
+}
 
+// Transport returns the PROBE_TRANSPORT_* the probe packets are sent with.
+func (x *Xdp) Transport() string {
+	if x.ringbuf != nil {
+		return PROBE_TRANSPORT_RINGBUF
+	}
+	return PROBE_TRANSPORT_PERF
+}
+
+// SupportsAggregation reports whether the XDP program can aggregate the
+// probe statistics in the kernel.
+func (x *Xdp) SupportsAggregation() bool {
+	return x.probeStats != nil && x.probeLost != nil && x.config != nil
+}
+
+// SupportsSampling reports whether the XDP program can sample the probe
+// packets.
+func (x *Xdp) SupportsSampling() bool {
+	return x.samplingStats != nil && x.samplingCount != nil && x.config != nil
+}
+
+// Configure sets the xdp_config map. Without it the XDP program sends every
+// probe packet as a perf event.
+func (x *Xdp) Configure(c XdpConfig) error {
+	if x.config == nil {
+		if c.Flags != 0 || c.SnapLen != 0 || c.SelectorAlgorithm != 0 {
+			return fmt.Errorf("the XDP program has no xdp_config map")
+		}
+		return nil
+	}
+	return x.config.Put(uint32(0), &c)
+}
+
+// DrainProbeStats removes the statistics aggregated in the kernel and calls
+// fn with those of each key merged over the CPUs.
+func (x *Xdp) DrainProbeStats(fn func(key XdpProbeKey, stats XdpProbeStats)) error {
+	var (
+		key    XdpProbeKey
+		values []XdpProbeStats
+		keys   []XdpProbeKey
+	)
+	iter := x.probeStats.Iterate()
+	for iter.Next(&key, &values) {
+		keys = append(keys, key)
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		err := x.probeStats.LookupAndDelete(&k, &values)
+		if errors.Is(err, ebpf.ErrNotSupported) {
+			// Before Linux 5.14, packets counted between Lookup and Delete are lost
+			if err = x.probeStats.Lookup(&k, &values); err == nil {
+				err = x.probeStats.Delete(&k)
+			}
+		}
+		if errors.Is(err, ebpf.ErrKeyNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		fn(k, MergeProbeStats(values))
+	}
 	return nil
 }
 
+// Lost returns the number of probe packets the XDP program could neither
+// aggregate nor send to the ring buffer since it was loaded.
+func (x *Xdp) Lost() (uint64, error) {
+	if x.probeLost == nil {
+		return 0, nil
+	}
+	var n uint64
+	var values []uint64
+	for i := range x.probeLost.MaxEntries() {
+		if err := x.probeLost.Lookup(i, &values); err != nil {
+			return 0, err
+		}
+		for _, v := range values {
+			n += v
+		}
+	}
+	return n, nil
+}
+
+// SamplingStats returns the number of probe packets observed and selected by
+// the sampling since the XDP program was loaded.
+func (x *Xdp) SamplingStats() (observed uint64, selected uint64, err error) {
+	if x.samplingStats == nil {
+		return 0, 0, nil
+	}
+	var values []uint64
+	if err := x.samplingStats.Lookup(uint32(0), &values); err != nil {
+		return 0, 0, err
+	}
+	for _, v := range values {
+		observed += v
+	}
+	if err := x.samplingStats.Lookup(uint32(1), &values); err != nil {
+		return 0, 0, err
+	}
+	for _, v := range values {
+		selected += v
+	}
+	return observed, selected, nil
+}
+
+// MergeProbeStats merges the per-CPU values of a probe_stats entry.
+func MergeProbeStats(values []XdpProbeStats) XdpProbeStats {
+	var s XdpProbeStats
+	for _, v := range values {
+		if v.Count == 0 {
+			continue
+		}
+		if s.Count == 0 || v.DelayMin < s.DelayMin {
+			s.DelayMin = v.DelayMin
+		}
+		if s.Count == 0 || v.DelayMax > s.DelayMax {
+			s.DelayMax = v.DelayMax
+		}
+		s.Count += v.Count
+		s.DelaySum += v.DelaySum
+	}
+	return s
+}
+
+// ProbeData returns the key as parsed by meter.Parse.
+func (k *XdpProbeKey) ProbeData() meter.ProbeData {
+	pd := meter.ProbeData{
+		H_source:     net.HardwareAddr(k.HSource[:]).String(),
+		H_dest:       net.HardwareAddr(k.HDest[:]).String(),
+		V6Srcaddr:    netip.AddrFrom16(k.V6Srcaddr).String(),
+		V6Dstaddr:    netip.AddrFrom16(k.V6Dstaddr).String(),
+		NextHdr:      k.NextHdr,
+		HdrExtLen:    k.HdrExtLen,
+		RoutingType:  k.RoutingType,
+		SegmentsLeft: k.SegmentsLeft,
+		LastEntry:    k.LastEntry,
+		Flags:        k.Flags,
+		Tag:          k.Tag,
+	}
+	for i := 0; i < len(k.Segments) && i <= int(k.LastEntry); i++ {
+		pd.Segments[i] = netip.AddrFrom16(k.Segments[i]).String()
+	}
+	return pd
+}
+
+// VerifierLog returns the log of the verifier, empty unless
+// ebpf.ProgramOptions.LogLevel is set.
+func (x *Xdp) VerifierLog() string {
+	return x.prog.VerifierLog
+}
+
+// Attach attaches the XDP program in mode, or in the next mode of
+// attachModes if the interface does not support it, and returns the mode
+// attached in.
+func (x *Xdp) Attach(iface *net.Interface, mode string) (string, error) {
+	modes, err := attachModes(mode)
+	if err != nil {
+		return "", err
+	}
+
+	errs := []error{}
+	for _, m := range modes {
+		l, err := x.attach(iface, m)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s mode: %w", m, err))
+			continue
+		}
+		x.link = l
+		return m, nil
+	}
+	return "", errors.Join(errs...)
+}
+
+// attachModes returns the modes to try in order, from the fastest.
+func attachModes(mode string) ([]string, error) {
+	switch mode {
+	case XDP_MODE_OFFLOAD:
+		// A program offloaded to the NIC cannot use the maps of the host,
+		// which the probe packets and statistics are sent through
+		return nil, fmt.Errorf("XDP attach mode %s is not supported", mode)
+	case XDP_MODE_NATIVE, XDP_MODE_DRIVER:
+		return []string{XDP_MODE_NATIVE, XDP_MODE_GENERIC}, nil
+	case "", XDP_MODE_GENERIC:
+		return []string{XDP_MODE_GENERIC}, nil
+	default:
+		return nil, fmt.Errorf("unknown XDP attach mode: %s", mode)
+	}
+}
+
+func (x *Xdp) attach(iface *net.Interface, mode string) (link.Link, error) {
+	opts := link.XDPOptions{
+		Program:   x.prog,
+		Interface: iface.Index,
+	}
+	switch mode {
+	case XDP_MODE_GENERIC:
+		opts.Flags = link.XDPGenericMode
+	case XDP_MODE_NATIVE:
+		opts.Flags = link.XDPDriverMode
+	}
+	return link.AttachXDP(opts)
+}
+
 func (x *Xdp) NewPerfReader() (*perf.Reader, error) {
-	return perf.NewReader(x.objs.PacketProbePerf, 4096)
+	return perf.NewReader(x.perf, x.bufferSize)
 }
 
 func (x *Xdp) Close() error {
 	errs := []error{}
-	if err := x.objs.Close(); err != nil {
+	if err := x.prog.Close(); err != nil {
 		errs = append(errs, err)
 	}
 
-	if err := x.link.Close(); err != nil {
-		errs = append(errs, err)
+	for _, m := range []*ebpf.Map{x.perf, x.ringbuf, x.probeStats, x.probeLost, x.config, x.samplingStats, x.samplingCount} {
+		if m == nil {
+			continue
+		}
+		if err := m.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if x.link != nil {
+		if err := x.link.Close(); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	if len(errs) > 0 {
@@ -81,6 +440,13 @@ func (x *Xdp) Close() error {
 	return nil
 }
 
+const (
+	XDP_MODE_GENERIC = "generic" // SKB mode, supported by every interface
+	XDP_MODE_NATIVE  = "native"  // in the driver
+	XDP_MODE_DRIVER  = "driver"  // same as XDP_MODE_NATIVE
+	XDP_MODE_OFFLOAD = "offload" // on the NIC, not supported
+)
+
 const (
 	XDP_ABORTED uint32 = iota
 	XDP_DROP
@@ -0,0 +1,112 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package bpf
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// HASH_INPUT_LEN is the size of struct hash_input
+const HASH_INPUT_LEN = 48
+
+const bobGoldenRatio uint32 = 0x9e3779b9
+
+// BobHash is the BOB hash function (RFC5475 A.2).
+func BobHash(k []uint8, initval uint32) uint32 {
+	a, b, c := bobGoldenRatio, bobGoldenRatio, initval
+	length := uint32(len(k))
+
+	for len(k) >= 12 {
+		a += binary.LittleEndian.Uint32(k[0:])
+		b += binary.LittleEndian.Uint32(k[4:])
+		c += binary.LittleEndian.Uint32(k[8:])
+		a, b, c = bobMix(a, b, c)
+		k = k[12:]
+	}
+
+	c += length
+	// The first octet of c is reserved for the length
+	var tail [12]uint8
+	copy(tail[:], k)
+	copy(tail[9:], tail[8:11])
+	tail[8] = 0
+	a += binary.LittleEndian.Uint32(tail[0:])
+	b += binary.LittleEndian.Uint32(tail[4:])
+	c += binary.LittleEndian.Uint32(tail[8:])
+	_, _, c = bobMix(a, b, c)
+	return c
+}
+
+func bobMix(a, b, c uint32) (uint32, uint32, uint32) {
+	a -= b
+	a -= c
+	a ^= c >> 13
+	b -= c
+	b -= a
+	b ^= a << 8
+	c -= a
+	c -= b
+	c ^= b >> 13
+	a -= b
+	a -= c
+	a ^= c >> 12
+	b -= c
+	b -= a
+	b ^= a << 16
+	c -= a
+	c -= b
+	c ^= b >> 5
+	a -= b
+	a -= c
+	a ^= c >> 3
+	b -= c
+	b -= a
+	b ^= a << 10
+	c -= a
+	c -= b
+	c ^= b >> 15
+	return a, b, c
+}
+
+// HashInput returns the fields of a probe packet hashed by the XDP program
+// for ipfix.SELECTOR_ALGORITHM_HASH_BOB, as struct hash_input. The packet
+// has to reach segments[0] of the SRH.
+func HashInput(packet []uint8, md *XdpMetaData) ([]uint8, error) {
+	const (
+		ethLen  = 14
+		ipv6Len = 40
+		hbhOff  = ethLen + ipv6Len
+	)
+	if len(packet) < hbhOff+2 {
+		return nil, fmt.Errorf("packet is too short for the hop-by-hop options header: %d", len(packet))
+	}
+	ipv6 := packet[ethLen:]
+	srhOff := hbhOff + (int(packet[hbhOff+1])+1)*8
+	if len(packet) < srhOff+8+16 {
+		return nil, fmt.Errorf("packet is too short for the SRH: %d", len(packet))
+	}
+	srh := packet[srhOff:]
+
+	in := make([]uint8, 0, HASH_INPUT_LEN)
+	in = append(in, ipv6[8:24]...) // source address
+	in = append(in, 0, ipv6[1]&0x0f, ipv6[2], ipv6[3])
+	in = binary.BigEndian.AppendUint32(in, md.SentSec)
+	in = binary.BigEndian.AppendUint32(in, md.SentSubsec)
+	in = append(in, srh[8:24]...) // segments[0]
+	in = append(in, srh[6], srh[7], srh[4], 0)
+	return in, nil
+}
+
+// PacketDigest returns the digest of a probe packet computed by the XDP
+// program for ipfix.SELECTOR_ALGORITHM_HASH_BOB.
+func PacketDigest(packet []uint8, md *XdpMetaData, initval uint32) (uint32, error) {
+	in, err := HashInput(packet, md)
+	if err != nil {
+		return 0, err
+	}
+	return BobHash(in, initval), nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package bpf
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cilium/ebpf/perf"
+	"github.com/cilium/ebpf/ringbuf"
+)
+
+// ProbeSample is a probe packet sent by the XDP program.
+type ProbeSample struct {
+	MetaData  XdpMetaData
+	PacketLen uint32  // of the packet on the wire
+	Packet    []uint8 // the first capture length bytes, reused by the next Read
+	// Samples lost before this one, whose Packet is then empty
+	LostSamples uint64
+}
+
+// ProbeReader reads the probe packets of the perf event array or of the ring
+// buffer.
+type ProbeReader interface {
+	Read(s *ProbeSample) error
+	Close() error
+}
+
+// NewProbeReader returns a ProbeReader of the transport of x.
+func (x *Xdp) NewProbeReader() (ProbeReader, error) {
+	if x.ringbuf != nil {
+		r, err := ringbuf.NewReader(x.ringbuf)
+		if err != nil {
+			return nil, err
+		}
+		return &ringbufReader{reader: r}, nil
+	}
+	r, err := x.NewPerfReader()
+	if err != nil {
+		return nil, err
+	}
+	return &perfReader{reader: r}, nil
+}
+
+type perfReader struct {
+	reader *perf.Reader
+	record perf.Record
+	header probeSampleHeader
+}
+
+// The sample is a probeSampleHeader followed by the packet, and possibly
+// padding
+func (r *perfReader) Read(s *ProbeSample) error {
+	if err := r.reader.ReadInto(&r.record); err != nil {
+		return err
+	}
+	s.LostSamples = r.record.LostSamples
+	s.Packet = nil
+	if s.LostSamples > 0 {
+		return nil
+	}
+
+	if err := decodeProbeSample(r.record.RawSample, &r.header, s); err != nil {
+		return fmt.Errorf("invalid perf sample: %w", err)
+	}
+	return nil
+}
+
+func (r *perfReader) Close() error {
+	return r.reader.Close()
+}
+
+// probeSampleHeader is struct probe_sample_header
+type probeSampleHeader struct {
+	MetaData  XdpMetaData
+	PacketLen uint32
+	CapLen    uint32
+}
+
+func decodeProbeSample(raw []uint8, h *probeSampleHeader, s *ProbeSample) error {
+	n, err := binary.Decode(raw, binary.NativeEndian, h)
+	if err != nil {
+		return err
+	}
+	data := raw[n:]
+	if int(h.CapLen) > len(data) {
+		return fmt.Errorf("cap length %d exceeds %d", h.CapLen, len(data))
+	}
+
+	s.MetaData = h.MetaData
+	s.PacketLen = h.PacketLen
+	s.Packet = data[:h.CapLen]
+	return nil
+}
+
+type ringbufReader struct {
+	reader *ringbuf.Reader
+	record ringbuf.Record
+	header probeSampleHeader
+}
+
+// The kernel counts the samples lost when the ring buffer is full, see
+// Xdp.Lost.
+func (r *ringbufReader) Read(s *ProbeSample) error {
+	if err := r.reader.ReadInto(&r.record); err != nil {
+		return err
+	}
+	s.LostSamples = 0
+	if err := decodeProbeSample(r.record.RawSample, &r.header, s); err != nil {
+		return fmt.Errorf("invalid ring buffer sample: %w", err)
+	}
+	return nil
+}
+
+func (r *ringbufReader) Close() error {
+	return r.reader.Close()
+}
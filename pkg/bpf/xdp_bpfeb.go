@@ -1,5 +1,5 @@
 // Code generated by bpf2go; DO NOT EDIT.
-//go:build arm64be || armbe || mips || mips64 || mips64p32 || ppc64 || s390 || s390x || sparc || sparc64
+//go:build mips || mips64 || ppc64 || s390x
 
 package bpf
 
@@ -12,6 +12,21 @@ import (
 	"github.com/cilium/ebpf"
 )
 
+// Names of all BPF objects in the ELF.
+//
+// Used for safe lookups in a Collection or CollectionSpec.
+const (
+	xdpMapPacketProbePerf    = "packet_probe_perf"
+	xdpMapPacketProbeRingbuf = "packet_probe_ringbuf"
+	xdpMapProbeLost          = "probe_lost"
+	xdpMapProbeStats         = "probe_stats"
+	xdpMapSamplingCount      = "sampling_count"
+	xdpMapSamplingStats      = "sampling_stats"
+	xdpMapXdpConfig          = "xdp_config"
+	xdpProgXdpProg           = "xdp_prog"
+	xdpProgXdpProgRingbuf    = "xdp_prog_ringbuf"
+)
+
 // loadXdp returns the embedded CollectionSpec for xdp.
 func loadXdp() (*ebpf.CollectionSpec, error) {
 	reader := bytes.NewReader(_XdpBytes)
@@ -32,7 +47,7 @@ func loadXdp() (*ebpf.CollectionSpec, error) {
 //	*xdpMaps
 //
 // See ebpf.CollectionSpec.LoadAndAssign documentation for details.
-func loadXdpObjects(obj interface{}, opts *ebpf.CollectionOptions) error {
+func loadXdpObjects(obj any, opts *ebpf.CollectionOptions) error {
 	spec, err := loadXdp()
 	if err != nil {
 		return err
@@ -47,20 +62,34 @@ func loadXdpObjects(obj interface{}, opts *ebpf.CollectionOptions) error {
 type xdpSpecs struct {
 	xdpProgramSpecs
 	xdpMapSpecs
+	xdpVariableSpecs
 }
 
-// xdpSpecs contains programs before they are loaded into the kernel.
+// xdpProgramSpecs contains programs before they are loaded into the kernel.
 //
 // It can be passed ebpf.CollectionSpec.Assign.
 type xdpProgramSpecs struct {
-	XdpProg *ebpf.ProgramSpec `ebpf:"xdp_prog"`
+	XdpProg        *ebpf.ProgramSpec `ebpf:"xdp_prog"`
+	XdpProgRingbuf *ebpf.ProgramSpec `ebpf:"xdp_prog_ringbuf"`
 }
 
 // xdpMapSpecs contains maps before they are loaded into the kernel.
 //
 // It can be passed ebpf.CollectionSpec.Assign.
 type xdpMapSpecs struct {
-	PacketProbePerf *ebpf.MapSpec `ebpf:"packet_probe_perf"`
+	PacketProbePerf    *ebpf.MapSpec `ebpf:"packet_probe_perf"`
+	PacketProbeRingbuf *ebpf.MapSpec `ebpf:"packet_probe_ringbuf"`
+	ProbeLost          *ebpf.MapSpec `ebpf:"probe_lost"`
+	ProbeStats         *ebpf.MapSpec `ebpf:"probe_stats"`
+	SamplingCount      *ebpf.MapSpec `ebpf:"sampling_count"`
+	SamplingStats      *ebpf.MapSpec `ebpf:"sampling_stats"`
+	XdpConfig          *ebpf.MapSpec `ebpf:"xdp_config"`
+}
+
+// xdpVariableSpecs contains global variables before they are loaded into the kernel.
+//
+// It can be passed ebpf.CollectionSpec.Assign.
+type xdpVariableSpecs struct {
 }
 
 // xdpObjects contains all objects after they have been loaded into the kernel.
@@ -69,6 +98,7 @@ type xdpMapSpecs struct {
 type xdpObjects struct {
 	xdpPrograms
 	xdpMaps
+	xdpVariables
 }
 
 func (o *xdpObjects) Close() error {
@@ -82,25 +112,45 @@ func (o *xdpObjects) Close() error {
 //
 // It can be passed to loadXdpObjects or ebpf.CollectionSpec.LoadAndAssign.
 type xdpMaps struct {
-	PacketProbePerf *ebpf.Map `ebpf:"packet_probe_perf"`
+	PacketProbePerf    *ebpf.Map `ebpf:"packet_probe_perf"`
+	PacketProbeRingbuf *ebpf.Map `ebpf:"packet_probe_ringbuf"`
+	ProbeLost          *ebpf.Map `ebpf:"probe_lost"`
+	ProbeStats         *ebpf.Map `ebpf:"probe_stats"`
+	SamplingCount      *ebpf.Map `ebpf:"sampling_count"`
+	SamplingStats      *ebpf.Map `ebpf:"sampling_stats"`
+	XdpConfig          *ebpf.Map `ebpf:"xdp_config"`
 }
 
 func (m *xdpMaps) Close() error {
 	return _XdpClose(
 		m.PacketProbePerf,
+		m.PacketProbeRingbuf,
+		m.ProbeLost,
+		m.ProbeStats,
+		m.SamplingCount,
+		m.SamplingStats,
+		m.XdpConfig,
 	)
 }
 
+// xdpVariables contains all global variables after they have been loaded into the kernel.
+//
+// It can be passed to loadXdpObjects or ebpf.CollectionSpec.LoadAndAssign.
+type xdpVariables struct {
+}
+
 // xdpPrograms contains all programs after they have been loaded into the kernel.
 //
 // It can be passed to loadXdpObjects or ebpf.CollectionSpec.LoadAndAssign.
 type xdpPrograms struct {
-	XdpProg *ebpf.Program `ebpf:"xdp_prog"`
+	XdpProg        *ebpf.Program `ebpf:"xdp_prog"`
+	XdpProgRingbuf *ebpf.Program `ebpf:"xdp_prog_ringbuf"`
 }
 
 func (p *xdpPrograms) Close() error {
 	return _XdpClose(
 		p.XdpProg,
+		p.XdpProgRingbuf,
 	)
 }
 
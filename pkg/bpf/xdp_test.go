@@ -4,16 +4,21 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"net"
 	"net/netip"
+	"os"
 	"testing"
 	"unsafe"
 
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/features"
 	"github.com/cilium/ebpf/perf"
 	"github.com/cilium/ebpf/rlimit"
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
 	"github.com/nttcom/fluvia/internal/pkg/meter"
+	"github.com/nttcom/fluvia/pkg/ipfix"
 )
 
 type testData struct {
@@ -157,7 +162,7 @@ func TestXDPProg(t *testing.T) {
 		}
 	}()
 
-	var metadata XdpMetaData
+	var header probeSampleHeader
 
 	expected := testData{
 		sentSec:    0x6538d5f6,
@@ -202,16 +207,17 @@ func TestXDPProg(t *testing.T) {
 
 	reader := bytes.NewReader(eventData.RawSample)
 
-	if err := binary.Read(reader, binary.LittleEndian, &metadata); err != nil {
+	if err := binary.Read(reader, binary.LittleEndian, &header); err != nil {
 		t.Fatal(err)
 	}
+	metadata := header.MetaData
 
-	metadataSize := unsafe.Sizeof(metadata)
-	if len(eventData.RawSample) <= int(metadataSize) {
+	headerSize := unsafe.Sizeof(header)
+	if len(eventData.RawSample) <= int(headerSize) {
 		t.Fatalf("XDP did not send raw packet")
 	}
 
-	probeData, err := meter.Parse(eventData.RawSample[metadataSize:])
+	probeData, err := meter.Parse(eventData.RawSample[headerSize:])
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -228,3 +234,442 @@ func TestXDPProg(t *testing.T) {
 		t.Errorf("actual   value: %+v\n", actual)
 	}
 }
+
+func TestReadXdpObjects(t *testing.T) {
+	if err := rlimit.RemoveMemlock(); err != nil {
+		t.Fatal(err)
+	}
+	x, err := ReadXdpObjects(XdpOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := x.Close(); err != nil {
+			t.Errorf("failed to close xdp: %v", err)
+		}
+	}()
+
+	// A stale object would silently fall back to the aggregation in user space
+	if !x.SupportsAggregation() {
+		t.Fatal("the XDP object has no aggregation maps, run make go-gen")
+	}
+
+	if err := x.Configure(XdpConfig{Flags: XDP_CONFIG_F_AGGREGATE}); err != nil {
+		t.Fatal(err)
+	}
+	for range 3 {
+		if ret, _, err := x.prog.Test(generateInput(t)); err != nil || ret != XDP_PASS {
+			t.Fatalf("got %d, %v", ret, err)
+		}
+	}
+
+	var got []meter.ProbeData
+	var count uint64
+	err = x.DrainProbeStats(func(key XdpProbeKey, stats XdpProbeStats) {
+		got = append(got, key.ProbeData())
+		count += stats.Count
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || count != 3 {
+		t.Fatalf("got %d keys and %d packets want 1 and 3", len(got), count)
+	}
+	if got[0].V6Srcaddr != "2001:db8::1" || got[0].Segments[1] != "2001:db8:dead:beef::2" {
+		t.Errorf("got %+v", got[0])
+	}
+}
+
+func TestMergeProbeStats(t *testing.T) {
+	got := MergeProbeStats([]XdpProbeStats{
+		{},
+		{Count: 2, DelayMin: 10, DelayMax: 30, DelaySum: 40},
+		{},
+		{Count: 1, DelayMin: 5, DelayMax: 5, DelaySum: 5},
+	})
+	want := XdpProbeStats{Count: 3, DelayMin: 5, DelayMax: 30, DelaySum: 45}
+	if got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+
+	// A CPU without packets does not count as a delay of 0
+	got = MergeProbeStats([]XdpProbeStats{{}, {Count: 1, DelayMin: -5, DelayMax: -5, DelaySum: -5}})
+	if got.DelayMax != -5 {
+		t.Errorf("got max %d want %d", got.DelayMax, -5)
+	}
+}
+
+func TestXdpProbeKeyProbeData(t *testing.T) {
+	key := XdpProbeKey{
+		HSource:      [6]uint8{0x02, 0x42, 0xac, 0x11, 0x00, 0x02},
+		HDest:        [6]uint8{0x02, 0x42, 0xac, 0x11, 0x00, 0x03},
+		V6Srcaddr:    netip.MustParseAddr("2001:db8::1").As16(),
+		V6Dstaddr:    netip.MustParseAddr("2001:db8::2").As16(),
+		NextHdr:      uint8(layers.IPProtocolUDP),
+		HdrExtLen:    4,
+		RoutingType:  4,
+		SegmentsLeft: 2,
+		LastEntry:    1,
+		Tag:          0x1234,
+	}
+	key.Segments[0] = netip.MustParseAddr("2001:db8:dead:beef::1").As16()
+	key.Segments[1] = netip.MustParseAddr("2001:db8:dead:beef::2").As16()
+
+	want := meter.ProbeData{
+		H_source:     "02:42:ac:11:00:02",
+		H_dest:       "02:42:ac:11:00:03",
+		V6Srcaddr:    "2001:db8::1",
+		V6Dstaddr:    "2001:db8::2",
+		NextHdr:      uint8(layers.IPProtocolUDP),
+		HdrExtLen:    4,
+		RoutingType:  4,
+		SegmentsLeft: 2,
+		LastEntry:    1,
+		Tag:          0x1234,
+		Segments: [10]string{
+			"2001:db8:dead:beef::1",
+			"2001:db8:dead:beef::2",
+		},
+	}
+	if got := key.ProbeData(); got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestProbeReader(t *testing.T) {
+	if err := rlimit.RemoveMemlock(); err != nil {
+		t.Fatal(err)
+	}
+	for _, transport := range []string{PROBE_TRANSPORT_PERF, PROBE_TRANSPORT_RINGBUF} {
+		t.Run(transport, func(t *testing.T) {
+			if transport == PROBE_TRANSPORT_RINGBUF {
+				if err := features.HaveMapType(ebpf.RingBuf); err != nil {
+					t.Skip(err)
+				}
+			}
+			x, err := ReadXdpObjects(XdpOptions{Transport: transport})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				if err := x.Close(); err != nil {
+					t.Errorf("failed to close xdp: %v", err)
+				}
+			}()
+			if x.Transport() != transport {
+				t.Fatalf("got transport %s want %s", x.Transport(), transport)
+			}
+
+			r, err := x.NewProbeReader()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				if err := r.Close(); err != nil {
+					t.Errorf("failed to close reader: %v", err)
+				}
+			}()
+
+			input := generateInput(t)
+			// Both transports send the same bytes of a packet
+			long := append(bytes.Clone(input), make([]uint8, MAX_SNAP_LEN)...)
+			for _, tc := range []struct {
+				input   []uint8
+				snapLen uint32
+				capLen  int
+			}{
+				{input, 0, len(input)},
+				{input, 60, 60},
+				{long, 0, MAX_SNAP_LEN},
+				{long, MAX_SNAP_LEN + 100, MAX_SNAP_LEN},
+			} {
+				if err := x.Configure(XdpConfig{SnapLen: tc.snapLen}); err != nil {
+					t.Fatal(err)
+				}
+				if ret, _, err := x.prog.Test(tc.input); err != nil || ret != XDP_PASS {
+					t.Fatalf("got %d, %v", ret, err)
+				}
+
+				var s ProbeSample
+				if err := r.Read(&s); err != nil {
+					t.Fatal(err)
+				}
+				if s.MetaData.SentSec != 0x6538d5f6 || s.MetaData.SentSubsec != 0x3b533d00 {
+					t.Errorf("got %+v", s.MetaData)
+				}
+				if !bytes.Equal(s.Packet, tc.input[:tc.capLen]) || s.PacketLen != uint32(len(tc.input)) {
+					t.Errorf("snap length %d: got %d bytes of length %d want %d of %d",
+						tc.snapLen, len(s.Packet), s.PacketLen, tc.capLen, len(tc.input))
+				}
+			}
+		})
+	}
+}
+
+func TestProbeTransportAuto(t *testing.T) {
+	if err := rlimit.RemoveMemlock(); err != nil {
+		t.Fatal(err)
+	}
+	x, err := ReadXdpObjects(XdpOptions{Transport: PROBE_TRANSPORT_AUTO})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := x.Close(); err != nil {
+			t.Errorf("failed to close xdp: %v", err)
+		}
+	}()
+
+	want := PROBE_TRANSPORT_RINGBUF
+	if err := features.HaveMapType(ebpf.RingBuf); err != nil {
+		want = PROBE_TRANSPORT_PERF
+	}
+	if got := x.Transport(); got != want {
+		t.Errorf("got transport %s want %s", got, want)
+	}
+}
+
+func TestRingbufSize(t *testing.T) {
+	page := os.Getpagesize()
+	for _, tc := range []struct{ size, want int }{
+		{1, page},
+		{page, page},
+		{page + 1, 2 * page},
+		{3 * page, 4 * page},
+	} {
+		if got := ringbufSize(tc.size); got != tc.want {
+			t.Errorf("ringbufSize(%d) = %d want %d", tc.size, got, tc.want)
+		}
+	}
+}
+
+func TestAttachModes(t *testing.T) {
+	for _, tc := range []struct {
+		mode string
+		want []string
+	}{
+		{"", []string{XDP_MODE_GENERIC}},
+		{XDP_MODE_DRIVER, []string{XDP_MODE_NATIVE, XDP_MODE_GENERIC}},
+	} {
+		got, err := attachModes(tc.mode)
+		if err != nil || fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Errorf("attachModes(%q) = %v, %v want %v", tc.mode, got, err, tc.want)
+		}
+	}
+	if _, err := attachModes("skb"); err == nil {
+		t.Error("got no error for an unknown mode")
+	}
+	if _, err := attachModes(XDP_MODE_OFFLOAD); err == nil {
+		t.Error("got no error for the offload mode")
+	}
+}
+
+func TestAttach(t *testing.T) {
+	if err := rlimit.RemoveMemlock(); err != nil {
+		t.Fatal(err)
+	}
+	x, err := ReadXdpObjects(XdpOptions{
+		Collection: ebpf.CollectionOptions{
+			Programs: ebpf.ProgramOptions{LogLevel: ebpf.LogLevelStats},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := x.Close(); err != nil {
+			t.Errorf("failed to close xdp: %v", err)
+		}
+	}()
+	if x.VerifierLog() == "" {
+		t.Error("got no verifier log")
+	}
+
+	lo, err := net.InterfaceByName("lo")
+	if err != nil {
+		t.Skip(err)
+	}
+	if _, err := x.Attach(lo, XDP_MODE_OFFLOAD); err == nil {
+		t.Fatal("attached in the offload mode")
+	}
+	// The loopback interface has no native XDP
+	mode, err := x.Attach(lo, XDP_MODE_NATIVE)
+	if err != nil {
+		t.Skip(err)
+	}
+	if mode != XDP_MODE_GENERIC {
+		t.Errorf("got %s mode want %s", mode, XDP_MODE_GENERIC)
+	}
+}
+
+func TestSampling(t *testing.T) {
+	if err := rlimit.RemoveMemlock(); err != nil {
+		t.Fatal(err)
+	}
+	x, err := ReadXdpObjects(XdpOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := x.Close(); err != nil {
+			t.Errorf("failed to close xdp: %v", err)
+		}
+	}()
+
+	config := XdpConfig{
+		Flags:             XDP_CONFIG_F_AGGREGATE,
+		SelectorAlgorithm: uint32(ipfix.SELECTOR_ALGORITHM_SYSTEMATIC_COUNT),
+		SamplingInterval:  5,
+	}
+	if !x.SupportsSampling() {
+		t.Fatal("the XDP object has no sampling maps, run make go-gen")
+	}
+	if err := x.Configure(config); err != nil {
+		t.Fatal(err)
+	}
+
+	for range 12 {
+		if ret, _, err := x.prog.Test(generateInput(t)); err != nil || ret != XDP_PASS {
+			t.Fatalf("got %d, %v", ret, err)
+		}
+	}
+	observed, selected, err := x.SamplingStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if observed != 12 || selected != 3 {
+		t.Errorf("got %d observed and %d selected want 12 and 3", observed, selected)
+	}
+
+	// The packets of all CPUs are counted in the single entry
+	var count struct {
+		Lock  uint32
+		Count uint32
+	}
+	if err := x.samplingCount.Lookup(uint32(0), &count); err != nil {
+		t.Fatal(err)
+	}
+	if count.Count != 2 {
+		t.Errorf("got a count of %d want 2", count.Count)
+	}
+}
+
+func TestBobHash(t *testing.T) {
+	// Computed by the reference lookup2.c of the octets 0, 1, 2, ...
+	tests := []struct {
+		length  int
+		initval uint32
+		want    uint32
+	}{
+		{0, 0, 3175731469},
+		{0, 0x12345678, 1039548841},
+		{1, 0, 1843378377},
+		{5, 0, 1679514057},
+		{11, 0, 4052338821},
+		{11, 0x12345678, 2799372215},
+		{12, 0, 2579356143},
+		{13, 0x12345678, 429533793},
+		{HASH_INPUT_LEN, 0, 3542368982},
+		{HASH_INPUT_LEN, 0x12345678, 181504213},
+	}
+	k := make([]uint8, HASH_INPUT_LEN)
+	for i := range k {
+		k[i] = uint8(i)
+	}
+	for _, tt := range tests {
+		if got := BobHash(k[:tt.length], tt.initval); got != tt.want {
+			t.Errorf("BobHash(%d octets, %#x): got %d want %d", tt.length, tt.initval, got, tt.want)
+		}
+	}
+}
+
+func TestPacketDigest(t *testing.T) {
+	input := generateInput(t)
+	md := XdpMetaData{SentSec: 0x6538d5f6, SentSubsec: 0x3b533d00}
+
+	want := []uint8{
+		0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01, // source address
+		0, 0, 0, 0, // flow label
+		0x65, 0x38, 0xd5, 0xf6, 0x3b, 0x53, 0x3d, 0x00, // sent timestamp
+		0x20, 0x01, 0x0d, 0xb8, 0xde, 0xad, 0xbe, 0xef, 0, 0, 0, 0, 0, 0, 0, 0x01, // segments[0]
+		0, 0, 1, 0, // tag, last entry
+	}
+	in, err := HashInput(input, &md)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(in, want) {
+		t.Errorf("got % x want % x", in, want)
+	}
+
+	digest, err := PacketDigest(input, &md, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest != BobHash(want, 1) {
+		t.Errorf("got %#x want %#x", digest, BobHash(want, 1))
+	}
+
+	// Snapped before segments[0]
+	if _, err := PacketDigest(input[:100], &md, 1); err == nil {
+		t.Error("got no error for a packet without segments[0]")
+	}
+}
+
+func TestHashSampling(t *testing.T) {
+	if err := rlimit.RemoveMemlock(); err != nil {
+		t.Fatal(err)
+	}
+	x, err := ReadXdpObjects(XdpOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := x.Close(); err != nil {
+			t.Errorf("failed to close xdp: %v", err)
+		}
+	}()
+	if !x.SupportsSampling() {
+		t.Fatal("the XDP object has no sampling maps, run make go-gen")
+	}
+
+	input := generateInput(t)
+	digest, err := PacketDigest(input, &XdpMetaData{SentSec: 0x6538d5f6, SentSubsec: 0x3b533d00}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The XDP program selects the packet only if it computes the same digest
+	for _, r := range [][2]uint32{{digest, digest}, {0, digest - 1}, {digest + 1, math.MaxUint32}} {
+		if r[0] > r[1] {
+			continue
+		}
+		if err := x.Configure(XdpConfig{
+			Flags:             XDP_CONFIG_F_AGGREGATE,
+			SelectorAlgorithm: uint32(ipfix.SELECTOR_ALGORITHM_HASH_BOB),
+			HashInitialiser:   1,
+			HashRangeMin:      r[0],
+			HashRangeMax:      r[1],
+		}); err != nil {
+			t.Fatal(err)
+		}
+		_, before, err := x.SamplingStats()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ret, _, err := x.prog.Test(input); err != nil || ret != XDP_PASS {
+			t.Fatalf("got %d, %v", ret, err)
+		}
+		_, after, err := x.SamplingStats()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := uint64(0)
+		if r[0] == digest {
+			want = 1
+		}
+		if after-before != want {
+			t.Errorf("range [%#x, %#x]: got %d selected want %d for digest %#x", r[0], r[1], after-before, want, digest)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package client
+
+import (
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+// batch collects data records into a single IPFIX Message. Records sharing a
+// template are packed into one data set, and the templates that need to be
+// (re-)sent are packed into one template set and one options template set
+// at the head of the message.
+type batch struct {
+	templates    []*template
+	dataSets     []ipfix.Set
+	dataSetLens  []int // unpadded length of each data set
+	dataSetIndex map[uint16]int
+	dataSetTmpls []*template // template of each data set
+	records      int
+}
+
+func newBatch() *batch {
+	return &batch{dataSetIndex: make(map[uint16]int)}
+}
+
+func (b *batch) empty() bool {
+	return b.records == 0
+}
+
+// len returns the length of the IPFIX Message built from the batch.
+func (b *batch) len() int {
+	l := 16 + templateSetsLen(b.templates) // message header
+	for _, dl := range b.dataSetLens {
+		l += padLen(dl)
+	}
+	return l
+}
+
+// lenWith returns the length of the IPFIX Message if a data record of
+// recordLen bytes described by t is added to the batch together with the
+// templates to be sent.
+func (b *batch) lenWith(t *template, recordLen int, templates []*template) int {
+	all := b.templates[:len(b.templates):len(b.templates)]
+	for _, tt := range templates {
+		if !b.hasTemplate(tt) {
+			all = append(all, tt)
+		}
+	}
+	l := 16 + templateSetsLen(all)
+
+	i, ok := b.dataSetIndex[t.TemplateID]
+	for j, dl := range b.dataSetLens {
+		if ok && i == j {
+			dl += recordLen
+		}
+		l += padLen(dl)
+	}
+	if !ok {
+		l += padLen(4 + recordLen)
+	}
+	return l
+}
+
+func (b *batch) hasTemplate(t *template) bool {
+	for _, bt := range b.templates {
+		if bt == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *batch) add(t *template, rec *ipfix.DataRecord, recordLen int, templates []*template) {
+	for _, tt := range templates {
+		if !b.hasTemplate(tt) {
+			b.templates = append(b.templates, tt)
+		}
+	}
+
+	i, ok := b.dataSetIndex[t.TemplateID]
+	if !ok {
+		i = len(b.dataSets)
+		b.dataSetIndex[t.TemplateID] = i
+		b.dataSets = append(b.dataSets, *ipfix.NewSet(t.TemplateID, nil))
+		b.dataSetLens = append(b.dataSetLens, 4) // set header
+		b.dataSetTmpls = append(b.dataSetTmpls, t)
+	}
+	b.dataSets[i].Records = append(b.dataSets[i].Records, rec)
+	b.dataSetLens[i] += recordLen
+	b.records++
+}
+
+// sets returns the sets of the IPFIX Message built from the batch.
+func (b *batch) sets() []ipfix.Set {
+	var sets []ipfix.Set
+	var records, optionsRecords []ipfix.Record
+	for _, t := range b.templates {
+		if t.isOptions() {
+			optionsRecords = append(optionsRecords, t.Record())
+		} else {
+			records = append(records, t.Record())
+		}
+	}
+	if len(records) > 0 {
+		sets = append(sets, *ipfix.NewSet(ipfix.TEMPLATE_SETS_ID, records))
+	}
+	if len(optionsRecords) > 0 {
+		sets = append(sets, *ipfix.NewSet(ipfix.OPTIONS_TEMPLATE_SETS_ID, optionsRecords))
+	}
+	return append(sets, b.dataSets...)
+}
+
+// fieldValues returns the field values of the batched data records, except
+// for the options records which are not worth buffering.
+func (b *batch) fieldValues() [][]ipfix.FieldValue {
+	var fvss [][]ipfix.FieldValue
+	for i, s := range b.dataSets {
+		if b.dataSetTmpls[i].isOptions() {
+			continue
+		}
+		for _, r := range s.Records {
+			fvss = append(fvss, r.(*ipfix.DataRecord).FieldValues)
+		}
+	}
+	return fvss
+}
+
+// templateSetsLen returns the length of the template set and the options
+// template set carrying templates.
+func templateSetsLen(templates []*template) int {
+	var templatesLen, optionsLen int
+	for _, t := range templates {
+		if t.isOptions() {
+			optionsLen += t.len()
+		} else {
+			templatesLen += t.len()
+		}
+	}
+
+	l := 0
+	if templatesLen > 0 {
+		l += padLen(4 + templatesLen)
+	}
+	if optionsLen > 0 {
+		l += padLen(4 + optionsLen)
+	}
+	return l
+}
+
+func padLen(l int) int {
+	if l%4 != 0 {
+		return l + 4 - l%4
+	}
+	return l
+}
@@ -7,36 +7,50 @@ package client
 
 import (
 	"log"
-	"net"
 	"time"
-
-	"github.com/nttcom/fluvia/pkg/ipfix"
 )
 
-func New(ingressIfName string, raddr *net.UDPAddr, interval int) ClientError {
-	ch := make(chan []ipfix.FieldValue)
+func New(ingressIfName string, meterOpts MeterOptions, collectors []Collector, dispatchOpts DispatchOptions, interval int) ClientError {
+	ch := make(chan Flow)
 	errChan := make(chan ClientError)
 
-	e := NewExporter()
-	go func() {
-		err := e.Run(raddr, ch)
-		if err != nil {
-			errChan <- ClientError{
-				Component: "exporter",
-				Error:     err,
-			}
-		}
-	}()
-
-	m := NewMeter(ingressIfName)
+	m := NewMeter(ingressIfName, meterOpts)
 	defer func() {
 		if err := m.Close(); err != nil {
 			log.Printf("failed to close meter: %v", err)
 		}
 	}()
 
+	var queues []*collectorQueue
+	for _, c := range collectors {
+		if c.Options.MeteringStatistics == nil {
+			c.Options.MeteringStatistics = m.Statistics
+		}
+		queues = append(queues, newCollectorQueue(c))
+	}
+	d, err := newDispatcher(queues, dispatchOpts)
+	if err != nil {
+		return ClientError{
+			Component: "dispatcher",
+			Error:     err,
+		}
+	}
+
+	for _, q := range queues {
+		go func() {
+			err := q.exporter.Run(q.address, q.ch)
+			if err != nil {
+				errChan <- ClientError{
+					Component: "exporter",
+					Error:     err,
+				}
+			}
+		}()
+	}
+	go d.Run(ch)
+
 	go func() {
-		err := m.Run(ch, time.Duration(interval))
+		err := m.Run(ch, meterInterval(interval))
 		if err != nil {
 			errChan <- ClientError{
 				Component: "meter",
@@ -50,3 +64,9 @@ func New(ingressIfName string, raddr *net.UDPAddr, interval int) ClientError {
 		return clientError
 	}
 }
+
+// meterInterval is the interval between the flow records of the meter, which
+// is given in seconds.
+func meterInterval(seconds int) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,20 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMeterInterval(t *testing.T) {
+	for _, tt := range []struct {
+		seconds int
+		want    time.Duration
+	}{
+		{1, time.Second},
+		{60, time.Minute},
+	} {
+		if got := meterInterval(tt.seconds); got != tt.want {
+			t.Errorf("meterInterval(%d): got %s want %s", tt.seconds, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package client
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"time"
+
+	"github.com/nttcom/fluvia/internal/pkg/meter"
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+const (
+	POLICY_ALL      = "all"      // every collector receives all flows
+	POLICY_FAILOVER = "failover" // the first healthy collector receives all flows
+	POLICY_HASH     = "hash"     // flows are spread over the collectors by their key
+)
+
+// Flows are queued for each collector, so that an exporter busy connecting
+// to its collector does not block the others.
+const DEFAULT_COLLECTOR_QUEUE_SIZE = 1024
+
+// A recovered collector must stay connected for this delay before the
+// failover policy switches back to it.
+const DEFAULT_FAILBACK_DELAY = 30 * time.Second
+
+// Interval between the logs of flows dropped on full collector queues
+const DROP_LOG_INTERVAL = 10 * time.Second
+
+// Collector is an IPFIX collector at Address, to which an Exporter with its
+// own transport, templates and sequence numbers exports flows.
+type Collector struct {
+	Address string
+	Options ExporterOptions
+}
+
+type DispatchOptions struct {
+	// POLICY_ALL, POLICY_FAILOVER or POLICY_HASH. POLICY_ALL is used if empty.
+	// With POLICY_FAILOVER, the collectors are in the order of preference.
+	Policy string
+	// DEFAULT_FAILBACK_DELAY is used if zero.
+	FailbackDelay time.Duration
+}
+
+// Flow is a set of field values exported as a data record, with the key
+// of the flow for POLICY_HASH.
+type Flow struct {
+	Key         uint64
+	FieldValues []ipfix.FieldValue
+}
+
+// flowKey returns the hash of the flow key of p.
+func flowKey(p *meter.ProbeData) uint64 {
+	h := fnv.New64a()
+	for _, s := range []string{p.H_source, p.H_dest, p.V6Srcaddr, p.V6Dstaddr} {
+		_, _ = h.Write([]byte(s))
+		_, _ = h.Write([]byte{0})
+	}
+	_, _ = h.Write([]byte{p.NextHdr, p.HdrExtLen, p.RoutingType, p.SegmentsLeft, p.LastEntry, p.Flags})
+	_ = binary.Write(h, binary.BigEndian, p.Tag)
+	for _, s := range p.Segments {
+		_, _ = h.Write([]byte(s))
+		_, _ = h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
+
+type collectorQueue struct {
+	address  string
+	exporter *Exporter
+	ch       chan []ipfix.FieldValue
+	dropped  uint64
+}
+
+func newCollectorQueue(c Collector) *collectorQueue {
+	return &collectorQueue{
+		address:  c.Address,
+		exporter: NewExporter(c.Options),
+		ch:       make(chan []ipfix.FieldValue, DEFAULT_COLLECTOR_QUEUE_SIZE),
+	}
+}
+
+// dispatcher sends the flows to the collector queues according to the policy.
+type dispatcher struct {
+	queues []*collectorQueue
+	active int // index of the collector receiving flows with POLICY_FAILOVER
+	opts   DispatchOptions
+}
+
+func newDispatcher(queues []*collectorQueue, opts DispatchOptions) (*dispatcher, error) {
+	if len(queues) == 0 {
+		return nil, fmt.Errorf("no collector is configured")
+	}
+	if opts.Policy == "" {
+		opts.Policy = POLICY_ALL
+	}
+	switch opts.Policy {
+	case POLICY_ALL, POLICY_FAILOVER, POLICY_HASH:
+	default:
+		return nil, fmt.Errorf("unknown collector policy: %s", opts.Policy)
+	}
+	if opts.FailbackDelay <= 0 {
+		opts.FailbackDelay = DEFAULT_FAILBACK_DELAY
+	}
+	return &dispatcher{queues: queues, opts: opts}, nil
+}
+
+// Run sends every flow received from flowChan to the queues selected by the
+// policy without blocking. Flows are dropped for the collectors whose queue is full.
+func (d *dispatcher) Run(flowChan chan Flow) {
+	lastLog := time.Now()
+	for f := range flowChan {
+		switch d.opts.Policy {
+		case POLICY_ALL:
+			for _, q := range d.queues {
+				q.send(f.FieldValues)
+			}
+		case POLICY_FAILOVER:
+			d.failover().send(f.FieldValues)
+		case POLICY_HASH:
+			d.hash(f.Key).send(f.FieldValues)
+		}
+
+		if time.Since(lastLog) < DROP_LOG_INTERVAL {
+			continue
+		}
+		lastLog = time.Now()
+		for _, q := range d.queues {
+			if q.dropped > 0 {
+				log.Printf("Dropped %d flows for collector %s as its queue is full", q.dropped, q.address)
+				q.dropped = 0
+			}
+		}
+	}
+}
+
+func (q *collectorQueue) send(fvs []ipfix.FieldValue) {
+	select {
+	case q.ch <- fvs:
+	default:
+		q.dropped++
+	}
+}
+
+// failover returns the active collector. It switches to the next healthy
+// collector when the active one fails, and back to a preferred collector
+// once it has stayed connected for the failback delay.
+func (d *dispatcher) failover() *collectorQueue {
+	for i, q := range d.queues {
+		if i == d.active {
+			if q.exporter.healthy() {
+				return q
+			}
+			continue
+		}
+		if !q.exporter.healthy() || (i < d.active && q.exporter.uptime() < d.opts.FailbackDelay) {
+			continue
+		}
+		log.Printf("Switching export from collector %s to %s", d.queues[d.active].address, q.address)
+		d.active = i
+		return q
+	}
+	// The records are buffered or dropped by the active exporter until a
+	// collector recovers
+	return d.queues[d.active]
+}
+
+// hash returns the collector of the flow key. The flows of a failed
+// collector are spread over the healthy ones, while the others stay.
+func (d *dispatcher) hash(key uint64) *collectorQueue {
+	q := d.queues[key%uint64(len(d.queues))]
+	if q.exporter.healthy() {
+		return q
+	}
+
+	var healthy []*collectorQueue
+	for _, q := range d.queues {
+		if q.exporter.healthy() {
+			healthy = append(healthy, q)
+		}
+	}
+	if len(healthy) == 0 {
+		return q
+	}
+	return healthy[key%uint64(len(healthy))]
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+func testQueues(n, size int) []*collectorQueue {
+	var queues []*collectorQueue
+	for i := 0; i < n; i++ {
+		queues = append(queues, &collectorQueue{
+			address:  string(rune('a' + i)),
+			exporter: NewExporter(ExporterOptions{}),
+			ch:       make(chan []ipfix.FieldValue, size),
+		})
+	}
+	return queues
+}
+
+func TestDispatchAll(t *testing.T) {
+	queues := testQueues(2, 10)
+	// The exporter of the blocked collector does not read its queue
+	blocked, active := queues[0], queues[1]
+	blocked.ch = make(chan []ipfix.FieldValue, 1)
+
+	d, err := newDispatcher(queues, DispatchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	flowChan := make(chan Flow)
+	go d.Run(flowChan)
+
+	const flows = 5
+	for i := 0; i < flows; i++ {
+		select {
+		case flowChan <- Flow{FieldValues: testFixedFlow(i)}:
+		case <-time.After(time.Second):
+			t.Fatalf("dispatch is blocked by a collector")
+		}
+	}
+
+	for i := 0; i < flows; i++ {
+		select {
+		case fvs := <-active.ch:
+			if v := fvs[0].(*ipfix.PacketDeltaCount).Val; v != uint64(i) {
+				t.Errorf("got flow %d want %d", v, i)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("got %d flows want %d", i, flows)
+		}
+	}
+	if len(blocked.ch) != 1 {
+		t.Errorf("got %d queued flows want %d", len(blocked.ch), 1)
+	}
+}
+
+func TestDispatchFailover(t *testing.T) {
+	queues := testQueues(2, 10)
+	primary, secondary := queues[0], queues[1]
+	d, err := newDispatcher(queues, DispatchOptions{Policy: POLICY_FAILOVER, FailbackDelay: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if q := d.failover(); q != primary {
+		t.Errorf("got collector %s want the primary", q.address)
+	}
+
+	primary.exporter.down.Store(true)
+	if q := d.failover(); q != secondary {
+		t.Errorf("got collector %s want the secondary on failure of the primary", q.address)
+	}
+
+	// The primary has just recovered
+	primary.exporter.down.Store(false)
+	primary.exporter.connectAt.Store(time.Now().UnixNano())
+	if q := d.failover(); q != secondary {
+		t.Errorf("got collector %s want the secondary before the failback delay", q.address)
+	}
+
+	primary.exporter.connectAt.Store(time.Now().Add(-time.Minute).UnixNano())
+	if q := d.failover(); q != primary {
+		t.Errorf("got collector %s want the primary after the failback delay", q.address)
+	}
+}
+
+func TestDispatchHash(t *testing.T) {
+	queues := testQueues(3, 10)
+	d, err := newDispatcher(queues, DispatchOptions{Policy: POLICY_HASH})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for key := uint64(0); key < 6; key++ {
+		if q := d.hash(key); q != queues[key%3] {
+			t.Errorf("got collector %s want %s for key %d", q.address, queues[key%3].address, key)
+		}
+	}
+
+	// Flows of the failed collector move, and the others stay
+	queues[1].exporter.down.Store(true)
+	for key := uint64(0); key < 6; key++ {
+		q := d.hash(key)
+		if q == queues[1] {
+			t.Errorf("got failed collector for key %d", key)
+		}
+		if key%3 != 1 && q != queues[key%3] {
+			t.Errorf("got collector %s want %s for key %d", q.address, queues[key%3].address, key)
+		}
+	}
+}
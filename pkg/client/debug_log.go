@@ -0,0 +1,67 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+const (
+	DEBUG_LOG_FORMAT_TEXT = "text" // text tree of ipfix.Formatter
+	DEBUG_LOG_FORMAT_JSON = "json" // a JSON object per line
+)
+
+// DebugLog mirrors the messages sent by Exporters, rendered by
+// ipfix.Formatter. It may be shared by the Exporters of several collectors.
+type DebugLog struct {
+	mu        sync.Mutex
+	w         io.Writer
+	format    string
+	formatter *ipfix.Formatter
+}
+
+// debugLogMessage is a message in DEBUG_LOG_FORMAT_JSON
+type debugLogMessage struct {
+	Collector string `json:"collector"`
+	ipfix.FormattedMessage
+}
+
+// NewDebugLog returns a DebugLog writing to w in DEBUG_LOG_FORMAT_TEXT or
+// DEBUG_LOG_FORMAT_JSON, which is DEBUG_LOG_FORMAT_TEXT if empty.
+func NewDebugLog(w io.Writer, format string) (*DebugLog, error) {
+	switch format {
+	case "":
+		format = DEBUG_LOG_FORMAT_TEXT
+	case DEBUG_LOG_FORMAT_TEXT, DEBUG_LOG_FORMAT_JSON:
+	default:
+		return nil, fmt.Errorf("unknown debug log format: %s", format)
+	}
+	return &DebugLog{w: w, format: format, formatter: ipfix.NewFormatter(nil)}, nil
+}
+
+// Log writes a message sent to collector.
+func (l *DebugLog) Log(collector string, m *ipfix.Message) {
+	out := l.formatter.Message(m)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var err error
+	if l.format == DEBUG_LOG_FORMAT_JSON {
+		err = json.NewEncoder(l.w).Encode(debugLogMessage{Collector: collector, FormattedMessage: out})
+	} else {
+		_, err = fmt.Fprintf(l.w, "Collector: %s\n%s", collector, out.Text())
+	}
+	if err != nil {
+		log.Printf("Could not write debug log: %s", err)
+	}
+}
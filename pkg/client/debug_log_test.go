@@ -0,0 +1,49 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+func TestDebugLog(t *testing.T) {
+	var buf bytes.Buffer
+	l, err := NewDebugLog(&buf, DEBUG_LOG_FORMAT_JSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := NewExporter(ExporterOptions{DebugLog: l})
+	e.conn = discardConn{}
+	e.address = "192.0.2.1:4739"
+	e.maxMessageLen = ipfix.MAX_MESSAGE_LENGTH
+	e.add(testFixedFlow(1))
+	e.flush()
+
+	var got struct {
+		Collector  string `json:"collector"`
+		ExportTime string `json:"exportTime"`
+		Sets       []struct {
+			Records []ipfix.FormattedRecord `json:"records"`
+		} `json:"sets"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Collector != e.address || strings.HasPrefix(got.ExportTime, "1970") {
+		t.Errorf("got collector %s export time %s", got.Collector, got.ExportTime)
+	}
+	if len(got.Sets) != 2 || got.Sets[1].Records[0].Type != "data" {
+		t.Fatalf("got %+v want a template set and a data set", got.Sets)
+	}
+	if f := got.Sets[1].Records[0].Fields[0]; f.Name != "packetDeltaCount" || f.Value != float64(1) {
+		t.Errorf("got %+v", f)
+	}
+
+	if _, err := NewDebugLog(&buf, "xml"); err == nil {
+		t.Error("got no error for an unknown format")
+	}
+}
@@ -6,74 +6,820 @@
 package client
 
 import (
+	"crypto/tls"
+	"fmt"
 	"log"
 	"net"
-	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/nttcom/fluvia/pkg/ipfix"
+	"github.com/nttcom/fluvia/pkg/netflow9"
 )
 
 const OBSERVATION_ID uint32 = 61166
 
+// SELECTOR_ID identifies the sampling of the probe packets
+const SELECTOR_ID uint64 = 1
+
+const (
+	TRANSPORT_UDP  = "udp"
+	TRANSPORT_TCP  = "tcp"  // RFC7011 10.4
+	TRANSPORT_TLS  = "tls"  // TLS over TCP, RFC7011 11.
+	TRANSPORT_DTLS = "dtls" // DTLS over UDP, RFC7011 11.
+	TRANSPORT_FILE = "file" // IPFIX File, RFC5655
+
+	BUFFER_POLICY_DROP   = "drop"   // drop records while disconnected
+	BUFFER_POLICY_BUFFER = "buffer" // buffer records while disconnected
+
+	PROTOCOL_IPFIX      = "ipfix"
+	PROTOCOL_NETFLOW_V9 = "netflow-v9" // RFC3954, over TRANSPORT_UDP only
+)
+
+const (
+	DEFAULT_TEMPLATE_REFRESH_INTERVAL = 600 * time.Second
+	DEFAULT_MTU                       = 1500
+	DEFAULT_FLUSH_INTERVAL            = 100 * time.Millisecond
+	DEFAULT_RECONNECT_INTERVAL        = 1 * time.Second
+	DEFAULT_MAX_RECONNECT_INTERVAL    = 60 * time.Second
+	DEFAULT_BUFFER_SIZE               = 4096
+	DEFAULT_DIAL_TIMEOUT              = 5 * time.Second
+	DEFAULT_WRITE_TIMEOUT             = 5 * time.Second
+	DEFAULT_STATISTICS_INTERVAL       = 60 * time.Second
+)
+
+type ExporterOptions struct {
+	// TRANSPORT_UDP, TRANSPORT_TCP, TRANSPORT_TLS, TRANSPORT_DTLS or
+	// TRANSPORT_FILE. TRANSPORT_UDP is used if empty. With TRANSPORT_FILE,
+	// the address is the path of the files. See ipfix.NewFileWriter.
+	Transport string
+	// Certificates, root CAs and server name for TRANSPORT_TLS and
+	// TRANSPORT_DTLS. See NewTLSConfig.
+	TLSConfig *tls.Config
+	// Templates are re-sent over UDP once this interval has passed since
+	// they were last sent (RFC7011 8.4). Zero disables the time-based refresh.
+	TemplateRefreshInterval time.Duration
+	// Templates are re-sent over UDP once this number of messages has been
+	// sent since they were last sent (RFC7011 8.4). Zero disables the
+	// packet-based refresh.
+	TemplateRefreshPackets uint32
+	// Data records are packed into messages that fit in this path MTU
+	// together with the IP and UDP headers. DEFAULT_MTU is used if zero.
+	MTU int
+	// Data records are sent at the latest this interval after they were
+	// received. DEFAULT_FLUSH_INTERVAL is used if zero.
+	FlushInterval time.Duration
+	// The interval before reconnecting to the collector doubles on every
+	// failure, from ReconnectInterval up to MaxReconnectInterval.
+	// DEFAULT_RECONNECT_INTERVAL and DEFAULT_MAX_RECONNECT_INTERVAL are used if zero.
+	ReconnectInterval    time.Duration
+	MaxReconnectInterval time.Duration
+	// BUFFER_POLICY_DROP or BUFFER_POLICY_BUFFER. BUFFER_POLICY_DROP is used if empty.
+	BufferPolicy string
+	// The maximum number of records buffered while disconnected.
+	// DEFAULT_BUFFER_SIZE is used if zero.
+	BufferSize int
+	// Options records of the process statistics (RFC7011 4.) are sent at
+	// this interval. Zero disables them.
+	StatisticsInterval time.Duration
+	// Source of the Metering Process Statistics and the Metering Process
+	// Reliability Statistics, which are not sent if nil.
+	MeteringStatistics func() MeteringStatistics
+	// With TRANSPORT_FILE, the file is rotated once it exceeds RotateSize
+	// bytes or RotateInterval. Zero disables each of them.
+	RotateSize     int64
+	RotateInterval time.Duration
+	// The messages sent are mirrored into DebugLog unless nil.
+	DebugLog *DebugLog
+	// PROTOCOL_IPFIX or PROTOCOL_NETFLOW_V9. PROTOCOL_IPFIX is used if empty.
+	// With PROTOCOL_NETFLOW_V9, the fields it cannot carry are dropped from
+	// the records: variable-length fields, including structured data, and
+	// enterprise-specific fields not in NetflowV9FieldTypes.
+	Protocol            string
+	NetflowV9FieldTypes map[netflow9.Element]uint16
+}
+
+type template struct {
+	*ipfix.Template
+	sent     bool
+	lastSent time.Time
+	sentAt   uint64 // messageCount when the template was last sent
+}
+
 type Exporter struct {
-	flowSeq    uint32
-	tempRecSeq uint16
+	flowSeq        uint32
+	tempRecSeq     uint16
+	messageCount   uint64
+	droppedRecords uint64
+	firstDropped   time.Time
+	lastDropped    time.Time
+	exportedRecs   uint64
+	exportedOctets uint64
+	templates      map[string]*template // keyed by the layout of field specifiers
+	templateIDs    map[uint16]string
+	templateKey    []uint8     // buffer of the keys of templates
+	withdrawals    []*template // templates to be withdrawn before their ID is reused
+	batch          *batch
+	buffer         [][]ipfix.FieldValue
+	maxMessageLen  int
+	address        string
+	conn           net.Conn   // nil while disconnected
+	connErr        chan error // receives the error closing conn
+	reconnectDelay time.Duration
+	reconnectTimer *time.Timer
+	opts           ExporterOptions
+	netflowV9      *netflow9.Encoder             // nil unless PROTOCOL_NETFLOW_V9
+	unsupported    map[ipfix.FieldSpecifier]bool // fields logged as dropped for NetFlow v9
+	// Health of the collector, read by the dispatcher across goroutines
+	down      atomic.Bool  // the collector failed and is not reconnected yet
+	connectAt atomic.Int64 // UnixNano of the last connection, 0 while disconnected
 }
 
-func NewExporter() *Exporter {
+func NewExporter(opts ExporterOptions) *Exporter {
+	if opts.Transport == "" {
+		opts.Transport = TRANSPORT_UDP
+	}
+	if opts.MTU <= 0 {
+		opts.MTU = DEFAULT_MTU
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = DEFAULT_FLUSH_INTERVAL
+	}
+	if opts.ReconnectInterval <= 0 {
+		opts.ReconnectInterval = DEFAULT_RECONNECT_INTERVAL
+	}
+	if opts.MaxReconnectInterval <= 0 {
+		opts.MaxReconnectInterval = DEFAULT_MAX_RECONNECT_INTERVAL
+	}
+	if opts.BufferPolicy == "" {
+		opts.BufferPolicy = BUFFER_POLICY_DROP
+	}
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = DEFAULT_BUFFER_SIZE
+	}
+	if opts.Protocol == "" {
+		opts.Protocol = PROTOCOL_IPFIX
+	}
+
 	e := &Exporter{
-		flowSeq:    1,
-		tempRecSeq: 256,
+		flowSeq:        1,
+		tempRecSeq:     ipfix.MIN_DATA_SETS_ID,
+		templates:      make(map[string]*template),
+		templateIDs:    make(map[uint16]string),
+		batch:          newBatch(),
+		reconnectDelay: opts.ReconnectInterval,
+		reconnectTimer: time.NewTimer(0),
+		opts:           opts,
+	}
+	if opts.Protocol == PROTOCOL_NETFLOW_V9 {
+		e.netflowV9 = netflow9.NewEncoder()
+		for el, fieldType := range opts.NetflowV9FieldTypes {
+			e.netflowV9.Remap(el, fieldType)
+		}
+		e.unsupported = make(map[ipfix.FieldSpecifier]bool)
 	}
 	return e
 }
 
-func (e *Exporter) Run(raddr *net.UDPAddr, flowChan chan []ipfix.FieldValue) error {
-	conn, err := net.DialUDP("udp", nil, raddr)
-	if err != nil {
-		return err
+// Run exports the flows received from flowChan to the collector at address.
+// The collector is reconnected with exponential back-off whenever the
+// connection fails, and flows are buffered or dropped according to the
+// buffer policy in the meantime.
+func (e *Exporter) Run(address string, flowChan chan []ipfix.FieldValue) error {
+	switch e.opts.Transport {
+	case TRANSPORT_UDP, TRANSPORT_TCP, TRANSPORT_TLS, TRANSPORT_DTLS, TRANSPORT_FILE:
+	default:
+		return fmt.Errorf("unknown transport: %s", e.opts.Transport)
+	}
+	if e.opts.BufferPolicy != BUFFER_POLICY_DROP && e.opts.BufferPolicy != BUFFER_POLICY_BUFFER {
+		return fmt.Errorf("unknown buffer policy: %s", e.opts.BufferPolicy)
 	}
-	defer func() {
-		if err := conn.Close(); err != nil {
-			log.Printf("failed to close connection: %v", err)
+	switch e.opts.Protocol {
+	case PROTOCOL_IPFIX:
+	case PROTOCOL_NETFLOW_V9:
+		// Export Packets have no length to be framed over a stream
+		if e.opts.Transport != TRANSPORT_UDP {
+			return fmt.Errorf("%s is not supported over %s", e.opts.Protocol, e.opts.Transport)
 		}
-	}()
+	default:
+		return fmt.Errorf("unknown protocol: %s", e.opts.Protocol)
+	}
 
-	var m *ipfix.Message
+	e.address = address
+	defer e.disconnect()
+
+	flushTimer := time.NewTimer(e.opts.FlushInterval)
+	flushTimer.Stop()
+	var statsChan <-chan time.Time
+	if e.opts.StatisticsInterval > 0 {
+		statsTicker := time.NewTicker(e.opts.StatisticsInterval)
+		defer statsTicker.Stop()
+		statsChan = statsTicker.C
+	}
 	// get flow data from go channel
 	for {
-		fvs := <-flowChan
-		var sets []ipfix.Set
-		// 1. Create template data set
-		var fss []ipfix.FieldSpecifier
-		for _, fv := range fvs {
-			fss = append(fss, *fv.FieldSpecifier())
+		select {
+		case fvs := <-flowChan:
+			if e.batch.empty() {
+				flushTimer.Reset(e.opts.FlushInterval)
+			}
+			e.add(fvs)
+		case <-flushTimer.C:
+			e.flush()
+		case <-statsChan:
+			e.addStatistics()
+			e.flush()
+		case <-e.reconnectTimer.C:
+			if err := e.connect(); err != nil {
+				return err
+			}
+		case err := <-e.connErr:
+			if isAuthenticationError(err) {
+				return fmt.Errorf("authentication with collector %s failed: %w", e.address, err)
+			}
+			log.Printf("Connection to collector %s is closed: %s", e.address, err)
+			e.disconnect()
+		}
+	}
+}
+
+// connect connects to the collector and starts a new Transport Session,
+// on which all templates are sent again (RFC7011 10.4.2.2).
+// Only authentication failures are returned, as retrying them is useless.
+func (e *Exporter) connect() error {
+	conn, err := e.dial()
+	if err != nil {
+		if isAuthenticationError(err) {
+			return fmt.Errorf("authentication with collector %s failed: %w", e.address, err)
+		}
+		e.down.Store(true)
+		log.Printf("Could not connect to collector %s: %s (retry in %s)", e.address, err, e.reconnectDelay)
+		e.reconnectTimer.Reset(e.reconnectDelay)
+		e.reconnectDelay = min(e.reconnectDelay*2, e.opts.MaxReconnectInterval)
+		return nil
+	}
+	log.Printf("Connected to collector %s over %s", e.address, e.opts.Transport)
+
+	e.conn = conn
+	e.down.Store(false)
+	e.connectAt.Store(time.Now().UnixNano())
+	e.reconnectDelay = e.opts.ReconnectInterval
+	e.maxMessageLen = ipfix.MAX_MESSAGE_LENGTH
+	if addr, ok := conn.RemoteAddr().(*net.UDPAddr); ok {
+		e.maxMessageLen = maxMessageLen(e.opts.MTU, addr.IP)
+		if e.opts.Transport == TRANSPORT_DTLS {
+			e.maxMessageLen -= DTLS_RECORD_OVERHEAD
+		}
+	}
+	if e.netflowV9 != nil {
+		// Batches are measured as IPFIX Messages, whose header is shorter
+		e.maxMessageLen -= netflow9.HEADER_LEN - 16
+	}
+
+	// The collector never sends anything but TLS alerts, so reading detects
+	// closed connections and rejected certificates
+	if e.opts.Transport != TRANSPORT_UDP && e.opts.Transport != TRANSPORT_FILE {
+		e.connErr = make(chan error, 1)
+		go watchConn(conn, e.connErr)
+	}
+
+	e.newSession()
+
+	buffer := e.buffer
+	e.buffer = nil
+	for _, fvs := range buffer {
+		e.add(fvs)
+	}
+	e.flush()
+	return nil
+}
+
+// newSession resets the state of the Transport Session, so that all
+// templates are sent again.
+func (e *Exporter) newSession() {
+	// Sequence numbers and templates are per Transport Session
+	e.flowSeq = 1
+	e.withdrawals = nil
+	for _, t := range e.templates {
+		t.sent = false
+	}
+}
+
+func (e *Exporter) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: DEFAULT_DIAL_TIMEOUT}
+	switch e.opts.Transport {
+	case TRANSPORT_TLS:
+		return tls.DialWithDialer(dialer, "tcp", e.address, e.opts.TLSConfig)
+	case TRANSPORT_DTLS:
+		return dialDTLS(e.address, e.opts.TLSConfig, e.opts.MTU)
+	case TRANSPORT_FILE:
+		return openFile(e.address, e.opts.RotateSize, e.opts.RotateInterval)
+	default:
+		return dialer.Dial(e.opts.Transport, e.address)
+	}
+}
+
+func watchConn(conn net.Conn, errChan chan error) {
+	buf := make([]uint8, 1)
+	for {
+		if _, err := conn.Read(buf); err != nil {
+			errChan <- err
+			return
+		}
+	}
+}
+
+// disconnect closes the connection to the collector and schedules a reconnection.
+func (e *Exporter) disconnect() {
+	e.down.Store(true)
+	e.connectAt.Store(0)
+	if e.conn == nil {
+		return
+	}
+	if err := e.conn.Close(); err != nil {
+		log.Printf("failed to close connection: %v", err)
+	}
+	e.conn = nil
+	e.connErr = nil
+	e.reconnectTimer.Reset(e.reconnectDelay)
+}
+
+// healthy reports whether the collector is usable. A collector not yet
+// connected is healthy until the first connection fails.
+func (e *Exporter) healthy() bool {
+	return !e.down.Load()
+}
+
+// uptime returns how long the collector has been connected.
+func (e *Exporter) uptime() time.Duration {
+	at := e.connectAt.Load()
+	if at == 0 {
+		return 0
+	}
+	return time.Since(time.Unix(0, at))
+}
+
+// maxMessageLen returns the maximum length of an IPFIX Message that fits in
+// a UDP datagram without IP fragmentation.
+func maxMessageLen(mtu int, ip net.IP) int {
+	l := mtu - 8 // UDP header
+	if ip.To4() != nil {
+		l -= 20 // IPv4 header
+	} else {
+		l -= 40 // IPv6 header
+	}
+	return min(max(l, 0), ipfix.MAX_MESSAGE_LENGTH)
+}
+
+// add adds a data record to the batch, sending the batch first if the record
+// would not fit in the same message.
+func (e *Exporter) add(fvs []ipfix.FieldValue) {
+	e.addRecord(fvs, 0)
+}
+
+// addRecord adds a data record, which is an options record if
+// scopeFieldCount is non-zero.
+func (e *Exporter) addRecord(fvs []ipfix.FieldValue, scopeFieldCount uint16) {
+	if e.conn == nil {
+		if scopeFieldCount == 0 {
+			e.bufferRecords(fvs)
+		}
+		return
+	}
+
+	// Files are rotated between messages, and each file starts a new
+	// Transport Session as it is read on its own
+	if f, ok := e.conn.(*fileConn); ok && e.batch.empty() && f.w.RotationDue() {
+		if err := f.w.Rotate(); err != nil {
+			log.Printf("Could not rotate file %s: %s", e.address, err)
+			e.disconnect()
+			e.addRecord(fvs, scopeFieldCount)
+			return
+		}
+		log.Printf("Rotated to file %s", f.w.Name())
+		e.newSession()
+	}
+
+	if e.netflowV9 != nil {
+		var ok bool
+		if fvs, ok = e.netflowV9Fields(fvs, scopeFieldCount); !ok {
+			e.dropRecord()
+			return
+		}
+	}
+
+	fvs, subTemplates, subRedefined, err := e.subTemplates(fvs)
+	if err != nil {
+		log.Printf("Could not export a data record: %s", err)
+		e.dropRecord()
+		return
+	}
+	t, redefined, err := e.templateWithScope(fvs, scopeFieldCount)
+	if err != nil {
+		log.Printf("Could not export a data record: %s", err)
+		e.dropRecord()
+		return
+	}
+	if redefined || subRedefined {
+		// Records of the old template must not share a data set with the new one
+		e.flush()
+		if len(e.withdrawals) > 0 {
+			e.withdraw()
+		}
+	}
+	rec := &ipfix.DataRecord{FieldValues: fvs}
+	// A field encoded in more or fewer bytes than declared would corrupt
+	// the rest of the data set
+	if err := t.Validate(rec); err != nil {
+		log.Printf("Could not export a data record: %s", err)
+		e.dropRecord()
+		return
+	}
+	recordLen := 0
+	for _, fv := range fvs {
+		recordLen += int(fv.Len())
+	}
+
+	// Templates of the structured data are sent in the same message as
+	// the record, like its own template
+	templates := append(subTemplates, t)
+	toSend := e.templatesToSend(templates)
+	if !e.batch.empty() && e.batch.lenWith(t, recordLen, toSend) > e.maxMessageLen {
+		e.flush()
+		toSend = e.templatesToSend(templates)
+	}
+
+	// A record larger than the MTU is sent alone, and is dropped if it does
+	// not fit in a message at all, as Message.Len is uint16.
+	if e.batch.lenWith(t, recordLen, toSend) > ipfix.MAX_MESSAGE_LENGTH {
+		log.Printf("Drop a data record of %d bytes exceeding the maximum message length", recordLen)
+		e.dropRecord()
+		return
+	}
+
+	e.batch.add(t, rec, recordLen, toSend)
+	if e.batch.len() >= e.maxMessageLen {
+		e.flush()
+	}
+}
+
+// flush sends the batched data records in a single message.
+func (e *Exporter) flush() {
+	if e.batch.empty() {
+		return
+	}
+
+	b := e.batch
+	e.batch = newBatch()
+	if e.conn == nil {
+		for _, fvs := range b.fieldValues() {
+			e.bufferRecords(fvs)
 		}
-		tempRec := ipfix.NewTemplateRecord(e.tempRecSeq, fss)
-		tempSet := ipfix.NewSet(ipfix.TEMPLATE_SETS_ID, []ipfix.Record{tempRec})
-		sets = append(sets, *tempSet)
+		return
+	}
 
-		// 2. Create data set
-		dataRec := &ipfix.DataRecord{FieldValues: fvs}
-		dataSet := ipfix.NewSet(e.tempRecSeq, []ipfix.Record{dataRec})
-		sets = append(sets, *dataSet)
+	m := ipfix.NewMessage(e.flowSeq, OBSERVATION_ID, b.sets())
+	if err := e.send(m); err != nil {
+		log.Printf("Could not send message to collector %s: %s", e.address, err)
+		e.disconnect()
+		for _, fvs := range b.fieldValues() {
+			e.bufferRecords(fvs)
+		}
+		return
+	}
+	e.flowSeq += uint32(b.records)
+	e.exportedRecs += uint64(b.records)
 
-		// 3. Create Message and Increment Sequence
-		m = ipfix.NewMessage(e.flowSeq, OBSERVATION_ID, sets)
-		e.tempRecSeq += uint16(len(tempSet.Records))
-		e.flowSeq += uint32(len(dataSet.Records))
+	now := time.Now()
+	for _, t := range b.templates {
+		t.sent = true
+		t.lastSent = now
+		t.sentAt = e.messageCount
+	}
+}
+
+// withdraw sends the pending Template Withdrawals (RFC7011 8.1).
+func (e *Exporter) withdraw() {
+	var records, optionsRecords []ipfix.Record
+	for _, t := range e.withdrawals {
+		if t.isOptions() {
+			optionsRecords = append(optionsRecords, ipfix.NewOptionTemplateRecord(t.TemplateID, 0, nil))
+		} else {
+			records = append(records, ipfix.NewTemplateRecord(t.TemplateID, nil))
+		}
+	}
+	e.withdrawals = nil
 
-		//4. Send message data
-		SendMessage(m, conn)
+	var sets []ipfix.Set
+	if len(records) > 0 {
+		sets = append(sets, *ipfix.NewSet(ipfix.TEMPLATE_SETS_ID, records))
+	}
+	if len(optionsRecords) > 0 {
+		sets = append(sets, *ipfix.NewSet(ipfix.OPTIONS_TEMPLATE_SETS_ID, optionsRecords))
+	}
+	m := ipfix.NewMessage(e.flowSeq, OBSERVATION_ID, sets)
+	if err := e.send(m); err != nil {
+		log.Printf("Could not send message to collector %s: %s", e.address, err)
+		e.disconnect()
 	}
 }
 
-func SendMessage(message *ipfix.Message, conn *net.UDPConn) {
-	byteMessage := message.Serialize()
+func (e *Exporter) send(m *ipfix.Message) error {
+	if err := e.conn.SetWriteDeadline(time.Now().Add(DEFAULT_WRITE_TIMEOUT)); err != nil {
+		return err
+	}
+	if e.opts.DebugLog != nil && m.ExportTime == 0 {
+		// The debug log shows the same Export Time as the collector
+		m.ExportTime = uint32(time.Now().Unix())
+	}
+	n := int(m.Len())
+	var err error
+	if e.netflowV9 != nil {
+		n, err = sendNetflowV9Message(e.netflowV9, m, e.conn)
+	} else {
+		err = SendMessage(m, e.conn)
+	}
+	if err != nil {
+		return err
+	}
+	if e.opts.DebugLog != nil {
+		e.opts.DebugLog.Log(e.address, m)
+	}
+	e.messageCount++
+	e.exportedOctets += uint64(n)
+	return nil
+}
+
+// netflowV9Fields returns fvs without the fields NetFlow v9 cannot carry,
+// logging each of them once. It returns false if a scope field cannot be
+// carried or no field is left.
+func (e *Exporter) netflowV9Fields(fvs []ipfix.FieldValue, scopeFieldCount uint16) ([]ipfix.FieldValue, bool) {
+	ret := fvs
+	copied := false
+	for i, fv := range fvs {
+		fs := *fv.FieldSpecifier()
+		var err error
+		if i < int(scopeFieldCount) {
+			_, err = e.netflowV9.ScopeFieldType(fs)
+		} else {
+			_, err = e.netflowV9.FieldType(fs)
+		}
+		if err == nil {
+			if copied {
+				ret = append(ret, fv)
+			}
+			continue
+		}
+		if i < int(scopeFieldCount) {
+			log.Printf("Could not export an options record over NetFlow v9: %s", err)
+			return nil, false
+		}
+		if !e.unsupported[fs] {
+			e.unsupported[fs] = true
+			log.Printf("Drop a field not exported over NetFlow v9: %s", err)
+		}
+		if !copied {
+			// fvs is shared with the exporters of other collectors
+			ret = append([]ipfix.FieldValue{}, fvs[:i]...)
+			copied = true
+		}
+	}
+	return ret, len(ret) > int(scopeFieldCount)
+}
+
+// bufferRecords keeps a record until the collector is reconnected, or drops
+// it according to the buffer policy.
+func (e *Exporter) bufferRecords(fvs []ipfix.FieldValue) {
+	if e.opts.BufferPolicy != BUFFER_POLICY_BUFFER || len(e.buffer) >= e.opts.BufferSize {
+		e.dropRecord()
+		return
+	}
+	e.buffer = append(e.buffer, fvs)
+}
+
+// dropRecord counts a data record that is not sent, for the Exporting
+// Process Reliability Statistics.
+func (e *Exporter) dropRecord() {
+	e.droppedRecords++
+	e.lastDropped = time.Now()
+	if e.firstDropped.IsZero() {
+		e.firstDropped = e.lastDropped
+	}
+}
+
+// template returns the template describing fvs, allocating a new Template ID
+// if no template with the same layout of field specifiers exists. redefined
+// reports whether the allocated Template ID was used by another template.
+func (e *Exporter) template(fvs []ipfix.FieldValue) (t *template, redefined bool, err error) {
+	return e.templateWithScope(fvs, 0)
+}
+
+// templateWithScope is template for both Templates and Options Templates,
+// whose first scopeFieldCount fields are the scope.
+func (e *Exporter) templateWithScope(fvs []ipfix.FieldValue, scopeFieldCount uint16) (t *template, redefined bool, err error) {
+	key := append(e.templateKey[:0], uint8(scopeFieldCount>>8), uint8(scopeFieldCount))
+	for _, fv := range fvs {
+		key = fv.FieldSpecifier().AppendTo(key)
+	}
+	e.templateKey = key
+
+	if t, ok := e.templates[string(key)]; ok {
+		return t, false, nil
+	}
 
-	_, err := conn.Write(byteMessage)
+	templateID := e.tempRecSeq
+	b := ipfix.NewTemplateBuilder(templateID)
+	for i, fv := range fvs {
+		if i < int(scopeFieldCount) {
+			b.AddScopeField(*fv.FieldSpecifier())
+		} else {
+			b.AddField(*fv.FieldSpecifier())
+		}
+	}
+	tmpl, err := b.Build()
 	if err != nil {
-		log.Fatalln(err)
-		os.Exit(1)
+		return nil, false, err
+	}
+
+	e.tempRecSeq++
+	if e.tempRecSeq == 0 {
+		// Template IDs wrap around to the first ID of data sets (RFC7011 3.4.1)
+		e.tempRecSeq = ipfix.MIN_DATA_SETS_ID
+	}
+
+	// The Template ID is reused, so the old template is redefined. Over TCP,
+	// the old template must be withdrawn first (RFC7011 8.1)
+	if oldKey, ok := e.templateIDs[templateID]; ok {
+		if old := e.templates[oldKey]; old.sent && e.opts.Transport != TRANSPORT_UDP {
+			e.withdrawals = append(e.withdrawals, old)
+		}
+		delete(e.templates, oldKey)
+		redefined = true
+	}
+
+	t = &template{Template: tmpl}
+	e.templates[string(key)] = t
+	e.templateIDs[templateID] = string(key)
+	return t, redefined, nil
+}
+
+// subTemplates allocates the templates of the data records in the
+// structured data (RFC6313) of fvs, and assigns their Template IDs to the
+// lists. The lists are copied rather than modified, as fvs is shared with
+// the exporters of other collectors. It returns the field values with the
+// copies, the templates of the lists including nested ones, and whether
+// any Template ID was redefined.
+func (e *Exporter) subTemplates(fvs []ipfix.FieldValue) ([]ipfix.FieldValue, []*template, bool, error) {
+	ret := fvs
+	copied := false
+	var templates []*template
+	redefined := false
+	for i, fv := range fvs {
+		var list ipfix.FieldValue
+		switch fv := fv.(type) {
+		case *ipfix.SubTemplateList:
+			l := *fv
+			var ts []*template
+			var r bool
+			var err error
+			l.TemplateID, l.Records, ts, r, err = e.subTemplateRecords(fv.TemplateID, fv.Records)
+			if err != nil {
+				return nil, nil, false, err
+			}
+			templates = append(templates, ts...)
+			redefined = redefined || r
+			list = &l
+		case *ipfix.SubTemplateMultiList:
+			l := *fv
+			l.Entries = make([]ipfix.SubTemplateMultiListEntry, len(fv.Entries))
+			for j, entry := range fv.Entries {
+				var ts []*template
+				var r bool
+				var err error
+				l.Entries[j].TemplateID, l.Entries[j].Records, ts, r, err = e.subTemplateRecords(entry.TemplateID, entry.Records)
+				if err != nil {
+					return nil, nil, false, err
+				}
+				templates = append(templates, ts...)
+				redefined = redefined || r
+			}
+			list = &l
+		default:
+			continue
+		}
+		if !copied {
+			ret = append([]ipfix.FieldValue{}, fvs...)
+			copied = true
+		}
+		ret[i] = list
+	}
+	return ret, templates, redefined, nil
+}
+
+// subTemplateRecords returns the Template ID of the data records of a list,
+// which share the template of the first one, and copies of the records
+// whose own lists are assigned. Lists of other records keep templateID.
+func (e *Exporter) subTemplateRecords(templateID uint16, records []ipfix.Record) (uint16, []ipfix.Record, []*template, bool, error) {
+	ret := make([]ipfix.Record, len(records))
+	var templates []*template
+	var t *template
+	redefined := false
+	for i, r := range records {
+		dr, ok := r.(*ipfix.DataRecord)
+		if !ok {
+			ret[i] = r
+			continue
+		}
+		fvs, ts, subRedefined, err := e.subTemplates(dr.FieldValues)
+		if err != nil {
+			return 0, nil, nil, false, err
+		}
+		templates = append(templates, ts...)
+		redefined = redefined || subRedefined
+		rec := &ipfix.DataRecord{FieldValues: fvs}
+		ret[i] = rec
+		if t == nil {
+			var r bool
+			if t, r, err = e.template(fvs); err != nil {
+				return 0, nil, nil, false, err
+			}
+			templateID = t.TemplateID
+			templates = append(templates, t)
+			redefined = redefined || r
+		}
+		if err := t.Validate(rec); err != nil {
+			return 0, nil, nil, false, err
+		}
+	}
+	return templateID, ret, templates, redefined, nil
+}
+
+func (t *template) isOptions() bool {
+	return t.ScopeFieldCount > 0
+}
+
+func (t *template) len() int {
+	return int(t.Record().Len())
+}
+
+func (e *Exporter) needsTemplate(t *template) bool {
+	if !t.sent {
+		return true
+	}
+	// Templates are refreshed only over UDP (RFC7011 8.4)
+	if e.opts.Transport != TRANSPORT_UDP {
+		return false
+	}
+	if e.opts.TemplateRefreshInterval > 0 && time.Since(t.lastSent) >= e.opts.TemplateRefreshInterval {
+		return true
+	}
+	if e.opts.TemplateRefreshPackets > 0 && e.messageCount-t.sentAt >= uint64(e.opts.TemplateRefreshPackets) {
+		return true
+	}
+	return false
+}
+
+// templatesToSend returns the templates among ts that need to be sent, once each.
+func (e *Exporter) templatesToSend(ts []*template) []*template {
+	var ret []*template
+	for i, t := range ts {
+		if e.needsTemplate(t) && !slices.Contains(ts[:i], t) {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
+
+// Buffers of encoded messages, shared by the exporters of all collectors.
+// The transports do not keep the buffer after Write returns.
+var messageBuffers = sync.Pool{
+	New: func() any {
+		buf := make([]uint8, 0, DEFAULT_MTU)
+		return &buf
+	},
+}
+
+func SendMessage(message *ipfix.Message, conn net.Conn) error {
+	buf := messageBuffers.Get().(*[]uint8)
+	defer messageBuffers.Put(buf)
+
+	*buf = message.AppendTo((*buf)[:0])
+	_, err := conn.Write(*buf)
+	return err
+}
+
+// sendNetflowV9Message sends message as a NetFlow v9 Export Packet, and
+// returns its length.
+func sendNetflowV9Message(enc *netflow9.Encoder, message *ipfix.Message, conn net.Conn) (int, error) {
+	buf := messageBuffers.Get().(*[]uint8)
+	defer messageBuffers.Put(buf)
+
+	var err error
+	if *buf, err = enc.AppendMessage((*buf)[:0], message); err != nil {
+		return 0, err
 	}
+	_, err = conn.Write(*buf)
+	return len(*buf), err
 }
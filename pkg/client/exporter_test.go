@@ -0,0 +1,431 @@
+package client
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+	"github.com/nttcom/fluvia/pkg/netflow9"
+)
+
+func testFlow(segments int) []ipfix.FieldValue {
+	sl := []ipfix.SRHSegmentIPv6{}
+	for i := 0; i < segments; i++ {
+		sl = append(sl, ipfix.SRHSegmentIPv6{Val: netip.MustParseAddr("2001:db8::1")})
+	}
+	return []ipfix.FieldValue{
+		&ipfix.PacketDeltaCount{Val: 1},
+		&ipfix.SRHSegmentIPv6BasicList{SegmentList: sl},
+	}
+}
+
+func testFixedFlow(i int) []ipfix.FieldValue {
+	return []ipfix.FieldValue{
+		&ipfix.PacketDeltaCount{Val: uint64(i)},
+		&ipfix.SRHActiveSegmentIPv6{Val: netip.MustParseAddr("2001:db8::1")},
+	}
+}
+
+func TestExporterTemplateReuse(t *testing.T) {
+	e := NewExporter(ExporterOptions{})
+
+	t1, _, _ := e.template(testFlow(1))
+	t2, _, _ := e.template(testFlow(3))
+	if t1 != t2 {
+		t.Errorf("flows with the same layout should share a template: %d, %d", t1.TemplateID, t2.TemplateID)
+	}
+
+	t3, _, _ := e.template([]ipfix.FieldValue{&ipfix.PacketDeltaCount{Val: 1}})
+	if t3.TemplateID == t1.TemplateID {
+		t.Errorf("flows with different layouts should not share template %d", t1.TemplateID)
+	}
+}
+
+func TestExporterTemplateIDWrapAround(t *testing.T) {
+	e := NewExporter(ExporterOptions{})
+	e.tempRecSeq = 0xffff
+
+	last, _, _ := e.template(testFlow(1))
+	first, redefined, _ := e.template([]ipfix.FieldValue{&ipfix.PacketDeltaCount{Val: 1}})
+	if last.TemplateID != 0xffff || first.TemplateID != ipfix.MIN_DATA_SETS_ID {
+		t.Errorf("got template ids %d, %d want %d, %d", last.TemplateID, first.TemplateID, 0xffff, ipfix.MIN_DATA_SETS_ID)
+	}
+	if redefined {
+		t.Errorf("template %d is not used yet", ipfix.MIN_DATA_SETS_ID)
+	}
+}
+
+func TestExporterTemplateRefresh(t *testing.T) {
+	e := NewExporter(ExporterOptions{
+		TemplateRefreshInterval: time.Minute,
+		TemplateRefreshPackets:  10,
+	})
+
+	tmpl, _, _ := e.template(testFlow(1))
+	if !e.needsTemplate(tmpl) {
+		t.Fatalf("a new template should be sent")
+	}
+
+	tmpl.sent = true
+	tmpl.lastSent = time.Now()
+	tmpl.sentAt = e.messageCount
+	if e.needsTemplate(tmpl) {
+		t.Errorf("a template just sent should not be refreshed")
+	}
+
+	e.messageCount += 10
+	if !e.needsTemplate(tmpl) {
+		t.Errorf("a template should be refreshed after %d messages", 10)
+	}
+
+	tmpl.sentAt = e.messageCount
+	tmpl.lastSent = time.Now().Add(-time.Minute)
+	if !e.needsTemplate(tmpl) {
+		t.Errorf("a template should be refreshed after %s", time.Minute)
+	}
+}
+
+func TestExporterBatch(t *testing.T) {
+	ln, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			t.Errorf("failed to close listener: %v", err)
+		}
+	}()
+
+	conn, err := net.DialUDP("udp", nil, ln.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			t.Errorf("failed to close connection: %v", err)
+		}
+	}()
+
+	e := NewExporter(ExporterOptions{MTU: 576})
+	e.conn = conn
+	e.maxMessageLen = maxMessageLen(e.opts.MTU, net.IPv4(127, 0, 0, 1))
+
+	const flows = 50
+	for i := 0; i < flows; i++ {
+		e.add(testFixedFlow(i))
+	}
+	e.flush()
+
+	s := ipfix.NewSession(0)
+	buf := make([]uint8, ipfix.MAX_MESSAGE_LENGTH)
+	records := 0
+	for records < flows {
+		if err := ln.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, err := ln.Read(buf)
+		if err != nil {
+			t.Fatalf("got %d records want %d: %v", records, flows, err)
+		}
+		if n > e.maxMessageLen {
+			t.Errorf("got message of %d bytes exceeding %d bytes", n, e.maxMessageLen)
+		}
+
+		m, err := s.DecodeMessage(buf[:n])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.SequenceNumber != uint32(records+1) {
+			t.Errorf("got sequence number %d want %d", m.SequenceNumber, records+1)
+		}
+		for _, set := range m.Sets {
+			if set.SetID >= ipfix.MIN_DATA_SETS_ID {
+				records += len(set.Records)
+			}
+		}
+	}
+}
+
+func TestExporterTCPReconnect(t *testing.T) {
+	// Find a free port, on which the collector is not listening yet
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := ln.Addr().String()
+	if err := ln.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	e := NewExporter(ExporterOptions{
+		Transport:         TRANSPORT_TCP,
+		ReconnectInterval: 10 * time.Millisecond,
+		BufferPolicy:      BUFFER_POLICY_BUFFER,
+	})
+	flowChan := make(chan []ipfix.FieldValue)
+	go func() {
+		if err := e.Run(address, flowChan); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// Records are buffered while the collector is unreachable
+	flowChan <- testFixedFlow(1)
+	flowChan <- testFixedFlow(2)
+
+	ln, err = net.Listen("tcp", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			t.Errorf("failed to close listener: %v", err)
+		}
+	}()
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			t.Errorf("failed to close connection: %v", err)
+		}
+	}()
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	header := make([]uint8, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		t.Fatal(err)
+	}
+	data := make([]uint8, binary.BigEndian.Uint16(header[2:4]))
+	copy(data, header)
+	if _, err := io.ReadFull(conn, data[4:]); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := ipfix.NewSession(0).DecodeMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Sets) != 2 || m.Sets[0].SetID != ipfix.TEMPLATE_SETS_ID || len(m.Sets[1].Records) != 2 {
+		t.Errorf("got %+v want a template set and 2 buffered records", m.Sets)
+	}
+}
+
+func TestExporterStructuredData(t *testing.T) {
+	ln, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			t.Errorf("failed to close listener: %v", err)
+		}
+	}()
+
+	conn, err := net.DialUDP("udp", nil, ln.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			t.Errorf("failed to close connection: %v", err)
+		}
+	}()
+
+	e := NewExporter(ExporterOptions{})
+	e.conn = conn
+	e.maxMessageLen = maxMessageLen(e.opts.MTU, net.IPv4(127, 0, 0, 1))
+
+	// Per-hop records, like IOAM node data
+	hops := &ipfix.SubTemplateList{Semantic: ipfix.SEMANTIC_ORDERED}
+	for i := 0; i < 3; i++ {
+		hops.Records = append(hops.Records, &ipfix.DataRecord{FieldValues: []ipfix.FieldValue{
+			&ipfix.SRHActiveSegmentIPv6{Val: netip.AddrFrom16([16]uint8{0x20, 0x01, 0x0d, 0xb8, 15: uint8(i)})},
+			&ipfix.PathDelayMeanDeltaMicroseconds{Val: uint32(i * 10)},
+		}})
+	}
+	fvs := []ipfix.FieldValue{&ipfix.PacketDeltaCount{Val: 1}, hops}
+	e.add(fvs)
+	e.flush()
+
+	if hops.TemplateID != 0 {
+		t.Errorf("the field values shared with other exporters are modified")
+	}
+
+	buf := make([]uint8, ipfix.MAX_MESSAGE_LENGTH)
+	if err := ln.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	n, err := ln.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := ipfix.NewSession(0).DecodeMessage(buf[:n])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(m.Sets) != 2 || len(m.Sets[0].Records) != 2 {
+		t.Fatalf("got %+v want the templates of the flow and the hops", m.Sets)
+	}
+	l, ok := m.Sets[1].Records[0].(*ipfix.DataRecord).FieldValues[1].(*ipfix.SubTemplateList)
+	if !ok || len(l.Records) != 3 {
+		t.Fatalf("got %+v want 3 hops", m.Sets[1].Records[0])
+	}
+	for i, r := range l.Records {
+		dr, ok := r.(*ipfix.DataRecord)
+		if !ok {
+			t.Fatalf("got %T want *ipfix.DataRecord", r)
+		}
+		if v := dr.FieldValues[1].(*ipfix.PathDelayMeanDeltaMicroseconds).Val; v != uint32(i*10) {
+			t.Errorf("got delay %d want %d", v, i*10)
+		}
+	}
+}
+
+func TestExporterInvalidRecord(t *testing.T) {
+	e := NewExporter(ExporterOptions{})
+	e.conn = discardConn{}
+	e.maxMessageLen = ipfix.MAX_MESSAGE_LENGTH
+
+	// The records of a list share the template of the first one
+	hops := &ipfix.SubTemplateList{Semantic: ipfix.SEMANTIC_ORDERED, Records: []ipfix.Record{
+		&ipfix.DataRecord{FieldValues: []ipfix.FieldValue{&ipfix.PathDelayMeanDeltaMicroseconds{Val: 10}}},
+		&ipfix.DataRecord{FieldValues: []ipfix.FieldValue{&ipfix.PacketDeltaCount{Val: 1}}},
+	}}
+	e.add([]ipfix.FieldValue{&ipfix.PacketDeltaCount{Val: 1}, hops})
+	if e.droppedRecords != 1 || !e.batch.empty() {
+		t.Errorf("got %d dropped records want 1", e.droppedRecords)
+	}
+
+	e.add(testFixedFlow(1))
+	if e.droppedRecords != 1 || e.batch.empty() {
+		t.Errorf("a valid record should be added")
+	}
+}
+
+func TestExporterOversizeRecord(t *testing.T) {
+	e := NewExporter(ExporterOptions{})
+	e.conn = discardConn{}
+	e.maxMessageLen = ipfix.MAX_MESSAGE_LENGTH
+
+	// The record fits in a variable-length field but not in a message with
+	// its template
+	e.add(testFlow(4094))
+	if e.droppedRecords != 1 || !e.batch.empty() {
+		t.Errorf("got %d dropped records want 1", e.droppedRecords)
+	}
+
+	e.add(testFlow(1))
+	if e.droppedRecords != 1 || e.batch.empty() {
+		t.Errorf("a record within the maximum message length should be added")
+	}
+}
+
+func TestExporterNetflowV9(t *testing.T) {
+	ln, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			t.Errorf("failed to close listener: %v", err)
+		}
+	}()
+
+	conn, err := net.DialUDP("udp", nil, ln.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			t.Errorf("failed to close connection: %v", err)
+		}
+	}()
+
+	e := NewExporter(ExporterOptions{Protocol: PROTOCOL_NETFLOW_V9})
+	e.conn = conn
+	e.maxMessageLen = maxMessageLen(e.opts.MTU, net.IPv4(127, 0, 0, 1))
+
+	// The segment list is variable-length, which NetFlow v9 cannot carry
+	fvs := testFlow(2)
+	e.add(fvs)
+	e.flush()
+	if len(fvs) != 2 {
+		t.Errorf("the field values shared with other exporters are modified")
+	}
+
+	buf := make([]uint8, ipfix.MAX_MESSAGE_LENGTH)
+	if err := ln.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	n, err := ln.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []uint8{
+		0x00, 0x00, 0x00, 0x0c, // template flowset
+		0x01, 0x00, 0x00, 0x01, // template 256, 1 field
+		0x00, 0x02, 0x00, 0x08, // packetDeltaCount
+		0x01, 0x00, 0x00, 0x0c, // data flowset
+		0, 0, 0, 0, 0, 0, 0, 1,
+	}
+	if v := binary.BigEndian.Uint16(buf[0:2]); v != netflow9.VERSION || n != netflow9.HEADER_LEN+len(want) {
+		t.Fatalf("got version %d and %d bytes", v, n)
+	}
+	if got := buf[netflow9.HEADER_LEN:n]; !bytes.Equal(got, want) {
+		t.Errorf("got %x want %x", got, want)
+	}
+	if e.exportedOctets != uint64(n) {
+		t.Errorf("got %d exported octets want %d", e.exportedOctets, n)
+	}
+
+	tcp := NewExporter(ExporterOptions{Protocol: PROTOCOL_NETFLOW_V9, Transport: TRANSPORT_TCP})
+	if err := tcp.Run("127.0.0.1:4739", nil); err == nil {
+		t.Errorf("got no error for %s over %s", PROTOCOL_NETFLOW_V9, TRANSPORT_TCP)
+	}
+}
+
+// discardConn is a connection to a collector discarding messages.
+type discardConn struct {
+	net.Conn
+}
+
+func (discardConn) Write(b []uint8) (int, error)       { return len(b), nil }
+func (discardConn) SetWriteDeadline(t time.Time) error { return nil }
+
+func BenchmarkSendMessage(b *testing.B) {
+	var records []ipfix.Record
+	for i := 0; i < 20; i++ {
+		records = append(records, &ipfix.DataRecord{FieldValues: testFixedFlow(i)})
+	}
+	m := ipfix.NewMessage(1, OBSERVATION_ID, []ipfix.Set{*ipfix.NewSet(ipfix.MIN_DATA_SETS_ID, records)})
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := SendMessage(m, discardConn{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkExporterAdd(b *testing.B) {
+	e := NewExporter(ExporterOptions{})
+	e.conn = discardConn{}
+	e.maxMessageLen = maxMessageLen(e.opts.MTU, net.IPv4(127, 0, 0, 1))
+	flow := testFixedFlow(0)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		e.add(flow)
+	}
+}
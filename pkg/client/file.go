@@ -0,0 +1,103 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package client
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"os"
+	"time"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+// fileConn is the connection of TRANSPORT_FILE, writing the messages to
+// IPFIX Files in place of a collector.
+type fileConn struct {
+	w *ipfix.FileWriter
+}
+
+type fileAddr string
+
+func (a fileAddr) Network() string { return TRANSPORT_FILE }
+func (a fileAddr) String() string  { return string(a) }
+
+func openFile(path string, rotateSize int64, rotateInterval time.Duration) (net.Conn, error) {
+	w, err := ipfix.NewFileWriter(path, rotateSize, rotateInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &fileConn{w: w}, nil
+}
+
+func (c *fileConn) Read(b []uint8) (int, error) {
+	return 0, errors.New("file is write-only")
+}
+
+func (c *fileConn) Write(b []uint8) (int, error) {
+	return c.w.Write(b)
+}
+
+func (c *fileConn) Close() error {
+	return c.w.Close()
+}
+
+func (c *fileConn) LocalAddr() net.Addr {
+	return fileAddr(c.w.Name())
+}
+
+func (c *fileConn) RemoteAddr() net.Addr {
+	return fileAddr(c.w.Name())
+}
+
+// Files have no deadlines
+func (c *fileConn) SetDeadline(t time.Time) error      { return nil }
+func (c *fileConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *fileConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// ReplayFile sends the IPFIX Messages of an IPFIX File to a collector over
+// conn byte for byte as they were exported, and returns the number of
+// messages sent. Over UDP, messages exceeding the UDP payload of mtu are
+// skipped, as files are written without the limit of the path MTU.
+func ReplayFile(name string, conn net.Conn, mtu int) (int, error) {
+	maxLen := ipfix.MAX_MESSAGE_LENGTH
+	if addr, ok := conn.RemoteAddr().(*net.UDPAddr); ok {
+		maxLen = maxMessageLen(mtu, addr.IP)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Printf("failed to close file %s: %v", name, err)
+		}
+	}()
+
+	r := bufio.NewReader(f)
+	n := 0
+	for {
+		data, err := ipfix.ReadMessage(r)
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		if len(data) > maxLen {
+			log.Printf("Skip a message of %d bytes exceeding the UDP payload of %d bytes", len(data), maxLen)
+			continue
+		}
+		if _, err := conn.Write(data); err != nil {
+			return n, err
+		}
+		n++
+	}
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+func TestExporterFile(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExporter(ExporterOptions{Transport: TRANSPORT_FILE, RotateSize: 1})
+	e.address = filepath.Join(dir, "flows.ipfix")
+	if err := e.connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every message is written to its own file
+	const flows = 3
+	for i := 0; i < flows; i++ {
+		e.add(testFixedFlow(i))
+		e.flush()
+	}
+	e.disconnect()
+
+	names, err := filepath.Glob(filepath.Join(dir, "flows-*.ipfix"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != flows {
+		t.Fatalf("got %d files want %d", len(names), flows)
+	}
+
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := ipfix.NewFileReader(f).Next()
+		if err != nil && err != io.EOF {
+			t.Fatalf("%s: %v", name, err)
+		}
+		// Each file starts with the templates of its records
+		if m == nil || len(m.Sets) != 2 || m.Sets[0].SetID != ipfix.TEMPLATE_SETS_ID {
+			t.Errorf("%s: got %+v want a template set and a data set", name, m)
+		} else if _, ok := m.Sets[1].Records[0].(*ipfix.DataRecord); !ok {
+			t.Errorf("%s: got %T want *ipfix.DataRecord", name, m.Sets[1].Records[0])
+		}
+		if err := f.Close(); err != nil {
+			t.Errorf("failed to close file: %v", err)
+		}
+	}
+}
+
+func TestReplayFileUDP(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExporter(ExporterOptions{Transport: TRANSPORT_FILE})
+	e.address = filepath.Join(dir, "flows.ipfix")
+	if err := e.connect(); err != nil {
+		t.Fatal(err)
+	}
+	e.add(testFlow(1))
+	e.flush()
+	// Larger than the UDP payload of DEFAULT_MTU
+	e.add(testFlow(100))
+	e.flush()
+	e.disconnect()
+	names, err := filepath.Glob(filepath.Join(dir, "flows-*.ipfix"))
+	if err != nil || len(names) != 1 {
+		t.Fatalf("got %v, %v want a file", names, err)
+	}
+
+	ln, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			t.Errorf("failed to close listener: %v", err)
+		}
+	}()
+	conn, err := net.DialUDP("udp", nil, ln.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			t.Errorf("failed to close connection: %v", err)
+		}
+	}()
+
+	n, err := ReplayFile(names[0], conn, DEFAULT_MTU)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("got %d messages sent want %d", n, 1)
+	}
+	if m := readTestMessage(t, ln); len(m.Sets) != 2 {
+		t.Errorf("got %d sets want %d", len(m.Sets), 2)
+	}
+}
@@ -7,16 +7,15 @@
 package client
 
 import (
-	"bytes"
 	"context"
-	"encoding/binary"
 	"errors"
 	"log"
+	"math"
 	"net"
 	"net/netip"
 	"sync"
+	"sync/atomic"
 	"time"
-	"unsafe"
 
 	"github.com/cilium/ebpf"
 	"github.com/nttcom/fluvia/internal/pkg/meter"
@@ -39,13 +38,70 @@ type StatsMap struct {
 	Db map[meter.ProbeData]*Stats
 }
 
+const (
+	AGGREGATION_KERNEL = "kernel" // in the probe_stats map of the XDP program
+	AGGREGATION_USER   = "user"   // in Go from each probe packet, for debugging
+)
+
+const (
+	SAMPLING_SYSTEMATIC = "systematic" // 1 in N packets, counted across all CPUs
+	SAMPLING_RANDOM     = "random"     // each packet with probability 1/N
+	SAMPLING_HASH       = "hash"       // the same packets on every node, by their digest
+)
+
+type MeterOptions struct {
+	// AGGREGATION_KERNEL by default
+	Aggregation string
+	// How the probe packets are sent with AGGREGATION_USER,
+	// bpf.PROBE_TRANSPORT_AUTO by default
+	ProbeTransport string
+	// Of the ring buffer, or of the perf buffer of each CPU, in bytes
+	BufferSize int
+	// Bytes of each probe packet sent, up to bpf.MAX_SNAP_LEN and 0 for
+	// bpf.MAX_SNAP_LEN. It has to cover the headers up to the SRH.
+	SnapLength int
+	// bpf.XDP_MODE_GENERIC by default. A mode the interface does not
+	// support falls back to the next slower one.
+	AttachMode string
+	// Verifier log of the XDP program, only on failure by default
+	VerifierLogLevel ebpf.LogLevel
+	VerifierLogSize  uint32
+	// SAMPLING_SYSTEMATIC or SAMPLING_RANDOM of 1 in SamplingInterval
+	// probe packets, SAMPLING_HASH, or empty to meter every packet
+	Sampling         string
+	SamplingInterval uint32
+	// SAMPLING_HASH selects the packets whose digest is in
+	// [HashRangeMin, HashRangeMax], and exports a packet report of each
+	HashInitialiser uint32
+	HashRangeMin    uint32
+	HashRangeMax    uint32
+}
+
 type Meter struct {
-	statsMap *StatsMap
-	bootTime time.Time
-	xdp      *bpf.Xdp
+	statsMap  *StatsMap
+	bootTime  time.Time
+	xdp       *bpf.Xdp
+	counters  meteringCounters
+	aggregate bool   // in the kernel
+	lost      uint64 // last total of bpf.Xdp.Lost
+
+	selectorAlgorithm uint16  // 0 without sampling
+	samplingInterval  uint32  // 1 without sampling
+	scale             float64 // of the counts of the selected packets to all the packets
+	hashInitialiser   uint32
+	hashRangeMin      uint32
+	hashRangeMax      uint32
+	reports           bool // of the packets selected by the hash
+	observed          atomic.Uint64
+	selected          atomic.Uint64
+
+	// Packet reports dropped on a full flowChan since lastDropLog, only
+	// updated by Read
+	droppedReports uint64
+	lastDropLog    time.Time
 }
 
-func NewMeter(ingressIfName string) *Meter {
+func NewMeter(ingressIfName string, opts MeterOptions) *Meter {
 	bootTime, err := getSystemBootTime()
 	if err != nil {
 		log.Fatalf("Could not get boot time: %s", err)
@@ -59,38 +115,134 @@ func NewMeter(ingressIfName string) *Meter {
 	}
 
 	// Load the XDP program
-	xdp, err := bpf.ReadXdpObjects(&ebpf.CollectionOptions{
-		Programs: ebpf.ProgramOptions{
-			LogLevel: ebpf.LogLevelInstruction,
+	xdp, err := bpf.ReadXdpObjects(bpf.XdpOptions{
+		Collection: ebpf.CollectionOptions{
+			Programs: ebpf.ProgramOptions{
+				LogLevel:     opts.VerifierLogLevel,
+				LogSizeStart: opts.VerifierLogSize,
+			},
 		},
+		Transport:  opts.ProbeTransport,
+		BufferSize: opts.BufferSize,
 	})
 	if err != nil {
 		var ve *ebpf.VerifierError
 		if errors.As(err, &ve) {
-			log.Fatalf("Could not load XDP program: %+v\n", ve)
+			// %+v writes out every line of the verifier log
+			log.Fatalf("Could not load XDP program: %+v", ve)
+		}
+		log.Fatalf("Could not load XDP program: %s", err)
+	}
+	if opts.VerifierLogLevel != 0 {
+		log.Printf("Verifier log of XDP program:\n%s", xdp.VerifierLog())
+	}
+
+	aggregate := false
+	switch opts.Aggregation {
+	case "", AGGREGATION_KERNEL:
+		aggregate = xdp.SupportsAggregation()
+		if !aggregate {
+			log.Printf("XDP program does not support aggregation in the kernel, run make go-gen. Falling back to aggregation in user space")
 		}
+	case AGGREGATION_USER:
+	default:
+		log.Fatalf("Unknown aggregation: %s", opts.Aggregation)
+	}
+
+	if opts.SnapLength < 0 {
+		log.Fatalf("Invalid snap length: %d", opts.SnapLength)
+	}
+	if opts.SnapLength > bpf.MAX_SNAP_LEN {
+		log.Printf("Snap length %d exceeds %d bytes, sending %d bytes of each probe packet", opts.SnapLength, bpf.MAX_SNAP_LEN, bpf.MAX_SNAP_LEN)
+		opts.SnapLength = bpf.MAX_SNAP_LEN
+	}
+	var selectorAlgorithm uint16
+	samplingInterval := uint32(1)
+	switch opts.Sampling {
+	case "":
+	case SAMPLING_SYSTEMATIC:
+		selectorAlgorithm = ipfix.SELECTOR_ALGORITHM_SYSTEMATIC_COUNT
+	case SAMPLING_RANDOM:
+		selectorAlgorithm = ipfix.SELECTOR_ALGORITHM_UNIFORM_PROBABILISTIC
+	case SAMPLING_HASH:
+		selectorAlgorithm = ipfix.SELECTOR_ALGORITHM_HASH_BOB
+	default:
+		log.Fatalf("Unknown sampling: %s", opts.Sampling)
+	}
+	scale := 1.0
+	switch selectorAlgorithm {
+	case 0:
+	case ipfix.SELECTOR_ALGORITHM_HASH_BOB:
+		if opts.HashRangeMax < opts.HashRangeMin || opts.HashRangeMax == 0 {
+			log.Fatalf("Invalid hash range: [%d, %d]", opts.HashRangeMin, opts.HashRangeMax)
+		}
+		// The digests are uniform over 32 bits
+		scale = float64(1<<32) / float64(uint64(opts.HashRangeMax)-uint64(opts.HashRangeMin)+1)
+	default:
+		if opts.SamplingInterval == 0 {
+			log.Fatalf("Sampling interval must be positive")
+		}
+		samplingInterval = opts.SamplingInterval
+		scale = float64(samplingInterval)
+	}
+	if selectorAlgorithm != 0 && !xdp.SupportsSampling() {
+		log.Fatalf("XDP program does not support sampling, run make go-gen")
+	}
+	reports := selectorAlgorithm == ipfix.SELECTOR_ALGORITHM_HASH_BOB
+
+	config := bpf.XdpConfig{
+		SnapLen:           uint32(opts.SnapLength),
+		BootTimeNano:      bootTime.UnixNano(),
+		SelectorAlgorithm: uint32(selectorAlgorithm),
+		SamplingInterval:  samplingInterval,
+		HashInitialiser:   opts.HashInitialiser,
+		HashRangeMin:      opts.HashRangeMin,
+		HashRangeMax:      opts.HashRangeMax,
+	}
+	if aggregate {
+		config.Flags |= bpf.XDP_CONFIG_F_AGGREGATE
+		if reports {
+			config.Flags |= bpf.XDP_CONFIG_F_REPORT
+		}
+	}
+	if !aggregate || reports {
+		log.Printf("Sending probe packets with %s", xdp.Transport())
+	}
+	if err := xdp.Configure(config); err != nil {
+		log.Fatalf("Could not configure XDP program: %s", err)
 	}
 
 	// Attach the XDP program.
-	if err = xdp.Attach(iface); err != nil {
+	mode, err := xdp.Attach(iface, opts.AttachMode)
+	if err != nil {
 		log.Fatalf("Could not attach XDP program: %s", err)
 	}
 
-	log.Printf("Attached XDP program to iface %q (index %d)", iface.Name, iface.Index)
+	log.Printf("Attached XDP program to iface %q (index %d) in %s mode", iface.Name, iface.Index, mode)
 	log.Printf("Press Ctrl-C to exit and remove the program")
 
 	return &Meter{
-		statsMap: &statsMap,
-		bootTime: bootTime,
-		xdp:      xdp,
+		statsMap:          &statsMap,
+		bootTime:          bootTime,
+		xdp:               xdp,
+		aggregate:         aggregate,
+		selectorAlgorithm: selectorAlgorithm,
+		samplingInterval:  samplingInterval,
+		scale:             scale,
+		hashInitialiser:   opts.HashInitialiser,
+		hashRangeMin:      opts.HashRangeMin,
+		hashRangeMax:      opts.HashRangeMax,
+		reports:           reports,
 	}
 }
 
-func (m *Meter) Run(flowChan chan []ipfix.FieldValue, interval time.Duration) error {
+func (m *Meter) Run(flowChan chan Flow, interval time.Duration) error {
 	eg, ctx := errgroup.WithContext(context.Background())
-	eg.Go(func() error {
-		return m.Read(ctx)
-	})
+	if !m.aggregate || m.reports {
+		eg.Go(func() error {
+			return m.Read(ctx, flowChan)
+		})
+	}
 	eg.Go(func() error {
 		return m.Send(ctx, flowChan, interval)
 	})
@@ -102,44 +254,53 @@ func (m *Meter) Run(flowChan chan []ipfix.FieldValue, interval time.Duration) er
 	return nil
 }
 
-func (m *Meter) Read(ctx context.Context) error {
-	perfEvent, err := m.xdp.NewPerfReader()
+func (m *Meter) Read(ctx context.Context, flowChan chan Flow) error {
+	reader, err := m.xdp.NewProbeReader()
 	if err != nil {
-		log.Fatalf("Could not obtain perf reader: %s", err)
+		log.Fatalf("Could not obtain probe reader: %s", err)
 	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			log.Printf("failed to close probe reader: %v", err)
+		}
+	}()
 
-	var metadata bpf.XdpMetaData
+	var sample bpf.ProbeSample
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		default:
-			eventData, err := perfEvent.Read()
-			if err != nil {
-				log.Fatalf("Could not read from bpf perf map:")
+			if err := reader.Read(&sample); err != nil {
+				log.Fatalf("Could not read probe packet: %s", err)
 			}
-
-			reader := bytes.NewReader(eventData.RawSample)
-
-			if err := binary.Read(reader, binary.LittleEndian, &metadata); err != nil {
-				log.Fatalf("Could not read from reader: %s", err)
+			if sample.LostSamples > 0 {
+				m.counters.addLost(sample.LostSamples)
+				continue
 			}
-
-			metadata_size := unsafe.Sizeof(metadata)
-			if len(eventData.RawSample)-int(metadata_size) <= 0 {
+			if len(sample.Packet) == 0 {
 				continue
 			}
 
+			metadata := sample.MetaData
 			receivedNano := m.bootTime.Add(time.Duration(metadata.ReceivedNano) * time.Nanosecond)
 			SentNano := time.Unix(int64(metadata.SentSec), int64(metadata.SentSubsec))
 
 			delay := receivedNano.Sub(SentNano)
 
-			probeData, err := meter.Parse(eventData.RawSample[metadata_size:])
+			probeData, err := meter.Parse(sample.Packet)
 			if err != nil {
 				log.Fatalf("Could not parse the packet: %s", err)
 			}
 
+			if m.reports {
+				m.report(flowChan, probeData, &sample, receivedNano)
+			}
+			if m.aggregate {
+				continue
+			}
+
+			m.counters.packets.Add(1)
 			delayMicro := delay.Microseconds()
 
 			m.statsMap.Mu.Lock()
@@ -170,7 +331,7 @@ func (m *Meter) Read(ctx context.Context) error {
 	}
 }
 
-func (m *Meter) Send(ctx context.Context, flowChan chan []ipfix.FieldValue, interval time.Duration) error {
+func (m *Meter) Send(ctx context.Context, flowChan chan Flow, interval time.Duration) error {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
@@ -179,9 +340,22 @@ func (m *Meter) Send(ctx context.Context, flowChan chan []ipfix.FieldValue, inte
 		case <-ctx.Done():
 			return nil
 		default:
+			if m.aggregate {
+				if err := m.drain(); err != nil {
+					return err
+				}
+			}
+			if err := m.updateLost(); err != nil {
+				return err
+			}
+			if err := m.updateSampling(); err != nil {
+				return err
+			}
+
 			m.statsMap.Mu.Lock()
 			for probeData, stat := range m.statsMap.Db {
-				dCnt := uint64(stat.Count)
+				// Counts of the selected packets are scaled to all the packets
+				dCnt := uint64(math.Round(float64(stat.Count) * m.scale))
 
 				sl := []ipfix.SRHSegmentIPv6{}
 				for _, seg := range probeData.Segments {
@@ -212,10 +386,10 @@ func (m *Meter) Send(ctx context.Context, flowChan chan []ipfix.FieldValue, inte
 					&ipfix.PathDelayMeanDeltaMicroseconds{Val: uint32(stat.DelayMean)},
 					&ipfix.PathDelayMinDeltaMicroseconds{Val: uint32(stat.DelayMin)},
 					&ipfix.PathDelayMaxDeltaMicroseconds{Val: uint32(stat.DelayMax)},
-					&ipfix.PathDelaySumDeltaMicroseconds{Val: uint32(stat.DelaySum)},
+					&ipfix.PathDelaySumDeltaMicroseconds{Val: uint32(math.Round(float64(stat.DelaySum) * m.scale))},
 				}
 				//  Throw to channel
-				flowChan <- f
+				flowChan <- Flow{Key: flowKey(&probeData), FieldValues: f}
 
 				// Stats (e.g., DelayMean) are based on packets received over a fixed duration
 				// These need to be cleared out for the next calculation of statistics
@@ -228,6 +402,131 @@ func (m *Meter) Send(ctx context.Context, flowChan chan []ipfix.FieldValue, inte
 	return nil
 }
 
+// report sends the Packet Report (RFC5476 6.4) of a packet selected by the
+// hash, whose digest correlates it with the reports of the other nodes. Its
+// delay is aggregated with those of the other packets.
+func (m *Meter) report(flowChan chan Flow, probeData *meter.ProbeData, s *bpf.ProbeSample, received time.Time) {
+	digest, err := bpf.PacketDigest(s.Packet, &s.MetaData, m.hashInitialiser)
+	if err != nil {
+		log.Printf("Could not compute the packet digest: %s", err)
+		return
+	}
+	src, _ := netip.ParseAddr(probeData.V6Srcaddr)
+	actSeg, _ := netip.ParseAddr(probeData.Segments[probeData.SegmentsLeft])
+
+	m.sendReport(flowChan, Flow{Key: flowKey(probeData), FieldValues: []ipfix.FieldValue{
+		&ipfix.SelectorId{Val: SELECTOR_ID},
+		&ipfix.DigestHashValue{Val: uint64(digest)},
+		&ipfix.ObservationTimeNanoseconds{Val: received},
+		&ipfix.SourceIPv6Address{Val: src},
+		&ipfix.SRHActiveSegmentIPv6{Val: actSeg},
+	}})
+}
+
+// sendReport sends f without blocking, which would stall the reading of the
+// probe packets and make the kernel lose them. Reports are dropped while
+// flowChan is full.
+func (m *Meter) sendReport(flowChan chan Flow, f Flow) {
+	select {
+	case flowChan <- f:
+	default:
+		m.droppedReports++
+	}
+
+	if m.droppedReports == 0 || time.Since(m.lastDropLog) < DROP_LOG_INTERVAL {
+		return
+	}
+	log.Printf("Dropped %d packet reports as the flow channel is full", m.droppedReports)
+	m.droppedReports = 0
+	m.lastDropLog = time.Now()
+}
+
+// updateSampling loads the counters of the sampling in the kernel.
+func (m *Meter) updateSampling() error {
+	if m.selectorAlgorithm == 0 {
+		return nil
+	}
+	observed, selected, err := m.xdp.SamplingStats()
+	if err != nil {
+		return err
+	}
+	m.observed.Store(observed)
+	m.selected.Store(selected)
+	return nil
+}
+
+// drain merges the statistics aggregated in the kernel into statsMap.
+func (m *Meter) drain() error {
+	m.statsMap.Mu.Lock()
+	defer m.statsMap.Mu.Unlock()
+
+	return m.xdp.DrainProbeStats(func(key bpf.XdpProbeKey, s bpf.XdpProbeStats) {
+		m.counters.packets.Add(s.Count)
+		addStats(m.statsMap.Db, key.ProbeData(), s)
+	})
+}
+
+// updateLost counts the probe packets lost in the kernel since the last call.
+func (m *Meter) updateLost() error {
+	lost, err := m.xdp.Lost()
+	if err != nil {
+		return err
+	}
+	if lost > m.lost {
+		m.counters.addLost(lost - m.lost)
+	}
+	m.lost = lost
+	return nil
+}
+
+// addStats adds the statistics of the kernel to db, in microseconds.
+func addStats(db map[meter.ProbeData]*Stats, probeData meter.ProbeData, s bpf.XdpProbeStats) {
+	if s.Count == 0 {
+		return
+	}
+	count := int64(s.Count)
+	delayMin := time.Duration(s.DelayMin).Microseconds()
+	delayMax := time.Duration(s.DelayMax).Microseconds()
+	delaySum := time.Duration(s.DelaySum).Microseconds()
+
+	value, ok := db[probeData]
+	if !ok {
+		db[probeData] = &Stats{
+			Count:     count,
+			DelayMean: delaySum / count,
+			DelayMin:  delayMin,
+			DelayMax:  delayMax,
+			DelaySum:  delaySum,
+		}
+		return
+	}
+	value.Count += count
+	value.DelayMin = min(value.DelayMin, delayMin)
+	value.DelayMax = max(value.DelayMax, delayMax)
+	value.DelaySum += delaySum
+	value.DelayMean = value.DelaySum / value.Count
+}
+
+// Statistics returns the counters of the Metering Process, which is the
+// source of ExporterOptions.MeteringStatistics.
+func (m *Meter) Statistics() MeteringStatistics {
+	s := m.counters.statistics()
+	if m.selectorAlgorithm != 0 {
+		s.Sampling = SamplingStatistics{
+			SelectorAlgorithm: m.selectorAlgorithm,
+			Interval:          m.samplingInterval,
+			HashInitialiser:   m.hashInitialiser,
+			HashRangeMin:      m.hashRangeMin,
+			HashRangeMax:      m.hashRangeMax,
+			Observed:          m.observed.Load(),
+			Selected:          m.selected.Load(),
+		}
+		// packetTotalCount is of the packets observed, not only those selected
+		s.Packets = s.Sampling.Observed
+	}
+	return s
+}
+
 func (m *Meter) Close() error {
 	if err := m.xdp.Close(); err != nil {
 		return err
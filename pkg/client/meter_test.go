@@ -0,0 +1,47 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/nttcom/fluvia/internal/pkg/meter"
+	"github.com/nttcom/fluvia/pkg/bpf"
+)
+
+func TestAddStats(t *testing.T) {
+	db := make(map[meter.ProbeData]*Stats)
+	key := meter.ProbeData{V6Srcaddr: "2001:db8::1"}
+
+	addStats(db, key, bpf.XdpProbeStats{})
+	if len(db) != 0 {
+		t.Fatalf("got %d entries for no packets", len(db))
+	}
+
+	addStats(db, key, bpf.XdpProbeStats{Count: 2, DelayMin: 1500, DelayMax: 8000, DelaySum: 9500})
+	addStats(db, key, bpf.XdpProbeStats{Count: 2, DelayMin: 1000, DelayMax: 3000, DelaySum: 4500})
+	want := Stats{Count: 4, DelayMean: 3, DelayMin: 1, DelayMax: 8, DelaySum: 13}
+	if got := *db[key]; got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestSendReport(t *testing.T) {
+	m := &Meter{}
+	flowChan := make(chan Flow, 1)
+
+	m.sendReport(flowChan, Flow{Key: 1})
+	// flowChan is full, the report is dropped instead of blocking
+	m.sendReport(flowChan, Flow{Key: 2})
+	if f := <-flowChan; f.Key != 1 {
+		t.Errorf("got flow %d want 1", f.Key)
+	}
+	if m.droppedReports != 0 || m.lastDropLog.IsZero() {
+		t.Errorf("got %d dropped reports logged at %v", m.droppedReports, m.lastDropLog)
+	}
+
+	// Drops are logged at most every DROP_LOG_INTERVAL
+	m.sendReport(flowChan, Flow{Key: 3})
+	m.sendReport(flowChan, Flow{Key: 4})
+	if m.droppedReports != 1 {
+		t.Errorf("got %d dropped reports want 1", m.droppedReports)
+	}
+}
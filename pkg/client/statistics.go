@@ -0,0 +1,150 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package client
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+// MeteringStatistics are the counters of the Metering Process since it started.
+type MeteringStatistics struct {
+	Packets     uint64 // packets observed
+	LostSamples uint64 // probe packets lost by the perf or ring buffer, or not aggregated
+	FirstLost   time.Time
+	LastLost    time.Time
+	Sampling    SamplingStatistics
+}
+
+// SamplingStatistics describe the Selector of the probe packets.
+type SamplingStatistics struct {
+	// ipfix.SELECTOR_ALGORITHM_*, or 0 if every packet is selected
+	SelectorAlgorithm uint16
+	Interval          uint32 // 1 in Interval packets is selected
+	// Packets whose digest is in [HashRangeMin, HashRangeMax] are selected
+	HashInitialiser uint32
+	HashRangeMin    uint32
+	HashRangeMax    uint32
+	Observed        uint64
+	Selected        uint64
+}
+
+// meteringCounters are updated by the Meter and read by the exporters.
+type meteringCounters struct {
+	packets     atomic.Uint64
+	lostSamples atomic.Uint64
+	firstLost   atomic.Int64 // UnixNano, 0 if no sample is lost
+	lastLost    atomic.Int64
+}
+
+func (c *meteringCounters) addLost(n uint64) {
+	now := time.Now().UnixNano()
+	c.lostSamples.Add(n)
+	c.firstLost.CompareAndSwap(0, now)
+	c.lastLost.Store(now)
+}
+
+func (c *meteringCounters) statistics() MeteringStatistics {
+	s := MeteringStatistics{
+		Packets:     c.packets.Load(),
+		LostSamples: c.lostSamples.Load(),
+	}
+	if at := c.firstLost.Load(); at != 0 {
+		s.FirstLost = time.Unix(0, at)
+	}
+	if at := c.lastLost.Load(); at != 0 {
+		s.LastLost = time.Unix(0, at)
+	}
+	return s
+}
+
+// addStatistics adds the options records of the process statistics
+// scoped to the Observation Domain (RFC7011 4.).
+func (e *Exporter) addStatistics() {
+	scope := &ipfix.ObservationDomainId{Val: OBSERVATION_ID}
+
+	if e.opts.MeteringStatistics != nil {
+		s := e.opts.MeteringStatistics()
+
+		// Metering Process Statistics (RFC7011 4.1)
+		e.addRecord([]ipfix.FieldValue{
+			scope,
+			&ipfix.ExportedMessageTotalCount{Val: e.messageCount},
+			&ipfix.ExportedFlowRecordTotalCount{Val: e.exportedRecs},
+			&ipfix.ExportedOctetTotalCount{Val: e.exportedOctets},
+			&ipfix.PacketTotalCount{Val: s.Packets},
+		}, 1)
+
+		// Metering Process Reliability Statistics (RFC7011 4.2)
+		e.addRecord([]ipfix.FieldValue{
+			scope,
+			&ipfix.IgnoredPacketTotalCount{Val: s.LostSamples},
+			&ipfix.FlowStartMilliseconds{Val: s.FirstLost},
+			&ipfix.FlowEndMilliseconds{Val: s.LastLost},
+		}, 1)
+
+		if s.Sampling.SelectorAlgorithm != 0 {
+			e.addSamplingStatistics(&s.Sampling)
+		}
+	}
+
+	// Exporting Process Reliability Statistics (RFC7011 4.3)
+	e.addRecord([]ipfix.FieldValue{
+		scope,
+		&ipfix.NotSentFlowTotalCount{Val: e.droppedRecords},
+		&ipfix.FlowStartMilliseconds{Val: e.firstDropped},
+		&ipfix.FlowEndMilliseconds{Val: e.lastDropped},
+	}, 1)
+}
+
+// addSamplingStatistics adds the options records of the Selector of the probe
+// packets (RFC5476 6.5.2, 6.5.3). samplingInterval and samplingAlgorithm
+// (RFC7270) are for collectors that predate PSAMP.
+func (e *Exporter) addSamplingStatistics(s *SamplingStatistics) {
+	scope := &ipfix.SelectorId{Val: SELECTOR_ID}
+
+	// Selector Report Interpretation
+	fvs := []ipfix.FieldValue{
+		scope,
+		&ipfix.SelectorAlgorithm{Val: s.SelectorAlgorithm},
+	}
+	switch s.SelectorAlgorithm {
+	case ipfix.SELECTOR_ALGORITHM_SYSTEMATIC_COUNT:
+		fvs = append(fvs,
+			&ipfix.SamplingPacketInterval{Val: 1},
+			&ipfix.SamplingPacketSpace{Val: s.Interval - 1},
+			&ipfix.SamplingInterval{Val: s.Interval},
+			&ipfix.SamplingAlgorithm{Val: ipfix.SAMPLING_ALGORITHM_DETERMINISTIC},
+		)
+	case ipfix.SELECTOR_ALGORITHM_UNIFORM_PROBABILISTIC:
+		fvs = append(fvs,
+			&ipfix.SamplingProbability{Val: 1 / float64(s.Interval)},
+			&ipfix.SamplingInterval{Val: s.Interval},
+			&ipfix.SamplingAlgorithm{Val: ipfix.SAMPLING_ALGORITHM_RANDOM},
+		)
+	case ipfix.SELECTOR_ALGORITHM_HASH_BOB:
+		// RFC5477 8.3
+		fvs = append(fvs,
+			&ipfix.HashOutputRangeMin{Val: 0},
+			&ipfix.HashOutputRangeMax{Val: math.MaxUint32},
+			&ipfix.HashSelectedRangeMin{Val: uint64(s.HashRangeMin)},
+			&ipfix.HashSelectedRangeMax{Val: uint64(s.HashRangeMax)},
+			&ipfix.HashDigestOutput{Val: true},
+			&ipfix.HashInitialiserValue{Val: uint64(s.HashInitialiser)},
+		)
+	}
+	e.addRecord(fvs, 1)
+
+	// Selector Report Statistics
+	e.addRecord([]ipfix.FieldValue{
+		scope,
+		&ipfix.SelectorIdTotalPktsObserved{Val: s.Observed},
+		&ipfix.SelectorIdTotalPktsSelected{Val: s.Selected},
+	}, 1)
+}
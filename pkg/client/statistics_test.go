@@ -0,0 +1,206 @@
+package client
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+func TestExporterStatistics(t *testing.T) {
+	ln, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			t.Errorf("failed to close listener: %v", err)
+		}
+	}()
+
+	conn, err := net.DialUDP("udp", nil, ln.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			t.Errorf("failed to close connection: %v", err)
+		}
+	}()
+
+	lost := time.UnixMilli(1698223606000)
+	e := NewExporter(ExporterOptions{
+		MeteringStatistics: func() MeteringStatistics {
+			return MeteringStatistics{Packets: 100, LostSamples: 3, FirstLost: lost, LastLost: lost}
+		},
+	})
+
+	// The record is dropped while disconnected
+	e.add(testFixedFlow(1))
+
+	e.conn = conn
+	e.maxMessageLen = maxMessageLen(e.opts.MTU, net.IPv4(127, 0, 0, 1))
+	e.addStatistics()
+	e.flush()
+
+	if err := ln.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]uint8, ipfix.MAX_MESSAGE_LENGTH)
+	n, err := ln.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := ipfix.NewSession(0).DecodeMessage(buf[:n])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(m.Sets) != 4 || m.Sets[0].SetID != ipfix.OPTIONS_TEMPLATE_SETS_ID {
+		t.Fatalf("got %+v want an options template set and 3 data sets", m.Sets)
+	}
+	for _, r := range m.Sets[0].Records {
+		if r := r.(*ipfix.OptionsTemplateRecord); r.ScopeFieldCount != 1 || r.FieldSpecifiers[0].InformationElementID != ipfix.IEID_OBSERVATION_DOMAIN_ID {
+			t.Errorf("got %+v want observationDomainId as scope", r)
+		}
+	}
+
+	values := map[uint16]ipfix.FieldValue{}
+	for _, s := range m.Sets[1:] {
+		for _, fv := range s.Records[0].(*ipfix.DataRecord).FieldValues {
+			values[fv.ElementID()] = fv
+		}
+	}
+	if v := values[ipfix.IEID_OBSERVATION_DOMAIN_ID].(*ipfix.ObservationDomainId).Val; v != OBSERVATION_ID {
+		t.Errorf("got observationDomainId %d want %d", v, OBSERVATION_ID)
+	}
+	if v := values[ipfix.IEID_PACKET_TOTAL_COUNT].(*ipfix.PacketTotalCount).Val; v != 100 {
+		t.Errorf("got packetTotalCount %d want %d", v, 100)
+	}
+	if v := values[ipfix.IEID_IGNORED_PACKET_TOTAL_COUNT].(*ipfix.IgnoredPacketTotalCount).Val; v != 3 {
+		t.Errorf("got ignoredPacketTotalCount %d want %d", v, 3)
+	}
+	if v := values[ipfix.IEID_NOT_SENT_FLOW_TOTAL_COUNT].(*ipfix.NotSentFlowTotalCount).Val; v != 1 {
+		t.Errorf("got notSentFlowTotalCount %d want %d", v, 1)
+	}
+}
+
+// exportSamplingStatistics returns the fields of the options records scoped
+// to the Selector.
+func exportSamplingStatistics(t *testing.T, sampling SamplingStatistics) map[uint16]ipfix.FieldValue {
+	t.Helper()
+	ln, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			t.Errorf("failed to close listener: %v", err)
+		}
+	}()
+
+	conn, err := net.DialUDP("udp", nil, ln.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			t.Errorf("failed to close connection: %v", err)
+		}
+	}()
+
+	e := NewExporter(ExporterOptions{
+		MeteringStatistics: func() MeteringStatistics {
+			return MeteringStatistics{
+				Packets:  sampling.Observed,
+				Sampling: sampling,
+			}
+		},
+	})
+	e.conn = conn
+	e.maxMessageLen = maxMessageLen(e.opts.MTU, net.IPv4(127, 0, 0, 1))
+	e.addStatistics()
+	e.flush()
+
+	if err := ln.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]uint8, ipfix.MAX_MESSAGE_LENGTH)
+	n, err := ln.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := ipfix.NewSession(0).DecodeMessage(buf[:n])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := map[uint16]ipfix.FieldValue{}
+	for _, s := range m.Sets[1:] {
+		r := s.Records[0].(*ipfix.DataRecord)
+		if r.FieldValues[0].ElementID() != ipfix.IEID_SELECTOR_ID {
+			continue
+		}
+		for _, fv := range r.FieldValues {
+			values[fv.ElementID()] = fv
+		}
+	}
+	if v, ok := values[ipfix.IEID_SELECTOR_ID].(*ipfix.SelectorId); !ok || v.Val != SELECTOR_ID {
+		t.Fatalf("got %+v want the options records scoped to selectorId %d", values, SELECTOR_ID)
+	}
+	return values
+}
+
+func TestExporterSamplingStatistics(t *testing.T) {
+	values := exportSamplingStatistics(t, SamplingStatistics{
+		SelectorAlgorithm: ipfix.SELECTOR_ALGORITHM_SYSTEMATIC_COUNT,
+		Interval:          10,
+		Observed:          1000,
+		Selected:          100,
+	})
+	if v := values[ipfix.IEID_SELECTOR_ALGORITHM].(*ipfix.SelectorAlgorithm).Val; v != ipfix.SELECTOR_ALGORITHM_SYSTEMATIC_COUNT {
+		t.Errorf("got selectorAlgorithm %d", v)
+	}
+	if v := values[ipfix.IEID_SAMPLING_PACKET_SPACE].(*ipfix.SamplingPacketSpace).Val; v != 9 {
+		t.Errorf("got samplingPacketSpace %d want %d", v, 9)
+	}
+	if v := values[ipfix.IEID_SAMPLING_INTERVAL].(*ipfix.SamplingInterval).Val; v != 10 {
+		t.Errorf("got samplingInterval %d want %d", v, 10)
+	}
+	if v := values[ipfix.IEID_SELECTOR_ID_TOTAL_PKTS_OBSERVED].(*ipfix.SelectorIdTotalPktsObserved).Val; v != 1000 {
+		t.Errorf("got selectorIdTotalPktsObserved %d want %d", v, 1000)
+	}
+	if v := values[ipfix.IEID_SELECTOR_ID_TOTAL_PKTS_SELECTED].(*ipfix.SelectorIdTotalPktsSelected).Val; v != 100 {
+		t.Errorf("got selectorIdTotalPktsSelected %d want %d", v, 100)
+	}
+}
+
+func TestExporterHashStatistics(t *testing.T) {
+	values := exportSamplingStatistics(t, SamplingStatistics{
+		SelectorAlgorithm: ipfix.SELECTOR_ALGORITHM_HASH_BOB,
+		HashInitialiser:   0x12345678,
+		HashRangeMin:      0,
+		HashRangeMax:      0x0fffffff,
+		Observed:          1600,
+		Selected:          100,
+	})
+	if v := values[ipfix.IEID_SELECTOR_ALGORITHM].(*ipfix.SelectorAlgorithm).Val; v != ipfix.SELECTOR_ALGORITHM_HASH_BOB {
+		t.Errorf("got selectorAlgorithm %d", v)
+	}
+	if v := values[ipfix.IEID_HASH_OUTPUT_RANGE_MAX].(*ipfix.HashOutputRangeMax).Val; v != 0xffffffff {
+		t.Errorf("got hashOutputRangeMax %#x want %#x", v, 0xffffffff)
+	}
+	if v := values[ipfix.IEID_HASH_SELECTED_RANGE_MAX].(*ipfix.HashSelectedRangeMax).Val; v != 0x0fffffff {
+		t.Errorf("got hashSelectedRangeMax %#x want %#x", v, 0x0fffffff)
+	}
+	if v := values[ipfix.IEID_HASH_INITIALISATION_VALUE].(*ipfix.HashInitialiserValue).Val; v != 0x12345678 {
+		t.Errorf("got hashInitialiserValue %#x want %#x", v, 0x12345678)
+	}
+	if v := values[ipfix.IEID_HASH_DIGEST_OUTPUT].(*ipfix.HashDigestOutput).Val; !v {
+		t.Error("got hashDigestOutput false")
+	}
+	if _, ok := values[ipfix.IEID_SAMPLING_INTERVAL]; ok {
+		t.Error("got samplingInterval for the hash selector")
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+
+	"github.com/pion/dtls/v3"
+)
+
+// DTLS record header, explicit nonce and AEAD tag (RFC6347 4.1)
+const DTLS_RECORD_OVERHEAD = 13 + 8 + 16
+
+// NewTLSConfig returns a TLS configuration for TRANSPORT_TLS and
+// TRANSPORT_DTLS. certFile and keyFile are the PEM encoded certificate and
+// key presented to the collector for mutual authentication (RFC7011 11.3),
+// and caFile is the PEM encoded CA bundle verifying the collector. The
+// system roots are used if caFile is empty. serverName is verified against
+// the collector certificate, and defaults to the host of the collector address.
+func NewTLSConfig(certFile, keyFile, caFile, serverName string) (*tls.Config, error) {
+	c := &tls.Config{
+		MinVersion: tls.VersionTLS12, // RFC7011 11.1
+		ServerName: serverName,
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+		c.RootCAs = pool
+	}
+
+	return c, nil
+}
+
+func dialDTLS(address string, config *tls.Config, mtu int) (net.Conn, error) {
+	raddr, err := net.ResolveUDPAddr("udp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []dtls.ClientOption{
+		dtls.WithExtendedMasterSecret(dtls.RequireExtendedMasterSecret),
+		// The MTU of pion/dtls is that of the UDP payload
+		dtls.WithMTU(maxMessageLen(mtu, raddr.IP)),
+	}
+	serverName := ""
+	if config != nil {
+		opts = append(opts,
+			dtls.WithCertificates(config.Certificates...),
+			dtls.WithRootCAs(config.RootCAs),
+			dtls.WithInsecureSkipVerify(config.InsecureSkipVerify),
+		)
+		serverName = config.ServerName
+	}
+	if serverName == "" {
+		if serverName, _, err = net.SplitHostPort(address); err != nil {
+			return nil, err
+		}
+	}
+	opts = append(opts, dtls.WithServerName(serverName))
+
+	conn, err := dtls.DialWithOptions("udp", raddr, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DEFAULT_DIAL_TIMEOUT)
+	defer cancel()
+	if err := conn.HandshakeContext(ctx); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// isAuthenticationError reports whether err is a failure of the mutual
+// authentication with the collector, either on verifying the collector
+// certificate or on the collector rejecting ours.
+func isAuthenticationError(err error) bool {
+	var (
+		certErr      *tls.CertificateVerificationError
+		unknownAuth  x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		certInvalid  x509.CertificateInvalidError
+		alertErr     tls.AlertError
+		opErr        *net.OpError
+		dtlsAlertErr interface{ IsFatalOrCloseNotify() bool }
+	)
+	switch {
+	case errors.As(err, &certErr), errors.As(err, &unknownAuth), errors.As(err, &hostnameErr), errors.As(err, &certInvalid):
+		return true
+	case errors.As(err, &alertErr):
+		return isAuthenticationAlert(uint8(alertErr))
+	case errors.As(err, &opErr) && opErr.Op == "remote error":
+		// Alerts sent by the collector, e.g. certificate_required after the
+		// TLS 1.3 handshake, are of an unexported type of crypto/tls that
+		// reads the same as tls.AlertError
+		for _, a := range authenticationAlerts {
+			if opErr.Err.Error() == tls.AlertError(a).Error() {
+				return true
+			}
+		}
+	case errors.As(err, &dtlsAlertErr):
+		// Fatal alerts of the DTLS handshake are failures to negotiate
+		// or authenticate, while a dead collector just times out
+		return dtlsAlertErr.IsFatalOrCloseNotify() && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return false
+}
+
+// bad_certificate, unsupported_certificate, certificate_revoked,
+// certificate_expired, certificate_unknown, unknown_ca, access_denied and
+// certificate_required (RFC8446 6.2)
+var authenticationAlerts = []uint8{42, 43, 44, 45, 46, 48, 49, 116}
+
+func isAuthenticationAlert(alert uint8) bool {
+	return slices.Contains(authenticationAlerts, alert)
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+	"github.com/pion/dtls/v3"
+)
+
+// testCertificate returns a self-signed certificate for 127.0.0.1 and a pool trusting it.
+func testCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fluvia test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: cert}, pool
+}
+
+func runTestExporter(t *testing.T, address string, opts ExporterOptions) (chan []ipfix.FieldValue, chan error) {
+	t.Helper()
+	opts.ReconnectInterval = 10 * time.Millisecond
+	e := NewExporter(opts)
+	flowChan := make(chan []ipfix.FieldValue)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- e.Run(address, flowChan)
+	}()
+	return flowChan, errChan
+}
+
+func readTestMessage(t *testing.T, conn net.Conn) *ipfix.Message {
+	t.Helper()
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]uint8, ipfix.MAX_MESSAGE_LENGTH)
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := ipfix.NewSession(0).DecodeMessage(buf[:n])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestExporterTLS(t *testing.T) {
+	cert, pool := testCertificate(t)
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			t.Errorf("failed to close listener: %v", err)
+		}
+	}()
+
+	flowChan, _ := runTestExporter(t, ln.Addr().String(), ExporterOptions{
+		Transport: TRANSPORT_TLS,
+		TLSConfig: &tls.Config{Certificates: []tls.Certificate{cert}, RootCAs: pool, MinVersion: tls.VersionTLS12},
+	})
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			t.Errorf("failed to close connection: %v", err)
+		}
+	}()
+	if err := conn.(*tls.Conn).Handshake(); err != nil {
+		t.Fatal(err)
+	}
+
+	flowChan <- testFixedFlow(1)
+	if m := readTestMessage(t, conn); len(m.Sets) != 2 {
+		t.Errorf("got %d sets want %d", len(m.Sets), 2)
+	}
+}
+
+func TestExporterTLSAuthenticationFailure(t *testing.T) {
+	cert, pool := testCertificate(t)
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			t.Errorf("failed to close listener: %v", err)
+		}
+	}()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			// The handshake fails as the exporter presents no certificate
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	// The exporter does not present its certificate
+	_, errChan := runTestExporter(t, ln.Addr().String(), ExporterOptions{
+		Transport: TRANSPORT_TLS,
+		TLSConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
+	})
+
+	select {
+	case err := <-errChan:
+		if !isAuthenticationError(err) {
+			t.Errorf("got %v want an authentication error", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("authentication failure is not reported")
+	}
+}
+
+func TestExporterDTLS(t *testing.T) {
+	cert, pool := testCertificate(t)
+	ln, err := dtls.ListenWithOptions("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)},
+		dtls.WithCertificates(cert),
+		dtls.WithClientCAs(pool),
+		dtls.WithClientAuth(dtls.RequireAndVerifyClientCert),
+		dtls.WithExtendedMasterSecret(dtls.RequireExtendedMasterSecret),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := ln.Close(); err != nil {
+			t.Errorf("failed to close listener: %v", err)
+		}
+	}()
+
+	flowChan, _ := runTestExporter(t, ln.Addr().String(), ExporterOptions{
+		Transport: TRANSPORT_DTLS,
+		TLSConfig: &tls.Config{Certificates: []tls.Certificate{cert}, RootCAs: pool, MinVersion: tls.VersionTLS12},
+	})
+
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			t.Errorf("failed to close connection: %v", err)
+		}
+	}()
+	if err := conn.(*dtls.Conn).Handshake(); err != nil {
+		t.Fatal(err)
+	}
+
+	flowChan <- testFixedFlow(1)
+	if m := readTestMessage(t, conn); len(m.Sets) != 2 {
+		t.Errorf("got %d sets want %d", len(m.Sets), 2)
+	}
+}
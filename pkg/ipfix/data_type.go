@@ -0,0 +1,206 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package ipfix
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"time"
+)
+
+// Encodings of the abstract data types (RFC7011 6.1) for the field values,
+// appended to a buffer. The decoders expect data of the length checked by
+// the caller.
+
+const ( // RFC7011 6.1.5
+	BOOLEAN_TRUE  uint8 = 1
+	BOOLEAN_FALSE uint8 = 2
+)
+
+// Seconds from the NTP epoch (1900-01-01) to the UNIX epoch
+const NTP_EPOCH_OFFSET = 2208988800
+
+// checkReducedSize checks the length of data of an abstract data type that
+// may be encoded in fewer octets (RFC7011 6.2).
+func checkReducedSize(data []uint8, t DataType) error {
+	if len(data) > math.MaxUint16 || !t.ValidLength(uint16(len(data))) {
+		return fmt.Errorf("invalid field length of %s: %d", t, len(data))
+	}
+	return nil
+}
+
+// reducedSize returns the length of the field value decoded from data, which
+// is zero unless data is of reduced size.
+func reducedSize(data []uint8, t DataType) uint16 {
+	if uint16(len(data)) == t.DefaultLength() {
+		return 0
+	}
+	return uint16(len(data))
+}
+
+// appendUnsigned appends the low length octets of v (RFC7011 6.1.1). The
+// exporter is responsible for v fitting in length octets.
+func appendUnsigned(dst []uint8, v uint64, length uint16) []uint8 {
+	for i := int(length) - 1; i >= 0; i-- {
+		dst = append(dst, uint8(v>>(8*i)))
+	}
+	return dst
+}
+
+func decodeUnsigned(data []uint8) uint64 {
+	var v uint64
+	for _, b := range data {
+		v = v<<8 | uint64(b)
+	}
+	return v
+}
+
+// appendSigned appends the low length octets of v in two's complement
+// (RFC7011 6.1.2). The exporter is responsible for v fitting in length octets.
+func appendSigned(dst []uint8, v int64, length uint16) []uint8 {
+	return appendUnsigned(dst, uint64(v), length)
+}
+
+func decodeSigned(data []uint8) int64 {
+	shift := 64 - 8*len(data)
+	return int64(decodeUnsigned(data)<<shift) >> shift
+}
+
+// appendFloat64 appends v in IEEE 754 (RFC7011 6.1.3), as float32 if
+// length is 4.
+func appendFloat64(dst []uint8, v float64, length uint16) []uint8 {
+	if length == 4 {
+		return binary.BigEndian.AppendUint32(dst, math.Float32bits(float32(v)))
+	}
+	return binary.BigEndian.AppendUint64(dst, math.Float64bits(v))
+}
+
+func decodeFloat64(data []uint8) float64 {
+	if len(data) == 4 {
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(data)))
+	}
+	return math.Float64frombits(binary.BigEndian.Uint64(data))
+}
+
+func appendBoolean(dst []uint8, b bool) []uint8 {
+	if b {
+		return append(dst, BOOLEAN_TRUE)
+	}
+	return append(dst, BOOLEAN_FALSE)
+}
+
+func decodeBoolean(data []uint8) bool {
+	return data[0] == BOOLEAN_TRUE
+}
+
+// appendMacAddress appends a 48-bit MAC address (RFC7011 6.1.4), which is
+// zero if addr is not.
+func appendMacAddress(dst []uint8, addr net.HardwareAddr) []uint8 {
+	if len(addr) != 6 {
+		return append(dst, 0, 0, 0, 0, 0, 0)
+	}
+	return append(dst, addr...)
+}
+
+func decodeMacAddress(data []uint8) net.HardwareAddr {
+	return net.HardwareAddr(append([]uint8{}, data...))
+}
+
+// appendIPv4Address appends an IPv4 address (RFC7011 6.1.11), which is
+// 0.0.0.0 if addr is not.
+func appendIPv4Address(dst []uint8, addr netip.Addr) []uint8 {
+	addr = addr.Unmap()
+	if !addr.Is4() {
+		return append(dst, 0, 0, 0, 0)
+	}
+	a := addr.As4()
+	return append(dst, a[:]...)
+}
+
+// appendIPv6Address appends an IPv6 address (RFC7011 6.1.12), which is ::
+// if addr is invalid. IPv4 addresses are encoded as IPv4-mapped addresses.
+func appendIPv6Address(dst []uint8, addr netip.Addr) []uint8 {
+	var a [16]uint8
+	if addr.IsValid() {
+		a = addr.As16()
+	}
+	return append(dst, a[:]...)
+}
+
+// appendDateTimeSeconds appends t as seconds since the UNIX epoch
+// (RFC7011 6.1.7). The zero time is encoded as the epoch.
+func appendDateTimeSeconds(dst []uint8, t time.Time) []uint8 {
+	if t.IsZero() {
+		return binary.BigEndian.AppendUint32(dst, 0)
+	}
+	return binary.BigEndian.AppendUint32(dst, uint32(t.Unix()))
+}
+
+func decodeDateTimeSeconds(data []uint8) time.Time {
+	s := binary.BigEndian.Uint32(data)
+	if s == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(s), 0)
+}
+
+// appendDateTimeMilliseconds appends t as milliseconds since the UNIX epoch
+// (RFC7011 6.1.8). The zero time is encoded as the epoch.
+func appendDateTimeMilliseconds(dst []uint8, t time.Time) []uint8 {
+	if t.IsZero() {
+		return binary.BigEndian.AppendUint64(dst, 0)
+	}
+	return binary.BigEndian.AppendUint64(dst, uint64(t.UnixMilli()))
+}
+
+func decodeDateTimeMilliseconds(data []uint8) time.Time {
+	ms := binary.BigEndian.Uint64(data)
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(int64(ms))
+}
+
+// appendDateTimeMicroseconds appends t rounded to microseconds in the NTP
+// Timestamp Format, whose 11 lowest bits of the fraction are zero
+// (RFC7011 6.1.9). The zero time is encoded as zero.
+func appendDateTimeMicroseconds(dst []uint8, t time.Time) []uint8 {
+	dst = appendDateTimeNanoseconds(dst, t.Round(time.Microsecond))
+	dst[len(dst)-2] &= 0xf8
+	dst[len(dst)-1] = 0
+	return dst
+}
+
+// appendDateTimeNanoseconds appends t in the NTP Timestamp Format
+// (RFC7011 6.1.10). The zero time is encoded as zero.
+func appendDateTimeNanoseconds(dst []uint8, t time.Time) []uint8 {
+	if t.IsZero() {
+		return binary.BigEndian.AppendUint64(dst, 0)
+	}
+	dst = binary.BigEndian.AppendUint32(dst, uint32(t.Unix()+NTP_EPOCH_OFFSET))
+	return binary.BigEndian.AppendUint32(dst, uint32((uint64(t.Nanosecond())<<32)/uint64(time.Second)))
+}
+
+func decodeDateTimeMicroseconds(data []uint8) time.Time {
+	t := decodeDateTimeNanoseconds(data)
+	if t.IsZero() {
+		return t
+	}
+	return t.Round(time.Microsecond)
+}
+
+func decodeDateTimeNanoseconds(data []uint8) time.Time {
+	s := binary.BigEndian.Uint32(data[0:4])
+	frac := binary.BigEndian.Uint32(data[4:8])
+	if s == 0 && frac == 0 {
+		return time.Time{}
+	}
+	ns := (uint64(frac)*uint64(time.Second) + 1<<31) >> 32
+	return time.Unix(int64(s)-NTP_EPOCH_OFFSET, int64(ns))
+}
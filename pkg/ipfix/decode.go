@@ -0,0 +1,302 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package ipfix
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+var ErrTruncated = errors.New("ipfix: truncated data")
+
+// RawRecord holds the undecoded content of a Data Set whose Template is not
+// known to the decoder, including any trailing padding.
+type RawRecord struct {
+	Value []uint8
+}
+
+func (r *RawRecord) Serialize() []uint8 {
+	return r.Value
+}
+
+func (r *RawRecord) AppendTo(dst []uint8) []uint8 {
+	return append(dst, r.Value...)
+}
+
+func (r *RawRecord) Len() uint16 {
+	return uint16(len(r.Value))
+}
+
+// DecodeMessage decodes an IPFIX Message (RFC7011 3.) from data.
+// Template Sets and Options Template Sets are decoded into TemplateRecord and
+// OptionsTemplateRecord. Data Sets cannot be split into records without the
+// corresponding Template, so the content of each Data Set is kept as a single
+// RawRecord. Use DecodeDataRecords to decode it once the Template is known.
+func DecodeMessage(data []uint8) (*Message, error) {
+	if len(data) < 16 {
+		return nil, fmt.Errorf("message header is less than 16 bytes: %w", ErrTruncated)
+	}
+
+	m := &Message{
+		Version:             binary.BigEndian.Uint16(data[0:2]),
+		ExportTime:          binary.BigEndian.Uint32(data[4:8]),
+		SequenceNumber:      binary.BigEndian.Uint32(data[8:12]),
+		ObservationDomainID: binary.BigEndian.Uint32(data[12:16]),
+	}
+	if m.Version != IPFIX_VERSION {
+		return nil, fmt.Errorf("unsupported version number: %d", m.Version)
+	}
+
+	length := int(binary.BigEndian.Uint16(data[2:4]))
+	if length < 16 {
+		return nil, fmt.Errorf("invalid message length: %d", length)
+	}
+	if length > len(data) {
+		return nil, fmt.Errorf("message length %d exceeds %d bytes: %w", length, len(data), ErrTruncated)
+	}
+
+	p := 16
+	for p < length {
+		s, n, err := DecodeSet(data[p:length])
+		if err != nil {
+			return nil, err
+		}
+		m.Sets = append(m.Sets, *s)
+		p += n
+	}
+
+	return m, nil
+}
+
+// DecodeSet decodes a Set (RFC7011 3.3) from the head of data and returns it
+// together with the number of bytes consumed.
+func DecodeSet(data []uint8) (*Set, int, error) {
+	if len(data) < 4 {
+		return nil, 0, fmt.Errorf("set header is less than 4 bytes: %w", ErrTruncated)
+	}
+
+	setID := binary.BigEndian.Uint16(data[0:2])
+	length := int(binary.BigEndian.Uint16(data[2:4]))
+	if length < 4 {
+		return nil, 0, fmt.Errorf("invalid set length: %d", length)
+	}
+	if length > len(data) {
+		return nil, 0, fmt.Errorf("set length %d exceeds %d bytes: %w", length, len(data), ErrTruncated)
+	}
+	body := data[4:length]
+
+	s := &Set{SetID: setID}
+	switch {
+	case setID == TEMPLATE_SETS_ID:
+		records, err := decodeTemplateRecords(body)
+		if err != nil {
+			return nil, 0, err
+		}
+		s.Records = records
+	case setID == OPTIONS_TEMPLATE_SETS_ID:
+		records, err := decodeOptionsTemplateRecords(body)
+		if err != nil {
+			return nil, 0, err
+		}
+		s.Records = records
+	case setID >= MIN_DATA_SETS_ID:
+		s.Records = []Record{&RawRecord{Value: body}}
+	default:
+		return nil, 0, fmt.Errorf("invalid set id: %d", setID)
+	}
+
+	return s, length, nil
+}
+
+// DecodeFieldSpecifier decodes a Field Specifier (RFC7011 3.2) from the head
+// of data and returns it together with the number of bytes consumed.
+func DecodeFieldSpecifier(data []uint8) (*FieldSpecifier, int, error) {
+	if len(data) < 4 {
+		return nil, 0, fmt.Errorf("field specifier is less than 4 bytes: %w", ErrTruncated)
+	}
+
+	fs := &FieldSpecifier{
+		E:                    data[0]&(1<<7) != 0,
+		InformationElementID: binary.BigEndian.Uint16(data[0:2]) &^ (1 << 15),
+		FieldLength:          binary.BigEndian.Uint16(data[2:4]),
+	}
+	if !fs.E {
+		return fs, 4, nil
+	}
+
+	if len(data) < 8 {
+		return nil, 0, fmt.Errorf("enterprise field specifier is less than 8 bytes: %w", ErrTruncated)
+	}
+	fs.EnterpriseNumber = binary.BigEndian.Uint32(data[4:8])
+
+	return fs, 8, nil
+}
+
+func decodeFieldSpecifiers(data []uint8, count int) ([]FieldSpecifier, int, error) {
+	fss := make([]FieldSpecifier, 0, count)
+	p := 0
+	for i := 0; i < count; i++ {
+		fs, n, err := DecodeFieldSpecifier(data[p:])
+		if err != nil {
+			return nil, 0, err
+		}
+		fss = append(fss, *fs)
+		p += n
+	}
+	return fss, p, nil
+}
+
+// DecodeTemplateRecord decodes a Template Record (RFC7011 3.4.1) from the head
+// of data and returns it together with the number of bytes consumed.
+// A Template Withdrawal (RFC7011 8.1) is returned as a TemplateRecord without
+// any FieldSpecifiers.
+func DecodeTemplateRecord(data []uint8) (*TemplateRecord, int, error) {
+	if len(data) < 4 {
+		return nil, 0, fmt.Errorf("template record header is less than 4 bytes: %w", ErrTruncated)
+	}
+
+	templateID := binary.BigEndian.Uint16(data[0:2])
+	fieldCount := int(binary.BigEndian.Uint16(data[2:4]))
+
+	fss, n, err := decodeFieldSpecifiers(data[4:], fieldCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("template %d: %w", templateID, err)
+	}
+
+	return NewTemplateRecord(templateID, fss), 4 + n, nil
+}
+
+// DecodeOptionsTemplateRecord decodes an Options Template Record
+// (RFC7011 3.4.2.2) from the head of data and returns it together with the
+// number of bytes consumed.
+// An Options Template Withdrawal (RFC7011 8.1) is returned as an
+// OptionsTemplateRecord without any FieldSpecifiers.
+func DecodeOptionsTemplateRecord(data []uint8) (*OptionsTemplateRecord, int, error) {
+	if len(data) < 4 {
+		return nil, 0, fmt.Errorf("options template record header is less than 4 bytes: %w", ErrTruncated)
+	}
+
+	templateID := binary.BigEndian.Uint16(data[0:2])
+	fieldCount := int(binary.BigEndian.Uint16(data[2:4]))
+	if fieldCount == 0 {
+		return NewOptionTemplateRecord(templateID, 0, nil), 4, nil
+	}
+
+	if len(data) < 6 {
+		return nil, 0, fmt.Errorf("options template record header is less than 6 bytes: %w", ErrTruncated)
+	}
+	scopeFieldCount := binary.BigEndian.Uint16(data[4:6])
+	if scopeFieldCount == 0 || int(scopeFieldCount) > fieldCount {
+		return nil, 0, fmt.Errorf("options template %d: invalid scope field count: %d", templateID, scopeFieldCount)
+	}
+
+	fss, n, err := decodeFieldSpecifiers(data[6:], fieldCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("options template %d: %w", templateID, err)
+	}
+
+	return NewOptionTemplateRecord(templateID, scopeFieldCount, fss), 6 + n, nil
+}
+
+func decodeTemplateRecords(data []uint8) ([]Record, error) {
+	records := []Record{}
+	p := 0
+	// Anything shorter than a Template Record header is padding
+	for len(data)-p >= 4 {
+		r, n, err := DecodeTemplateRecord(data[p:])
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+		p += n
+	}
+	return records, nil
+}
+
+func decodeOptionsTemplateRecords(data []uint8) ([]Record, error) {
+	records := []Record{}
+	p := 0
+	// Anything shorter than an Options Template Withdrawal is padding
+	for len(data)-p >= 4 {
+		r, n, err := DecodeOptionsTemplateRecord(data[p:])
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+		p += n
+	}
+	return records, nil
+}
+
+// DecodeDataRecord decodes a Data Record (RFC7011 3.4.3) described by fss from
+// the head of data and returns it together with the number of bytes consumed.
+// Each field is decoded by DecodeFieldValue.
+func DecodeDataRecord(data []uint8, fss []FieldSpecifier) (*DataRecord, int, error) {
+	r := &DataRecord{FieldValues: make([]FieldValue, 0, len(fss))}
+	p := 0
+	for _, fs := range fss {
+		n, err := fieldLength(data[p:], fs)
+		if err != nil {
+			return nil, 0, err
+		}
+		r.FieldValues = append(r.FieldValues, DecodeFieldValue(data[p:p+n], fs))
+		p += n
+	}
+	return r, p, nil
+}
+
+// DecodeDataRecords decodes all Data Records described by fss from the
+// content of a Data Set. Trailing bytes shorter than a Data Record are
+// treated as padding.
+func DecodeDataRecords(data []uint8, fss []FieldSpecifier) ([]Record, error) {
+	minLen := minRecordLength(fss)
+	if minLen == 0 {
+		return nil, fmt.Errorf("template without any fields can not describe data records")
+	}
+
+	records := []Record{}
+	p := 0
+	for len(data)-p >= minLen {
+		r, n, err := DecodeDataRecord(data[p:], fss)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+		p += n
+	}
+	return records, nil
+}
+
+// fieldLength returns the encoded length of the field described by fs at the
+// head of data, including the length prefix of a variable-length field.
+func fieldLength(data []uint8, fs FieldSpecifier) (int, error) {
+	if fs.FieldLength != VARIABLE_LENGTH {
+		if len(data) < int(fs.FieldLength) {
+			return 0, fmt.Errorf("field %d is less than %d bytes: %w", fs.InformationElementID, fs.FieldLength, ErrTruncated)
+		}
+		return int(fs.FieldLength), nil
+	}
+
+	// RFC7011 7. Variable-Length Information Element
+	_, n, err := DecodeVariableLength(data)
+	if err != nil {
+		return 0, fmt.Errorf("variable-length field %d: %w", fs.InformationElementID, err)
+	}
+	return n, nil
+}
+
+func minRecordLength(fss []FieldSpecifier) int {
+	l := 0
+	for _, fs := range fss {
+		if fs.FieldLength == VARIABLE_LENGTH {
+			l++
+		} else {
+			l += int(fs.FieldLength)
+		}
+	}
+	return l
+}
@@ -0,0 +1,162 @@
+package ipfix
+
+import (
+	"bytes"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func testFieldValues() []FieldValue {
+	return []FieldValue{
+		&PacketDeltaCount{Val: 100},
+		&SRHActiveSegmentIPv6{Val: netip.MustParseAddr("2001:db8::1")},
+		&SRHFlagsIPv6{Val: 0xaa},
+		&SRHTagIPv6{Val: 0xaaaa},
+		&SRHSegmentIPv6ListSection{
+			SegmentList: []netip.Addr{
+				netip.MustParseAddr("2001:db8::1"),
+				netip.MustParseAddr("2001:db8::2"),
+				netip.MustParseAddr("2001:db8::3"),
+			},
+		},
+		&UndefinedFieldValue{
+			ElemID:           1,
+			Value:            []uint8{0x01, 0x02, 0x03, 0x04},
+			TemplateLen:      4,
+			EnterpriseNumber: ENTERPRISE_NUMBER_NTTCOM,
+		},
+	}
+}
+
+func testMessage() *Message {
+	fvs := testFieldValues()
+	var fss []FieldSpecifier
+	for _, fv := range fvs {
+		fss = append(fss, *fv.FieldSpecifier())
+	}
+
+	tempSet := NewSet(TEMPLATE_SETS_ID, []Record{NewTemplateRecord(256, fss)})
+	dataSet := NewSet(256, []Record{
+		&DataRecord{FieldValues: fvs},
+		&DataRecord{FieldValues: fvs},
+	})
+
+	m := NewMessage(1, 61166, []Set{*tempSet, *dataSet})
+	m.ExportTime = 0x6538d5f6
+	return m
+}
+
+func TestDecodeMessage(t *testing.T) {
+	expected := testMessage()
+	data := expected.Serialize()
+
+	m, err := DecodeMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.ExportTime != expected.ExportTime || m.SequenceNumber != expected.SequenceNumber || m.ObservationDomainID != expected.ObservationDomainID {
+		t.Errorf("got header %+v want %+v", m, expected)
+	}
+
+	if len(m.Sets) != 2 {
+		t.Fatalf("got %d sets want %d", len(m.Sets), 2)
+	}
+
+	tempRec, ok := m.Sets[0].Records[0].(*TemplateRecord)
+	if !ok {
+		t.Fatalf("got %T want *TemplateRecord", m.Sets[0].Records[0])
+	}
+	if tempRec.TemplateID != 256 {
+		t.Errorf("got template id %d want %d", tempRec.TemplateID, 256)
+	}
+	if !tempRec.FieldSpecifiers[5].E || tempRec.FieldSpecifiers[5].EnterpriseNumber != ENTERPRISE_NUMBER_NTTCOM {
+		t.Errorf("enterprise field specifier is not decoded: %+v", tempRec.FieldSpecifiers[5])
+	}
+
+	raw, ok := m.Sets[1].Records[0].(*RawRecord)
+	if !ok {
+		t.Fatalf("got %T want *RawRecord", m.Sets[1].Records[0])
+	}
+	records, err := DecodeDataRecords(raw.Value, tempRec.FieldSpecifiers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d data records want %d", len(records), 2)
+	}
+	m.Sets[1].Records = records
+
+	if actual := m.Serialize(); !bytes.Equal(actual, data) {
+		t.Errorf("round trip mismatch\nexpected: %x\nactual:   %x", data, actual)
+	}
+}
+
+func TestDecodeMessageTruncated(t *testing.T) {
+	data := testMessage().Serialize()
+
+	for _, l := range []int{0, 15, 20, len(data) - 1} {
+		if _, err := DecodeMessage(data[:l]); !errors.Is(err, ErrTruncated) {
+			t.Errorf("length %d: got %v want %v", l, err, ErrTruncated)
+		}
+	}
+}
+
+func TestDecodeTemplateWithdrawal(t *testing.T) {
+	set := NewSet(TEMPLATE_SETS_ID, []Record{NewTemplateRecord(256, nil)})
+
+	s, n, err := DecodeSet(set.Serialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int(set.Len()) {
+		t.Errorf("got %d bytes consumed want %d", n, set.Len())
+	}
+	if len(s.Records) != 1 {
+		t.Fatalf("got %d records want %d", len(s.Records), 1)
+	}
+	if r := s.Records[0].(*TemplateRecord); r.TemplateID != 256 || len(r.FieldSpecifiers) != 0 {
+		t.Errorf("got %+v want withdrawal of template 256", r)
+	}
+}
+
+func TestDecodeOptionsTemplateRecord(t *testing.T) {
+	fvs := []FieldValue{
+		&ObservationDomainId{Val: 61166},
+		&NotSentFlowTotalCount{Val: 10},
+		&FlowStartMilliseconds{Val: time.UnixMilli(1698223606000)},
+		&FlowEndMilliseconds{},
+	}
+	var fss []FieldSpecifier
+	for _, fv := range fvs {
+		fss = append(fss, *fv.FieldSpecifier())
+	}
+	tempSet := NewSet(OPTIONS_TEMPLATE_SETS_ID, []Record{NewOptionTemplateRecord(256, 1, fss)})
+	dataSet := NewSet(256, []Record{&DataRecord{FieldValues: fvs}})
+
+	m, err := NewSession(0).DecodeMessage(NewMessage(1, 61166, []Set{*tempSet, *dataSet}).Serialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, ok := m.Sets[0].Records[0].(*OptionsTemplateRecord)
+	if !ok {
+		t.Fatalf("got %T want *OptionsTemplateRecord", m.Sets[0].Records[0])
+	}
+	if r.TemplateID != 256 || r.ScopeFieldCount != 1 || len(r.FieldSpecifiers) != len(fss) {
+		t.Errorf("got %+v want options template 256 with scope count 1", r)
+	}
+
+	got := m.Sets[1].Records[0].(*DataRecord).FieldValues
+	if v := got[1].(*NotSentFlowTotalCount).Val; v != 10 {
+		t.Errorf("got notSentFlowTotalCount %d want %d", v, 10)
+	}
+	if v := got[2].(*FlowStartMilliseconds).Val; !v.Equal(time.UnixMilli(1698223606000)) {
+		t.Errorf("got flowStartMilliseconds %s want %s", v, time.UnixMilli(1698223606000))
+	}
+	if v := got[3].(*FlowEndMilliseconds).Val; !v.IsZero() {
+		t.Errorf("got flowEndMilliseconds %s want the zero time", v)
+	}
+}
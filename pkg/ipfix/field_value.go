@@ -6,19 +6,30 @@
 package ipfix
 
 import (
-	"encoding/binary"
+	"fmt"
 	"net/netip"
+	"time"
 )
 
 type FieldValue interface {
 	Serialize() []uint8
-	Len() uint16 // binary length of field value
+	DecodeFromBytes(data []uint8) error // inverse of Serialize
+	Len() uint16                        // binary length of field value
 	ElementID() uint16
 	FieldSpecifier() *FieldSpecifier
 }
 
+func checkFieldLength(data []uint8, length int) error {
+	if len(data) != length {
+		return fmt.Errorf("invalid field length: got %d want %d", len(data), length)
+	}
+	return nil
+}
+
 type PacketDeltaCount struct {
 	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
 }
 
 func (fv *PacketDeltaCount) ElementID() uint16 {
@@ -26,13 +37,24 @@ func (fv *PacketDeltaCount) ElementID() uint16 {
 }
 
 func (fv *PacketDeltaCount) Serialize() []uint8 {
-	ret := make([]uint8, 8)
-	binary.BigEndian.PutUint64(ret, fv.Val)
-	return ret
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PacketDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PacketDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
 }
 
 func (fv *PacketDeltaCount) Len() uint16 {
-	return 8
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
 }
 
 func (fv *PacketDeltaCount) FieldSpecifier() *FieldSpecifier {
@@ -43,6 +65,8 @@ func (fv *PacketDeltaCount) FieldSpecifier() *FieldSpecifier {
 
 type SRHFlagsIPv6 struct {
 	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
 }
 
 func (fv *SRHFlagsIPv6) ElementID() uint16 {
@@ -50,11 +74,24 @@ func (fv *SRHFlagsIPv6) ElementID() uint16 {
 }
 
 func (fv *SRHFlagsIPv6) Serialize() []uint8 {
-	return []uint8{fv.Val}
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SRHFlagsIPv6) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SRHFlagsIPv6) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
 }
 
 func (fv *SRHFlagsIPv6) Len() uint16 {
-	return 1
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
 }
 
 func (fv *SRHFlagsIPv6) FieldSpecifier() *FieldSpecifier {
@@ -65,6 +102,8 @@ func (fv *SRHFlagsIPv6) FieldSpecifier() *FieldSpecifier {
 
 type SRHTagIPv6 struct {
 	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
 }
 
 func (fv *SRHTagIPv6) ElementID() uint16 {
@@ -72,13 +111,24 @@ func (fv *SRHTagIPv6) ElementID() uint16 {
 }
 
 func (fv *SRHTagIPv6) Serialize() []uint8 {
-	ret := make([]uint8, 2)
-	binary.BigEndian.PutUint16(ret, fv.Val)
-	return ret
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SRHTagIPv6) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SRHTagIPv6) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
 }
 
 func (fv *SRHTagIPv6) Len() uint16 {
-	return 2
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
 }
 
 func (fv *SRHTagIPv6) FieldSpecifier() *FieldSpecifier {
@@ -92,11 +142,23 @@ type SRHSegmentIPv6 struct {
 }
 
 func (fv *SRHSegmentIPv6) ElementID() uint16 {
-	return IEID_SRH_ACTIVE_SEGMENT_IPV6
+	return IEID_SRH_SEGMENT_IPV6
 }
 
 func (fv *SRHSegmentIPv6) Serialize() []uint8 {
-	return fv.Val.AsSlice()
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SRHSegmentIPv6) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *SRHSegmentIPv6) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
 }
 
 func (fv *SRHSegmentIPv6) Len() uint16 {
@@ -118,7 +180,19 @@ func (fv *SRHActiveSegmentIPv6) ElementID() uint16 {
 }
 
 func (fv *SRHActiveSegmentIPv6) Serialize() []uint8 {
-	return fv.Val.AsSlice()
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SRHActiveSegmentIPv6) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *SRHActiveSegmentIPv6) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
 }
 
 func (fv *SRHActiveSegmentIPv6) Len() uint16 {
@@ -131,6 +205,9 @@ func (fv *SRHActiveSegmentIPv6) FieldSpecifier() *FieldSpecifier {
 	return fs
 }
 
+// Field Specifier of the elements of SRHSegmentIPv6BasicList
+var srhSegmentIPv6Element = *(&SRHSegmentIPv6{}).FieldSpecifier()
+
 type SRHSegmentIPv6BasicList struct {
 	SegmentList []SRHSegmentIPv6
 }
@@ -140,36 +217,48 @@ func (fv *SRHSegmentIPv6BasicList) ElementID() uint16 {
 }
 
 func (fv *SRHSegmentIPv6BasicList) Serialize() []uint8 {
-	ret := []uint8{}
-
-	ret = append(ret, 255)
-
-	length := make([]uint8, 2)
-	binary.BigEndian.PutUint16(length, uint16(len(fv.SegmentList)*16+5))
-	ret = append(ret, length...)
-
-	ret = append(ret, 4) // ordered
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
 
-	subElemID := make([]uint8, 2)
-	binary.BigEndian.PutUint16(subElemID, IEID_SRH_SEGMENT_IPV6)
-	ret = append(ret, subElemID...)
+func (fv *SRHSegmentIPv6BasicList) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, 1+int(srhSegmentIPv6Element.Len())+16*len(fv.SegmentList))
+	dst = append(dst, SEMANTIC_ORDERED)
+	dst = srhSegmentIPv6Element.AppendTo(dst)
+	for i := range fv.SegmentList {
+		dst = fv.SegmentList[i].AppendTo(dst)
+	}
+	return dst
+}
 
-	subElemLength := make([]uint8, 2)
-	binary.BigEndian.PutUint16(subElemLength, 16) // SRv6 SID Length
-	ret = append(ret, subElemLength...)
+func (fv *SRHSegmentIPv6BasicList) DecodeFromBytes(data []uint8) error {
+	b := &BasicList{}
+	if err := b.DecodeFromBytes(data); err != nil {
+		return err
+	}
+	if b.Element.E || b.Element.InformationElementID != IEID_SRH_SEGMENT_IPV6 {
+		return fmt.Errorf("unexpected basicList element: %d", b.Element.InformationElementID)
+	}
+	if b.Element.FieldLength != 16 {
+		return fmt.Errorf("unexpected basicList element length: %d", b.Element.FieldLength)
+	}
 
-	for _, sl := range fv.SegmentList {
-		ret = append(ret, sl.Serialize()...)
+	fv.SegmentList = make([]SRHSegmentIPv6, 0, len(b.Values))
+	for _, v := range b.Values {
+		seg, ok := v.(*SRHSegmentIPv6)
+		if !ok {
+			return fmt.Errorf("invalid segment: %x", v.Serialize())
+		}
+		fv.SegmentList = append(fv.SegmentList, *seg)
 	}
-	return ret
+	return nil
 }
 
 func (fv *SRHSegmentIPv6BasicList) Len() uint16 {
-	return uint16(16*len(fv.SegmentList) + 6)
+	return VariableLengthLen(1 + int(srhSegmentIPv6Element.Len()) + 16*len(fv.SegmentList))
 }
 
 func (fv *SRHSegmentIPv6BasicList) FieldSpecifier() *FieldSpecifier {
-	templateLen := uint16(0xffff) // valiable
+	templateLen := VARIABLE_LENGTH
 	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
 	return fs
 }
@@ -183,29 +272,46 @@ func (fv *SRHSegmentIPv6ListSection) ElementID() uint16 {
 }
 
 func (fv *SRHSegmentIPv6ListSection) Serialize() []uint8 {
-	ret := []uint8{}
-
-	length := uint8(len(fv.SegmentList) * 16)
-	ret = append(ret, length)
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
 
+func (fv *SRHSegmentIPv6ListSection) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, 16*len(fv.SegmentList))
 	for _, sl := range fv.SegmentList {
-		ret = append(ret, sl.AsSlice()...)
+		dst = appendIPv6Address(dst, sl)
 	}
-	return ret
+	return dst
+}
+
+func (fv *SRHSegmentIPv6ListSection) DecodeFromBytes(data []uint8) error {
+	segments, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	if len(segments)%16 != 0 {
+		return fmt.Errorf("invalid segment list length: %d", len(segments))
+	}
+	fv.SegmentList = make([]netip.Addr, 0, len(segments)/16)
+	for i := 0; i < len(segments); i += 16 {
+		fv.SegmentList = append(fv.SegmentList, netip.AddrFrom16([16]uint8(segments[i:i+16])))
+	}
+	return nil
 }
 
 func (fv *SRHSegmentIPv6ListSection) Len() uint16 {
-	return uint16(16*len(fv.SegmentList) + 1)
+	return VariableLengthLen(16 * len(fv.SegmentList))
 }
 
 func (fv *SRHSegmentIPv6ListSection) FieldSpecifier() *FieldSpecifier {
-	templateLen := uint16(0xffff) // valiable
+	templateLen := VARIABLE_LENGTH
 	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
 	return fs
 }
 
 type SRHSegmentsIPv6Left struct {
 	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
 }
 
 func (fv *SRHSegmentsIPv6Left) ElementID() uint16 {
@@ -213,11 +319,24 @@ func (fv *SRHSegmentsIPv6Left) ElementID() uint16 {
 }
 
 func (fv *SRHSegmentsIPv6Left) Serialize() []uint8 {
-	return []uint8{fv.Val}
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SRHSegmentsIPv6Left) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SRHSegmentsIPv6Left) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
 }
 
 func (fv *SRHSegmentsIPv6Left) Len() uint16 {
-	return 1
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
 }
 
 func (fv *SRHSegmentsIPv6Left) FieldSpecifier() *FieldSpecifier {
@@ -228,6 +347,8 @@ func (fv *SRHSegmentsIPv6Left) FieldSpecifier() *FieldSpecifier {
 
 type SRHIPv6ActiveSegmentType struct {
 	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
 }
 
 func (fv *SRHIPv6ActiveSegmentType) ElementID() uint16 {
@@ -235,11 +356,24 @@ func (fv *SRHIPv6ActiveSegmentType) ElementID() uint16 {
 }
 
 func (fv *SRHIPv6ActiveSegmentType) Serialize() []uint8 {
-	return []uint8{fv.Val}
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SRHIPv6ActiveSegmentType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SRHIPv6ActiveSegmentType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
 }
 
 func (fv *SRHIPv6ActiveSegmentType) Len() uint16 {
-	return 1
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
 }
 
 func (fv *SRHIPv6ActiveSegmentType) FieldSpecifier() *FieldSpecifier {
@@ -250,6 +384,8 @@ func (fv *SRHIPv6ActiveSegmentType) FieldSpecifier() *FieldSpecifier {
 
 type SRHSegmentIPv6LocatorLength struct {
 	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
 }
 
 func (fv *SRHSegmentIPv6LocatorLength) ElementID() uint16 {
@@ -257,11 +393,24 @@ func (fv *SRHSegmentIPv6LocatorLength) ElementID() uint16 {
 }
 
 func (fv *SRHSegmentIPv6LocatorLength) Serialize() []uint8 {
-	return []uint8{fv.Val}
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SRHSegmentIPv6LocatorLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SRHSegmentIPv6LocatorLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
 }
 
 func (fv *SRHSegmentIPv6LocatorLength) Len() uint16 {
-	return 1
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
 }
 
 func (fv *SRHSegmentIPv6LocatorLength) FieldSpecifier() *FieldSpecifier {
@@ -272,6 +421,8 @@ func (fv *SRHSegmentIPv6LocatorLength) FieldSpecifier() *FieldSpecifier {
 
 type SRHSegmentIPv6EndpointBehavior struct {
 	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
 }
 
 func (fv *SRHSegmentIPv6EndpointBehavior) ElementID() uint16 {
@@ -279,13 +430,24 @@ func (fv *SRHSegmentIPv6EndpointBehavior) ElementID() uint16 {
 }
 
 func (fv *SRHSegmentIPv6EndpointBehavior) Serialize() []uint8 {
-	ret := make([]uint8, 2)
-	binary.BigEndian.PutUint16(ret, fv.Val)
-	return ret
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SRHSegmentIPv6EndpointBehavior) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SRHSegmentIPv6EndpointBehavior) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
 }
 
 func (fv *SRHSegmentIPv6EndpointBehavior) Len() uint16 {
-	return 2
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
 }
 
 func (fv *SRHSegmentIPv6EndpointBehavior) FieldSpecifier() *FieldSpecifier {
@@ -296,6 +458,8 @@ func (fv *SRHSegmentIPv6EndpointBehavior) FieldSpecifier() *FieldSpecifier {
 
 type PathDelayMeanDeltaMicroseconds struct {
 	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
 }
 
 func (fv *PathDelayMeanDeltaMicroseconds) ElementID() uint16 {
@@ -303,13 +467,24 @@ func (fv *PathDelayMeanDeltaMicroseconds) ElementID() uint16 {
 }
 
 func (fv *PathDelayMeanDeltaMicroseconds) Serialize() []uint8 {
-	ret := make([]uint8, 4)
-	binary.BigEndian.PutUint32(ret, fv.Val)
-	return ret
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PathDelayMeanDeltaMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PathDelayMeanDeltaMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
 }
 
 func (fv *PathDelayMeanDeltaMicroseconds) Len() uint16 {
-	return 4
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
 }
 
 func (fv *PathDelayMeanDeltaMicroseconds) FieldSpecifier() *FieldSpecifier {
@@ -320,6 +495,8 @@ func (fv *PathDelayMeanDeltaMicroseconds) FieldSpecifier() *FieldSpecifier {
 
 type PathDelayMinDeltaMicroseconds struct {
 	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
 }
 
 func (fv *PathDelayMinDeltaMicroseconds) ElementID() uint16 {
@@ -327,13 +504,24 @@ func (fv *PathDelayMinDeltaMicroseconds) ElementID() uint16 {
 }
 
 func (fv *PathDelayMinDeltaMicroseconds) Serialize() []uint8 {
-	ret := make([]uint8, 4)
-	binary.BigEndian.PutUint32(ret, fv.Val)
-	return ret
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PathDelayMinDeltaMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PathDelayMinDeltaMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
 }
 
 func (fv *PathDelayMinDeltaMicroseconds) Len() uint16 {
-	return 4
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
 }
 
 func (fv *PathDelayMinDeltaMicroseconds) FieldSpecifier() *FieldSpecifier {
@@ -344,6 +532,8 @@ func (fv *PathDelayMinDeltaMicroseconds) FieldSpecifier() *FieldSpecifier {
 
 type PathDelayMaxDeltaMicroseconds struct {
 	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
 }
 
 func (fv *PathDelayMaxDeltaMicroseconds) ElementID() uint16 {
@@ -351,13 +541,24 @@ func (fv *PathDelayMaxDeltaMicroseconds) ElementID() uint16 {
 }
 
 func (fv *PathDelayMaxDeltaMicroseconds) Serialize() []uint8 {
-	ret := make([]uint8, 4)
-	binary.BigEndian.PutUint32(ret, fv.Val)
-	return ret
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PathDelayMaxDeltaMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PathDelayMaxDeltaMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
 }
 
 func (fv *PathDelayMaxDeltaMicroseconds) Len() uint16 {
-	return 4
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
 }
 
 func (fv *PathDelayMaxDeltaMicroseconds) FieldSpecifier() *FieldSpecifier {
@@ -368,6 +569,8 @@ func (fv *PathDelayMaxDeltaMicroseconds) FieldSpecifier() *FieldSpecifier {
 
 type PathDelaySumDeltaMicroseconds struct {
 	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
 }
 
 func (fv *PathDelaySumDeltaMicroseconds) ElementID() uint16 {
@@ -375,13 +578,24 @@ func (fv *PathDelaySumDeltaMicroseconds) ElementID() uint16 {
 }
 
 func (fv *PathDelaySumDeltaMicroseconds) Serialize() []uint8 {
-	ret := make([]uint8, 4)
-	binary.BigEndian.PutUint32(ret, fv.Val)
-	return ret
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PathDelaySumDeltaMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PathDelaySumDeltaMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
 }
 
 func (fv *PathDelaySumDeltaMicroseconds) Len() uint16 {
-	return 4
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
 }
 
 func (fv *PathDelaySumDeltaMicroseconds) FieldSpecifier() *FieldSpecifier {
@@ -390,6 +604,333 @@ func (fv *PathDelaySumDeltaMicroseconds) FieldSpecifier() *FieldSpecifier {
 	return fs
 }
 
+type ObservationDomainId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *ObservationDomainId) ElementID() uint16 {
+	return IEID_OBSERVATION_DOMAIN_ID
+}
+
+func (fv *ObservationDomainId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ObservationDomainId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ObservationDomainId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *ObservationDomainId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *ObservationDomainId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+type ExportedOctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *ExportedOctetTotalCount) ElementID() uint16 {
+	return IEID_EXPORTED_OCTET_TOTAL_COUNT
+}
+
+func (fv *ExportedOctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExportedOctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *ExportedOctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *ExportedOctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *ExportedOctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+type ExportedMessageTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *ExportedMessageTotalCount) ElementID() uint16 {
+	return IEID_EXPORTED_MESSAGE_TOTAL_COUNT
+}
+
+func (fv *ExportedMessageTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExportedMessageTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *ExportedMessageTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *ExportedMessageTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *ExportedMessageTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+type ExportedFlowRecordTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *ExportedFlowRecordTotalCount) ElementID() uint16 {
+	return IEID_EXPORTED_FLOW_RECORD_TOTAL_COUNT
+}
+
+func (fv *ExportedFlowRecordTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExportedFlowRecordTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *ExportedFlowRecordTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *ExportedFlowRecordTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *ExportedFlowRecordTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+type PacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PacketTotalCount) ElementID() uint16 {
+	return IEID_PACKET_TOTAL_COUNT
+}
+
+func (fv *PacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+type IgnoredPacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *IgnoredPacketTotalCount) ElementID() uint16 {
+	return IEID_IGNORED_PACKET_TOTAL_COUNT
+}
+
+func (fv *IgnoredPacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IgnoredPacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *IgnoredPacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *IgnoredPacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *IgnoredPacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+type NotSentFlowTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *NotSentFlowTotalCount) ElementID() uint16 {
+	return IEID_NOT_SENT_FLOW_TOTAL_COUNT
+}
+
+func (fv *NotSentFlowTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NotSentFlowTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *NotSentFlowTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *NotSentFlowTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *NotSentFlowTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+type FlowStartMilliseconds struct {
+	Val time.Time
+}
+
+func (fv *FlowStartMilliseconds) ElementID() uint16 {
+	return IEID_FLOW_START_MILLISECONDS
+}
+
+func (fv *FlowStartMilliseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowStartMilliseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMilliseconds(dst, fv.Val)
+}
+
+func (fv *FlowStartMilliseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMilliseconds(data)
+	return nil
+}
+
+func (fv *FlowStartMilliseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *FlowStartMilliseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+type FlowEndMilliseconds struct {
+	Val time.Time
+}
+
+func (fv *FlowEndMilliseconds) ElementID() uint16 {
+	return IEID_FLOW_END_MILLISECONDS
+}
+
+func (fv *FlowEndMilliseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowEndMilliseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMilliseconds(dst, fv.Val)
+}
+
+func (fv *FlowEndMilliseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMilliseconds(data)
+	return nil
+}
+
+func (fv *FlowEndMilliseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *FlowEndMilliseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
 type UndefinedFieldValue struct {
 	ElemID           uint16
 	Value            []uint8
@@ -405,6 +946,15 @@ func (fv *UndefinedFieldValue) Serialize() []uint8 {
 	return fv.Value
 }
 
+func (fv *UndefinedFieldValue) AppendTo(dst []uint8) []uint8 {
+	return append(dst, fv.Value...)
+}
+
+func (fv *UndefinedFieldValue) DecodeFromBytes(data []uint8) error {
+	fv.Value = data
+	return nil
+}
+
 func (fv *UndefinedFieldValue) Len() uint16 {
 	return uint16(len(fv.Value))
 }
@@ -419,8 +969,79 @@ func (fv *UndefinedFieldValue) FieldSpecifier() *FieldSpecifier {
 	return fs
 }
 
+var fieldValueTypes = map[uint16]func() FieldValue{
+	IEID_PACKET_DELTA_COUNT:                 func() FieldValue { return &PacketDeltaCount{} },
+	IEID_SRH_FLAGS_IPV6:                     func() FieldValue { return &SRHFlagsIPv6{} },
+	IEID_SRH_TAG_IPV6:                       func() FieldValue { return &SRHTagIPv6{} },
+	IEID_SRH_SEGMENT_IPV6:                   func() FieldValue { return &SRHSegmentIPv6{} },
+	IEID_SRH_ACTIVE_SEGMENT_IPV6:            func() FieldValue { return &SRHActiveSegmentIPv6{} },
+	IEID_SRH_SEGMENT_IPV6_BASIC_LIST:        func() FieldValue { return &SRHSegmentIPv6BasicList{} },
+	IEID_SRH_SEGMENT_IPV6_LIST_SECTION:      func() FieldValue { return &SRHSegmentIPv6ListSection{} },
+	IEID_SRH_SEGMENT_IPV6_LEFT:              func() FieldValue { return &SRHSegmentsIPv6Left{} },
+	IEID_SRH_IPV6_ACTIVE_SEGMENT_TYPE:       func() FieldValue { return &SRHIPv6ActiveSegmentType{} },
+	IEID_SRH_SEGMENT_IPV6_LOCATOR_LENGTH:    func() FieldValue { return &SRHSegmentIPv6LocatorLength{} },
+	IEID_SRH_SEGMENT_IPV6_ENDPOINT_BEHAVIOR: func() FieldValue { return &SRHSegmentIPv6EndpointBehavior{} },
+	IEID_PATH_DELAY_MEAN_DALTA_MICROSECONDS: func() FieldValue { return &PathDelayMeanDeltaMicroseconds{} },
+	IEID_PATH_DELAY_MIN_DALTA_MICROSECONDS:  func() FieldValue { return &PathDelayMinDeltaMicroseconds{} },
+	IEID_PATH_DELAY_MAX_DALTA_MICROSECONDS:  func() FieldValue { return &PathDelayMaxDeltaMicroseconds{} },
+	IEID_PATH_DELAY_SUM_DALTA_MICROSECONDS:  func() FieldValue { return &PathDelaySumDeltaMicroseconds{} },
+	IEID_BASIC_LIST:                         func() FieldValue { return &BasicList{} },
+	IEID_SUB_TEMPLATE_LIST:                  func() FieldValue { return &SubTemplateList{} },
+	IEID_SUB_TEMPLATE_MULTI_LIST:            func() FieldValue { return &SubTemplateMultiList{} },
+	IEID_OBSERVATION_DOMAIN_ID:              func() FieldValue { return &ObservationDomainId{} },
+	IEID_EXPORTED_OCTET_TOTAL_COUNT:         func() FieldValue { return &ExportedOctetTotalCount{} },
+	IEID_EXPORTED_MESSAGE_TOTAL_COUNT:       func() FieldValue { return &ExportedMessageTotalCount{} },
+	IEID_EXPORTED_FLOW_RECORD_TOTAL_COUNT:   func() FieldValue { return &ExportedFlowRecordTotalCount{} },
+	IEID_PACKET_TOTAL_COUNT:                 func() FieldValue { return &PacketTotalCount{} },
+	IEID_IGNORED_PACKET_TOTAL_COUNT:         func() FieldValue { return &IgnoredPacketTotalCount{} },
+	IEID_NOT_SENT_FLOW_TOTAL_COUNT:          func() FieldValue { return &NotSentFlowTotalCount{} },
+	IEID_FLOW_START_MILLISECONDS:            func() FieldValue { return &FlowStartMilliseconds{} },
+	IEID_FLOW_END_MILLISECONDS:              func() FieldValue { return &FlowEndMilliseconds{} },
+}
+
+// DecodeFieldValue decodes the encoded field data described by fs into its
+// typed FieldValue, which is written by hand or generated from the IANA
+// registry. Enterprise-specific fields, unknown fields and fields that do
+// not match the encoding of their typed FieldValue are returned as an
+// UndefinedFieldValue.
+func DecodeFieldValue(data []uint8, fs FieldSpecifier) FieldValue {
+	newFieldValue, ok := fieldValueTypes[fs.InformationElementID]
+	if !ok {
+		newFieldValue, ok = ianaFieldValueTypes[fs.InformationElementID]
+	}
+	if ok && !fs.E {
+		fv := newFieldValue()
+		if err := fv.DecodeFromBytes(data); err == nil {
+			return fv
+		}
+	}
+
+	return &UndefinedFieldValue{
+		ElemID:           fs.InformationElementID,
+		Value:            data,
+		TemplateLen:      fs.FieldLength,
+		EnterpriseNumber: fs.EnterpriseNumber,
+	}
+}
+
 const ENTERPRISE_NUMBER_NTTCOM uint32 = 29319 // NTT Communications
 
+const ( // Values of selectorAlgorithm (RFC5477 8.2.1)
+	SELECTOR_ALGORITHM_SYSTEMATIC_COUNT      uint16 = 1
+	SELECTOR_ALGORITHM_SYSTEMATIC_TIME       uint16 = 2
+	SELECTOR_ALGORITHM_RANDOM_N_OUT_OF_N     uint16 = 3
+	SELECTOR_ALGORITHM_UNIFORM_PROBABILISTIC uint16 = 4
+	SELECTOR_ALGORITHM_PROPERTY_MATCH        uint16 = 5
+	SELECTOR_ALGORITHM_HASH_BOB              uint16 = 6
+	SELECTOR_ALGORITHM_HASH_IPSX             uint16 = 7
+	SELECTOR_ALGORITHM_HASH_CRC              uint16 = 8
+)
+
+const ( // Values of samplingAlgorithm (RFC7270 4.)
+	SAMPLING_ALGORITHM_DETERMINISTIC uint8 = 1
+	SAMPLING_ALGORITHM_RANDOM        uint8 = 2
+)
+
 const (
 	IEID_OCTET_DELTA_COUNT                uint16 = 1  // RFC5102
 	IEID_PACKET_DELTA_COUNT               uint16 = 2  // RFC5102
@@ -712,8 +1333,8 @@ const (
 	IEID_DATA_LINK_FRAME_SECTION                    uint16 = 315 // RFC7133
 	IEID_MPLS_LABEL_STACK_SECTION                   uint16 = 316 // RFC5477, RFC7133
 	IEID_MPLS_PAYLOAD_PACKET_SECTION                uint16 = 317 // RFC5477, RFC7133
-	IEID_SELECTOR_ID_TOTAL_PKTS_OBSERVED            uint16 = 317 // RFC5477
-	IEID_SELECTOR_ID_TOTAL_PKTS_SELECTED            uint16 = 318 // RFC5477
+	IEID_SELECTOR_ID_TOTAL_PKTS_OBSERVED            uint16 = 318 // RFC5477
+	IEID_SELECTOR_ID_TOTAL_PKTS_SELECTED            uint16 = 319 // RFC5477
 	IEID_ABSOLUTE_ERROR                             uint16 = 320 // RFC5477
 	IEID_RELATIVE_ERROR                             uint16 = 321 // RFC5477
 	IEID_OBSERVATION_TIME_SECONDS                   uint16 = 322 // RFC5477
@@ -0,0 +1,50 @@
+package ipfix
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeReducedSizeFieldValue(t *testing.T) {
+	for _, tc := range []struct {
+		data []uint8
+		fs   *FieldSpecifier
+		want FieldValue
+	}{
+		{
+			data: []uint8{0x05, 0xdc},
+			fs:   NewFieldSpecifier(false, IEID_PACKET_DELTA_COUNT, 2, 0),
+			want: &PacketDeltaCount{Val: 1500, Length: 2},
+		},
+		{
+			data: []uint8{0, 0, 0, 0, 0, 0, 0x05, 0xdc},
+			fs:   NewFieldSpecifier(false, IEID_PACKET_DELTA_COUNT, 8, 0),
+			want: &PacketDeltaCount{Val: 1500},
+		},
+		{
+			data: []uint8{0x01, 0x00, 0x00},
+			fs:   NewFieldSpecifier(false, IEID_PATH_DELAY_MEAN_DALTA_MICROSECONDS, 3, 0),
+			want: &PathDelayMeanDeltaMicroseconds{Val: 0x10000, Length: 3},
+		},
+		{
+			data: []uint8{0x2a},
+			fs:   NewFieldSpecifier(false, IEID_SRH_TAG_IPV6, 1, 0),
+			want: &SRHTagIPv6{Val: 42, Length: 1},
+		},
+	} {
+		got := DecodeFieldValue(tc.data, *tc.fs)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%x as %d: got %+v want %+v", tc.data, tc.fs.InformationElementID, got, tc.want)
+		}
+		if !bytes.Equal(got.Serialize(), tc.data) {
+			t.Errorf("%+v: serialized %x want %x", got, got.Serialize(), tc.data)
+		}
+	}
+
+	// Longer than the data type
+	fs := NewFieldSpecifier(false, IEID_PACKET_DELTA_COUNT, 9, 0)
+	if fv := DecodeFieldValue(make([]uint8, 9), *fs); reflect.TypeOf(fv) != reflect.TypeOf(&UndefinedFieldValue{}) {
+		t.Errorf("got %+v for 9 octets of packetDeltaCount", fv)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package ipfix
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Layout of the time inserted into the names of IPFIX Files
+const FILE_TIME_LAYOUT = "20060102T150405"
+
+// ReadMessage reads a single IPFIX Message from a stream, i.e. a TCP
+// connection or an IPFIX File, using the Length field of the Message Header.
+func ReadMessage(r io.Reader) ([]uint8, error) {
+	header := make([]uint8, 4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	length := binary.BigEndian.Uint16(header[2:4])
+	if length < 16 {
+		return nil, fmt.Errorf("invalid message length: %d", length)
+	}
+
+	data := make([]uint8, length)
+	copy(data, header)
+	if _, err := io.ReadFull(r, data[4:]); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// FileWriter writes IPFIX Messages to IPFIX Files (RFC5655), which are the
+// serialized Messages back to back. The file is rotated to a new one once it
+// exceeds a size or an age. A new file must start with the templates of the
+// records it contains, as every file is read on its own (RFC5655).
+type FileWriter struct {
+	path           string
+	rotateSize     int64
+	rotateInterval time.Duration
+	f              *os.File
+	size           int64
+	openedAt       time.Time
+	now            func() time.Time
+}
+
+// NewFileWriter creates the first file from path, into which the time of
+// creation is inserted before the extension, e.g. flows-20231025T120000.ipfix
+// for flows.ipfix. Zero rotateSize or rotateInterval disables the rotation
+// by size or time respectively.
+func NewFileWriter(path string, rotateSize int64, rotateInterval time.Duration) (*FileWriter, error) {
+	w := &FileWriter{
+		path:           path,
+		rotateSize:     rotateSize,
+		rotateInterval: rotateInterval,
+		now:            time.Now,
+	}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *FileWriter) open() error {
+	now := w.now()
+	ext := filepath.Ext(w.path)
+	base := strings.TrimSuffix(w.path, ext) + "-" + now.Format(FILE_TIME_LAYOUT)
+
+	// Files rotated within a second are numbered
+	name := base + ext
+	for i := 1; ; i++ {
+		f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err == nil {
+			w.f = f
+			break
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return err
+		}
+		name = fmt.Sprintf("%s.%d%s", base, i, ext)
+	}
+
+	w.size = 0
+	w.openedAt = now
+	return nil
+}
+
+// Name returns the name of the current file.
+func (w *FileWriter) Name() string {
+	return w.f.Name()
+}
+
+func (w *FileWriter) Write(b []uint8) (int, error) {
+	n, err := w.f.Write(b)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *FileWriter) WriteMessage(m *Message) error {
+	_, err := w.Write(m.Serialize())
+	return err
+}
+
+// RotationDue reports whether the current file exceeds the size or the age
+// of rotation.
+func (w *FileWriter) RotationDue() bool {
+	if w.rotateSize > 0 && w.size >= w.rotateSize {
+		return true
+	}
+	if w.rotateInterval > 0 && w.now().Sub(w.openedAt) >= w.rotateInterval {
+		return true
+	}
+	return false
+}
+
+// Rotate closes the current file and creates a new one.
+func (w *FileWriter) Rotate() error {
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	return w.open()
+}
+
+func (w *FileWriter) Close() error {
+	return w.f.Close()
+}
+
+// FileReader reads the IPFIX Messages of an IPFIX File, decoding the data
+// sets with the templates found earlier in the file.
+type FileReader struct {
+	r       *bufio.Reader
+	session *Session
+}
+
+func NewFileReader(r io.Reader) *FileReader {
+	return &FileReader{
+		r: bufio.NewReader(r),
+		// Templates in a file never expire, as over a reliable transport
+		session: NewSession(0),
+	}
+}
+
+// Next returns the next Message, or io.EOF at the end of the file.
+func (r *FileReader) Next() (*Message, error) {
+	data, err := ReadMessage(r.r)
+	if err != nil {
+		return nil, err
+	}
+	return r.session.DecodeMessage(data)
+}
@@ -0,0 +1,103 @@
+package ipfix
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileWriterReader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flows.ipfix")
+	m := testMessage()
+	size := int64(m.Len())
+
+	w, err := NewFileWriter(path, 2*size, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2023, 10, 25, 12, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+
+	var names []string
+	for i := 0; i < 5; i++ {
+		if w.RotationDue() {
+			names = append(names, w.Name())
+			if err := w.Rotate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.WriteMessage(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	names = append(names, w.Name())
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Files rotated within the same second are numbered
+	if len(names) != 3 || filepath.Base(names[1]) != "flows-20231025T120000.ipfix" || filepath.Base(names[2]) != "flows-20231025T120000.1.ipfix" {
+		t.Errorf("got files %v want 3 files", names)
+	}
+
+	messages := 0
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := NewFileReader(f)
+		for {
+			got, err := r.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if !bytes.Equal(got.Serialize(), m.Serialize()) {
+				t.Errorf("%s: got %x want %x", name, got.Serialize(), m.Serialize())
+			}
+			messages++
+		}
+		if err := f.Close(); err != nil {
+			t.Errorf("failed to close file: %v", err)
+		}
+	}
+	if messages != 5 {
+		t.Errorf("got %d messages want %d", messages, 5)
+	}
+}
+
+func TestFileWriterRotateInterval(t *testing.T) {
+	w, err := NewFileWriter(filepath.Join(t.TempDir(), "flows.ipfix"), 0, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := w.Close(); err != nil {
+			t.Errorf("failed to close file: %v", err)
+		}
+	}()
+
+	now := w.openedAt
+	w.now = func() time.Time { return now }
+	if w.RotationDue() {
+		t.Errorf("a new file should not be rotated")
+	}
+	now = now.Add(time.Minute)
+	if !w.RotationDue() {
+		t.Errorf("a file should be rotated after %s", time.Minute)
+	}
+}
+
+func TestReadMessageTruncated(t *testing.T) {
+	data := testMessage().Serialize()
+	if _, err := ReadMessage(bytes.NewReader(data[:len(data)-1])); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("got %v want %v", err, io.ErrUnexpectedEOF)
+	}
+}
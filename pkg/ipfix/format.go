@@ -0,0 +1,430 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package ipfix
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Formatter renders Messages and records for debugging, as JSON whose keys
+// are in a fixed order and as a text tree like Wireshark's, naming the
+// Information Elements known by its Registry.
+type Formatter struct {
+	registry *Registry
+}
+
+// NewFormatter returns a Formatter naming the Information Elements of r, or
+// the IANA Information Elements and those of fluvia if r is nil.
+func NewFormatter(r *Registry) *Formatter {
+	if r == nil {
+		r = defaultRegistry()
+	}
+	return &Formatter{registry: r}
+}
+
+// FormattedMessage is a Message with the names and decoded values of its
+// fields.
+type FormattedMessage struct {
+	Version             uint16         `json:"version"`
+	Length              uint16         `json:"length"`
+	ExportTime          time.Time      `json:"exportTime"`
+	SequenceNumber      uint32         `json:"sequenceNumber"`
+	ObservationDomainID uint32         `json:"observationDomainId"`
+	Sets                []FormattedSet `json:"sets"`
+}
+
+type FormattedSet struct {
+	SetID   uint16            `json:"setId"`
+	Length  uint16            `json:"length"`
+	Records []FormattedRecord `json:"records"`
+}
+
+type FormattedRecord struct {
+	Type            string           `json:"type"` // template, optionsTemplate, data or unknown
+	TemplateID      uint16           `json:"templateId,omitempty"`
+	ScopeFieldCount uint16           `json:"scopeFieldCount,omitempty"`
+	Fields          []FormattedField `json:"fields,omitempty"`
+	Data            string           `json:"data,omitempty"` // hex of a record of an unknown template
+}
+
+// FormattedField is a Field Specifier of a template, with Length, or a
+// field value of a data record, with Value.
+type FormattedField struct {
+	Name             string `json:"name"`
+	ElementID        uint16 `json:"elementId"`
+	EnterpriseNumber uint32 `json:"enterpriseNumber,omitempty"`
+	Length           uint16 `json:"length,omitempty"`
+	Value            any    `json:"value,omitempty"`
+}
+
+// FormattedList is the value of structured data (RFC6313).
+type FormattedList struct {
+	Semantic   string             `json:"semantic"`
+	TemplateID uint16             `json:"templateId,omitempty"`
+	Values     []any              `json:"values,omitempty"`
+	Records    [][]FormattedField `json:"records,omitempty"`
+	Data       string             `json:"data,omitempty"` // hex of the records of an unknown template
+}
+
+// Name returns the name of an Information Element, or its ID if unknown,
+// e.g. "29319.1".
+func (f *Formatter) Name(enterpriseNumber uint32, elementID uint16) string {
+	if ie, ok := f.registry.Lookup(enterpriseNumber, elementID); ok {
+		return ie.Name
+	}
+	return elementIDString(enterpriseNumber, elementID)
+}
+
+func elementIDString(enterpriseNumber uint32, elementID uint16) string {
+	if enterpriseNumber != 0 {
+		return fmt.Sprintf("%d.%d", enterpriseNumber, elementID)
+	}
+	return fmt.Sprintf("%d", elementID)
+}
+
+// Message returns m with the names and decoded values of its fields.
+func (f *Formatter) Message(m *Message) FormattedMessage {
+	out := FormattedMessage{
+		Version:             m.Version,
+		Length:              m.Len(),
+		ExportTime:          time.Unix(int64(m.ExportTime), 0).UTC(),
+		SequenceNumber:      m.SequenceNumber,
+		ObservationDomainID: m.ObservationDomainID,
+		Sets:                make([]FormattedSet, 0, len(m.Sets)),
+	}
+	for i := range m.Sets {
+		s := &m.Sets[i]
+		fs := FormattedSet{SetID: s.SetID, Length: s.Len(), Records: make([]FormattedRecord, 0, len(s.Records))}
+		for _, r := range s.Records {
+			fs.Records = append(fs.Records, f.Record(r))
+		}
+		out.Sets = append(out.Sets, fs)
+	}
+	return out
+}
+
+// Record returns r with the names and decoded values of its fields.
+func (f *Formatter) Record(r Record) FormattedRecord {
+	var out FormattedRecord
+	switch r := r.(type) {
+	case *TemplateRecord:
+		out.Type = "template"
+		out.TemplateID = r.TemplateID
+		out.Fields = f.fieldSpecifiers(r.FieldSpecifiers)
+	case *OptionsTemplateRecord:
+		out.Type = "optionsTemplate"
+		out.TemplateID = r.TemplateID
+		out.ScopeFieldCount = r.ScopeFieldCount
+		out.Fields = f.fieldSpecifiers(r.FieldSpecifiers)
+	case *DataRecord:
+		out.Type = "data"
+		out.Fields = f.fieldValues(r.FieldValues)
+	default:
+		// The Template of the Data Set is not known
+		out.Type = "unknown"
+		out.Data = hex.EncodeToString(AppendRecord(nil, r))
+	}
+	return out
+}
+
+func (f *Formatter) fieldSpecifiers(fss []FieldSpecifier) []FormattedField {
+	outs := make([]FormattedField, 0, len(fss))
+	for _, fs := range fss {
+		outs = append(outs, FormattedField{
+			Name:             f.Name(fs.EnterpriseNumber, fs.InformationElementID),
+			ElementID:        fs.InformationElementID,
+			EnterpriseNumber: fs.EnterpriseNumber,
+			Length:           fs.FieldLength,
+		})
+	}
+	return outs
+}
+
+func (f *Formatter) fieldValues(fvs []FieldValue) []FormattedField {
+	outs := make([]FormattedField, 0, len(fvs))
+	for _, fv := range fvs {
+		fs := fv.FieldSpecifier()
+		outs = append(outs, FormattedField{
+			Name:             f.Name(fs.EnterpriseNumber, fs.InformationElementID),
+			ElementID:        fs.InformationElementID,
+			EnterpriseNumber: fs.EnterpriseNumber,
+			Value:            f.Value(fv),
+		})
+	}
+	return outs
+}
+
+var semanticNames = map[uint8]string{
+	SEMANTIC_NONE_OF:        "noneOf",
+	SEMANTIC_EXACTLY_ONE_OF: "exactlyOneOf",
+	SEMANTIC_ONE_OR_MORE_OF: "oneOrMoreOf",
+	SEMANTIC_ALL_OF:         "allOf",
+	SEMANTIC_ORDERED:        "ordered",
+	SEMANTIC_UNDEFINED:      "undefined",
+}
+
+func semanticName(semantic uint8) string {
+	if name, ok := semanticNames[semantic]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", semantic)
+}
+
+func (f *Formatter) records(semantic uint8, templateID uint16, records []Record) FormattedList {
+	out := FormattedList{Semantic: semanticName(semantic), TemplateID: templateID}
+	for _, r := range records {
+		switch r := r.(type) {
+		case *DataRecord:
+			out.Records = append(out.Records, f.fieldValues(r.FieldValues))
+		default:
+			out.Data += hex.EncodeToString(AppendRecord(nil, r))
+		}
+	}
+	return out
+}
+
+// Value returns the decoded value of fv, which is a number, a string, a
+// time.Time, a FormattedList or a slice of them. Octet arrays and values
+// of unknown types are in hex.
+func (f *Formatter) Value(fv FieldValue) any {
+	switch fv := fv.(type) {
+	case *SRHSegmentIPv6BasicList:
+		segments := make([]string, 0, len(fv.SegmentList))
+		for _, seg := range fv.SegmentList {
+			segments = append(segments, seg.Val.String())
+		}
+		return segments
+	case *SRHSegmentIPv6ListSection:
+		segments := make([]string, 0, len(fv.SegmentList))
+		for _, seg := range fv.SegmentList {
+			segments = append(segments, seg.String())
+		}
+		return segments
+	case *BasicList:
+		out := FormattedList{Semantic: semanticName(fv.Semantic), Values: []any{}}
+		for _, v := range fv.Values {
+			out.Values = append(out.Values, f.Value(v))
+		}
+		return out
+	case *SubTemplateList:
+		return f.records(fv.Semantic, fv.TemplateID, fv.Records)
+	case *SubTemplateMultiList:
+		outs := make([]FormattedList, 0, len(fv.Entries))
+		for _, e := range fv.Entries {
+			outs = append(outs, f.records(fv.Semantic, e.TemplateID, e.Records))
+		}
+		return outs
+	case *UndefinedFieldValue:
+		return hex.EncodeToString(fv.Value)
+	}
+
+	// The field values hold their value in Val
+	if v := reflect.ValueOf(fv); v.Kind() == reflect.Pointer && v.Elem().Kind() == reflect.Struct {
+		if val := v.Elem().FieldByName("Val"); val.IsValid() {
+			switch val := val.Addr().Interface().(type) {
+			case *[]uint8:
+				return hex.EncodeToString(*val)
+			case *time.Time:
+				return *val
+			case *float64:
+				// JSON has neither NaN nor infinities
+				if math.IsNaN(*val) || math.IsInf(*val, 0) {
+					return fmt.Sprint(*val)
+				}
+				return *val
+			case FieldValue:
+				return f.Value(val)
+			case fmt.Stringer:
+				return val.String()
+			}
+			return val.Interface()
+		}
+	}
+	return hex.EncodeToString(AppendFieldValue(nil, fv))
+}
+
+// MarshalMessage returns m as JSON.
+func (f *Formatter) MarshalMessage(m *Message) ([]byte, error) {
+	return json.Marshal(f.Message(m))
+}
+
+// MarshalRecord returns r as JSON.
+func (f *Formatter) MarshalRecord(r Record) ([]byte, error) {
+	return json.Marshal(f.Record(r))
+}
+
+// MessageText returns m as a text tree, e.g.
+//
+//	IPFIX Message: version 10, length 40
+//	    Export Time: 2023-10-25T08:46:46Z
+//	    Sequence Number: 1
+//	    Observation Domain ID: 61166
+//	    Set 2 (Template Set), length 12
+//	        Template 256, 1 field
+//	            packetDeltaCount (2), length 8
+//	    Set 256 (Data Set), length 12
+//	        Data Record
+//	            packetDeltaCount (2): 100
+func (f *Formatter) MessageText(m *Message) string {
+	return f.Message(m).Text()
+}
+
+// RecordText returns r as a text tree.
+func (f *Formatter) RecordText(r Record) string {
+	var b strings.Builder
+	f.Record(r).writeText(&b, 0)
+	return b.String()
+}
+
+const textIndent = "    "
+
+// Text returns m as a text tree. See Formatter.MessageText.
+func (m FormattedMessage) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "IPFIX Message: version %d, length %d\n", m.Version, m.Length)
+	fmt.Fprintf(&b, "%sExport Time: %s\n", textIndent, m.ExportTime.Format(time.RFC3339))
+	fmt.Fprintf(&b, "%sSequence Number: %d\n", textIndent, m.SequenceNumber)
+	fmt.Fprintf(&b, "%sObservation Domain ID: %d\n", textIndent, m.ObservationDomainID)
+	for _, s := range m.Sets {
+		fmt.Fprintf(&b, "%sSet %d (%s), length %d\n", textIndent, s.SetID, setName(s.SetID), s.Length)
+		for _, r := range s.Records {
+			r.writeText(&b, 2)
+		}
+	}
+	return b.String()
+}
+
+func setName(setID uint16) string {
+	switch {
+	case setID == TEMPLATE_SETS_ID:
+		return "Template Set"
+	case setID == OPTIONS_TEMPLATE_SETS_ID:
+		return "Options Template Set"
+	case setID >= MIN_DATA_SETS_ID:
+		return "Data Set"
+	}
+	return "Reserved Set"
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
+func (r FormattedRecord) writeText(b *strings.Builder, depth int) {
+	indent := strings.Repeat(textIndent, depth)
+	switch r.Type {
+	case "template":
+		fmt.Fprintf(b, "%sTemplate %d, %s\n", indent, r.TemplateID, plural(len(r.Fields), "field"))
+	case "optionsTemplate":
+		fmt.Fprintf(b, "%sOptions Template %d, %s, %s\n", indent, r.TemplateID,
+			plural(len(r.Fields), "field"), plural(int(r.ScopeFieldCount), "scope field"))
+	case "data":
+		fmt.Fprintf(b, "%sData Record\n", indent)
+		writeFieldsText(b, r.Fields, depth+1)
+		return
+	default:
+		fmt.Fprintf(b, "%sUnknown Record: %s\n", indent, r.Data)
+		return
+	}
+	for _, fs := range r.Fields {
+		length := fmt.Sprintf("%d", fs.Length)
+		if fs.Length == VARIABLE_LENGTH {
+			length = "variable"
+		}
+		fmt.Fprintf(b, "%s%s%s (%s), length %s\n", indent, textIndent, fs.Name,
+			elementIDString(fs.EnterpriseNumber, fs.ElementID), length)
+	}
+}
+
+func writeFieldsText(b *strings.Builder, fields []FormattedField, depth int) {
+	indent := strings.Repeat(textIndent, depth)
+	for _, fv := range fields {
+		fmt.Fprintf(b, "%s%s (%s)", indent, fv.Name, elementIDString(fv.EnterpriseNumber, fv.ElementID))
+		switch v := fv.Value.(type) {
+		case FormattedList:
+			b.WriteString(":\n")
+			v.writeText(b, depth+1)
+		case []FormattedList:
+			b.WriteString(":\n")
+			for _, l := range v {
+				l.writeText(b, depth+1)
+			}
+		case time.Time:
+			fmt.Fprintf(b, ": %s\n", v.Format(time.RFC3339Nano))
+		default:
+			fmt.Fprintf(b, ": %v\n", v)
+		}
+	}
+}
+
+func (l FormattedList) writeText(b *strings.Builder, depth int) {
+	indent := strings.Repeat(textIndent, depth)
+	if l.TemplateID != 0 {
+		fmt.Fprintf(b, "%s%s, template %d\n", indent, l.Semantic, l.TemplateID)
+	} else {
+		fmt.Fprintf(b, "%s%s\n", indent, l.Semantic)
+	}
+	for _, v := range l.Values {
+		fmt.Fprintf(b, "%s%s%v\n", indent, textIndent, v)
+	}
+	for _, fields := range l.Records {
+		fmt.Fprintf(b, "%s%sData Record\n", indent, textIndent)
+		writeFieldsText(b, fields, depth+2)
+	}
+	if l.Data != "" {
+		fmt.Fprintf(b, "%s%s%s\n", indent, textIndent, l.Data)
+	}
+}
+
+// String returns l on a line, e.g. "ordered[{srhActiveSegmentIPv6=2001:db8::1}]".
+func (l FormattedList) String() string {
+	var items []string
+	for _, v := range l.Values {
+		items = append(items, fmt.Sprint(v))
+	}
+	for _, fields := range l.Records {
+		var fs []string
+		for _, f := range fields {
+			fs = append(fs, fmt.Sprintf("%s=%v", f.Name, f.Value))
+		}
+		items = append(items, "{"+strings.Join(fs, " ")+"}")
+	}
+	if l.Data != "" {
+		items = append(items, l.Data)
+	}
+	s := fmt.Sprintf("%s[%s]", l.Semantic, strings.Join(items, " "))
+	if l.TemplateID != 0 {
+		s = fmt.Sprintf("template %d %s", l.TemplateID, s)
+	}
+	return s
+}
+
+// MarshalJSON renders m with the names of the IANA Information Elements
+// and those of fluvia. See Formatter for other Information Elements.
+func (m *Message) MarshalJSON() ([]byte, error) {
+	return NewFormatter(nil).MarshalMessage(m)
+}
+
+func (r *TemplateRecord) MarshalJSON() ([]byte, error) {
+	return NewFormatter(nil).MarshalRecord(r)
+}
+
+func (r *OptionsTemplateRecord) MarshalJSON() ([]byte, error) {
+	return NewFormatter(nil).MarshalRecord(r)
+}
+
+func (r *DataRecord) MarshalJSON() ([]byte, error) {
+	return NewFormatter(nil).MarshalRecord(r)
+}
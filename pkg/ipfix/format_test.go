@@ -0,0 +1,100 @@
+package ipfix
+
+import (
+	"encoding/json"
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func testFormatMessage() *Message {
+	fvs := []FieldValue{
+		&PacketDeltaCount{Val: 100},
+		&SRHActiveSegmentIPv6{Val: netip.MustParseAddr("2001:db8::1")},
+		&UndefinedFieldValue{ElemID: 1, Value: []uint8{0xab}, TemplateLen: 1, EnterpriseNumber: 29319},
+		&SubTemplateList{Semantic: SEMANTIC_ORDERED, TemplateID: 257, Records: []Record{
+			&DataRecord{FieldValues: []FieldValue{&SRHTagIPv6{Val: 7}}},
+		}},
+	}
+	var fss []FieldSpecifier
+	for _, fv := range fvs {
+		fss = append(fss, *fv.FieldSpecifier())
+	}
+	m := NewMessage(1, 61166, []Set{
+		*NewSet(TEMPLATE_SETS_ID, []Record{NewTemplateRecord(256, fss)}),
+		*NewSet(256, []Record{&DataRecord{FieldValues: fvs}}),
+	})
+	m.ExportTime = 0x6538d5f6
+	return m
+}
+
+func TestFormatterJSON(t *testing.T) {
+	got, err := json.Marshal(testFormatMessage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"version":10,"length":80,"exportTime":"2023-10-25T08:46:46Z","sequenceNumber":1,"observationDomainId":61166,"sets":[` +
+		`{"setId":2,"length":28,"records":[{"type":"template","templateId":256,"fields":[` +
+		`{"name":"packetDeltaCount","elementId":2,"length":8},` +
+		`{"name":"srhActiveSegmentIPv6","elementId":495,"length":16},` +
+		`{"name":"29319.1","elementId":1,"enterpriseNumber":29319,"length":1},` +
+		`{"name":"subTemplateList","elementId":292,"length":65535}]}]},` +
+		`{"setId":256,"length":36,"records":[{"type":"data","fields":[` +
+		`{"name":"packetDeltaCount","elementId":2,"value":100},` +
+		`{"name":"srhActiveSegmentIPv6","elementId":495,"value":"2001:db8::1"},` +
+		`{"name":"29319.1","elementId":1,"enterpriseNumber":29319,"value":"ab"},` +
+		`{"name":"subTemplateList","elementId":292,"value":{"semantic":"ordered","templateId":257,"records":[` +
+		`[{"name":"srhTagIPv6","elementId":493,"value":7}]]}}]}]}]}`
+	if string(got) != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestFormatterText(t *testing.T) {
+	got := NewFormatter(nil).MessageText(testFormatMessage())
+	want := strings.Join([]string{
+		"IPFIX Message: version 10, length 80",
+		"    Export Time: 2023-10-25T08:46:46Z",
+		"    Sequence Number: 1",
+		"    Observation Domain ID: 61166",
+		"    Set 2 (Template Set), length 28",
+		"        Template 256, 4 fields",
+		"            packetDeltaCount (2), length 8",
+		"            srhActiveSegmentIPv6 (495), length 16",
+		"            29319.1 (29319.1), length 1",
+		"            subTemplateList (292), length variable",
+		"    Set 256 (Data Set), length 36",
+		"        Data Record",
+		"            packetDeltaCount (2): 100",
+		"            srhActiveSegmentIPv6 (495): 2001:db8::1",
+		"            29319.1 (29319.1): ab",
+		"            subTemplateList (292):",
+		"                ordered, template 257",
+		"                    Data Record",
+		"                        srhTagIPv6 (493): 7",
+		"",
+	}, "\n")
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestFormatterRegistry(t *testing.T) {
+	r := NewRegistry()
+	if err := r.LoadYAML(strings.NewReader(`
+information-elements:
+  - name: nodeId
+    element-id: 1
+    enterprise-number: 29319
+    data-type: unsigned8
+`)); err != nil {
+		t.Fatal(err)
+	}
+	rec := &DataRecord{FieldValues: []FieldValue{
+		&UndefinedFieldValue{ElemID: 1, Value: []uint8{0xab}, TemplateLen: 1, EnterpriseNumber: 29319},
+	}}
+	got := NewFormatter(r).RecordText(rec)
+	if want := "Data Record\n    nodeId (29319.1): ab\n"; got != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
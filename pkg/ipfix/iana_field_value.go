@@ -0,0 +1,17666 @@
+// Code generated by iegen; DO NOT EDIT.
+
+package ipfix
+
+import (
+	"net"
+	"net/netip"
+	"time"
+)
+
+// OctetDeltaCount is octetDeltaCount (1), unsigned64 deltaCounter in octets.
+type OctetDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *OctetDeltaCount) ElementID() uint16 {
+	return IEID_OCTET_DELTA_COUNT
+}
+
+func (fv *OctetDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OctetDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *OctetDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *OctetDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *OctetDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DeltaFlowCount is deltaFlowCount (3), unsigned64 deltaCounter in flows.
+type DeltaFlowCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DeltaFlowCount) ElementID() uint16 {
+	return IEID_DELTA_FLOW_COUNT
+}
+
+func (fv *DeltaFlowCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DeltaFlowCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DeltaFlowCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DeltaFlowCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DeltaFlowCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ProtocolIdentifier is protocolIdentifier (4), unsigned8 identifier.
+type ProtocolIdentifier struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *ProtocolIdentifier) ElementID() uint16 {
+	return IEID_PROTOCOL_IDENTIFIER
+}
+
+func (fv *ProtocolIdentifier) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ProtocolIdentifier) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ProtocolIdentifier) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *ProtocolIdentifier) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *ProtocolIdentifier) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpClassOfService is ipClassOfService (5), unsigned8 identifier.
+type IpClassOfService struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IpClassOfService) ElementID() uint16 {
+	return IEID_IP_CLASS_OF_SERVICE
+}
+
+func (fv *IpClassOfService) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpClassOfService) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IpClassOfService) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IpClassOfService) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IpClassOfService) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpControlBits is tcpControlBits (6), unsigned16 flags.
+type TcpControlBits struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *TcpControlBits) ElementID() uint16 {
+	return IEID_TCP_CONTROL_BITS
+}
+
+func (fv *TcpControlBits) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpControlBits) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TcpControlBits) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *TcpControlBits) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *TcpControlBits) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SourceTransportPort is sourceTransportPort (7), unsigned16 identifier.
+type SourceTransportPort struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *SourceTransportPort) ElementID() uint16 {
+	return IEID_SOURCE_TRANSPORT_PORT
+}
+
+func (fv *SourceTransportPort) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SourceTransportPort) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SourceTransportPort) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *SourceTransportPort) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *SourceTransportPort) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SourceIPv4Address is sourceIPv4Address (8), ipv4Address.
+type SourceIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *SourceIPv4Address) ElementID() uint16 {
+	return IEID_SOURCE_IPV4_ADDRESS
+}
+
+func (fv *SourceIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SourceIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *SourceIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *SourceIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *SourceIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SourceIPv4PrefixLength is sourceIPv4PrefixLength (9), unsigned8 in bits.
+type SourceIPv4PrefixLength struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *SourceIPv4PrefixLength) ElementID() uint16 {
+	return IEID_SOURCE_IPV4_PREFIX_LENGTH
+}
+
+func (fv *SourceIPv4PrefixLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SourceIPv4PrefixLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SourceIPv4PrefixLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *SourceIPv4PrefixLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *SourceIPv4PrefixLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IngressInterface is ingressInterface (10), unsigned32 identifier.
+type IngressInterface struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *IngressInterface) ElementID() uint16 {
+	return IEID_INGRESS_INTERFACE
+}
+
+func (fv *IngressInterface) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IngressInterface) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IngressInterface) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *IngressInterface) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *IngressInterface) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DestinationTransportPort is destinationTransportPort (11), unsigned16 identifier.
+type DestinationTransportPort struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *DestinationTransportPort) ElementID() uint16 {
+	return IEID_DESTINATION_TRANSPORT_PORT
+}
+
+func (fv *DestinationTransportPort) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DestinationTransportPort) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *DestinationTransportPort) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *DestinationTransportPort) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *DestinationTransportPort) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DestinationIPv4Address is destinationIPv4Address (12), ipv4Address.
+type DestinationIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *DestinationIPv4Address) ElementID() uint16 {
+	return IEID_DESTINATION_IPV4_ADDRESS
+}
+
+func (fv *DestinationIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DestinationIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *DestinationIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *DestinationIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *DestinationIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DestinationIPv4PrefixLength is destinationIPv4PrefixLength (13), unsigned8 in bits.
+type DestinationIPv4PrefixLength struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *DestinationIPv4PrefixLength) ElementID() uint16 {
+	return IEID_DEATINATION_IPV4_PREFIX_LENGTH
+}
+
+func (fv *DestinationIPv4PrefixLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DestinationIPv4PrefixLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *DestinationIPv4PrefixLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *DestinationIPv4PrefixLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *DestinationIPv4PrefixLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EgressInterface is egressInterface (14), unsigned32 identifier.
+type EgressInterface struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *EgressInterface) ElementID() uint16 {
+	return IEID_EGRESS_INTERFACE
+}
+
+func (fv *EgressInterface) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EgressInterface) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *EgressInterface) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *EgressInterface) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *EgressInterface) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpNextHopIPv4Address is ipNextHopIPv4Address (15), ipv4Address.
+type IpNextHopIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *IpNextHopIPv4Address) ElementID() uint16 {
+	return IEID_IP_NEXT_HOP_IPV4_ADDRESS
+}
+
+func (fv *IpNextHopIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpNextHopIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *IpNextHopIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *IpNextHopIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *IpNextHopIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpSourceAsNumber is bgpSourceAsNumber (16), unsigned32 identifier.
+type BgpSourceAsNumber struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *BgpSourceAsNumber) ElementID() uint16 {
+	return IEID_BGP_SOURCE_AS_NUMBER
+}
+
+func (fv *BgpSourceAsNumber) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpSourceAsNumber) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *BgpSourceAsNumber) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *BgpSourceAsNumber) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *BgpSourceAsNumber) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpDestinationAsNumber is bgpDestinationAsNumber (17), unsigned32 identifier.
+type BgpDestinationAsNumber struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *BgpDestinationAsNumber) ElementID() uint16 {
+	return IEID_BGP_DESTINATION_AS_NUMBER
+}
+
+func (fv *BgpDestinationAsNumber) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpDestinationAsNumber) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *BgpDestinationAsNumber) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *BgpDestinationAsNumber) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *BgpDestinationAsNumber) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpNextHopIPv4Address is bgpNextHopIPv4Address (18), ipv4Address.
+type BgpNextHopIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *BgpNextHopIPv4Address) ElementID() uint16 {
+	return IEID_BGP_NEXT_HOP_IPV4_ADDRESS
+}
+
+func (fv *BgpNextHopIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpNextHopIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *BgpNextHopIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *BgpNextHopIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *BgpNextHopIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostMCastPacketDeltaCount is postMCastPacketDeltaCount (19), unsigned64 deltaCounter in packets.
+type PostMCastPacketDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostMCastPacketDeltaCount) ElementID() uint16 {
+	return IEID_POST_MCAST_PACKET_DELTA_COUNT
+}
+
+func (fv *PostMCastPacketDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostMCastPacketDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostMCastPacketDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostMCastPacketDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostMCastPacketDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostMCastOctetDeltaCount is postMCastOctetDeltaCount (20), unsigned64 deltaCounter in octets.
+type PostMCastOctetDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostMCastOctetDeltaCount) ElementID() uint16 {
+	return IEID_POST_MCAST_OCTET_DELTA_COUNT
+}
+
+func (fv *PostMCastOctetDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostMCastOctetDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostMCastOctetDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostMCastOctetDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostMCastOctetDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowEndSysUpTime is flowEndSysUpTime (21), unsigned32 in milliseconds.
+type FlowEndSysUpTime struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *FlowEndSysUpTime) ElementID() uint16 {
+	return IEID_FLOW_END_SYS_UP_TIME
+}
+
+func (fv *FlowEndSysUpTime) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowEndSysUpTime) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowEndSysUpTime) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *FlowEndSysUpTime) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *FlowEndSysUpTime) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowStartSysUpTime is flowStartSysUpTime (22), unsigned32 in milliseconds.
+type FlowStartSysUpTime struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *FlowStartSysUpTime) ElementID() uint16 {
+	return IEID_FLOW_START_SYS_UP_TIME
+}
+
+func (fv *FlowStartSysUpTime) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowStartSysUpTime) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowStartSysUpTime) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *FlowStartSysUpTime) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *FlowStartSysUpTime) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostOctetDeltaCount is postOctetDeltaCount (23), unsigned64 deltaCounter in octets.
+type PostOctetDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostOctetDeltaCount) ElementID() uint16 {
+	return IEID_POST_OCTET_DELTA_COUNT
+}
+
+func (fv *PostOctetDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostOctetDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostOctetDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostOctetDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostOctetDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostPacketDeltaCount is postPacketDeltaCount (24), unsigned64 deltaCounter in packets.
+type PostPacketDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostPacketDeltaCount) ElementID() uint16 {
+	return IEID_POST_PACKET_DELTA_COUNT
+}
+
+func (fv *PostPacketDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostPacketDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostPacketDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostPacketDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostPacketDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MinimumIpTotalLength is minimumIpTotalLength (25), unsigned64 in octets.
+type MinimumIpTotalLength struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *MinimumIpTotalLength) ElementID() uint16 {
+	return IEID_MINIMUM_IP_TOTAL_LENGTH
+}
+
+func (fv *MinimumIpTotalLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MinimumIpTotalLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *MinimumIpTotalLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *MinimumIpTotalLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *MinimumIpTotalLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaximumIpTotalLength is maximumIpTotalLength (26), unsigned64 in octets.
+type MaximumIpTotalLength struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *MaximumIpTotalLength) ElementID() uint16 {
+	return IEID_MAXIMUM_IP_TOTAL_LENGTH
+}
+
+func (fv *MaximumIpTotalLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaximumIpTotalLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *MaximumIpTotalLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *MaximumIpTotalLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *MaximumIpTotalLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SourceIPv6Address is sourceIPv6Address (27), ipv6Address.
+type SourceIPv6Address struct {
+	Val netip.Addr
+}
+
+func (fv *SourceIPv6Address) ElementID() uint16 {
+	return IEID_SOURCE_IPV6_ADDRESS
+}
+
+func (fv *SourceIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SourceIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *SourceIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *SourceIPv6Address) Len() uint16 {
+	return 16
+}
+
+func (fv *SourceIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DestinationIPv6Address is destinationIPv6Address (28), ipv6Address.
+type DestinationIPv6Address struct {
+	Val netip.Addr
+}
+
+func (fv *DestinationIPv6Address) ElementID() uint16 {
+	return IEID_DESTINATION_IPV6_ADDRESS
+}
+
+func (fv *DestinationIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DestinationIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *DestinationIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *DestinationIPv6Address) Len() uint16 {
+	return 16
+}
+
+func (fv *DestinationIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SourceIPv6PrefixLength is sourceIPv6PrefixLength (29), unsigned8 in bits.
+type SourceIPv6PrefixLength struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *SourceIPv6PrefixLength) ElementID() uint16 {
+	return IEID_SOURCE_IPV6_PREFIX_LENGTH
+}
+
+func (fv *SourceIPv6PrefixLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SourceIPv6PrefixLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SourceIPv6PrefixLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *SourceIPv6PrefixLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *SourceIPv6PrefixLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DestinationIPv6PrefixLength is destinationIPv6PrefixLength (30), unsigned8 in bits.
+type DestinationIPv6PrefixLength struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *DestinationIPv6PrefixLength) ElementID() uint16 {
+	return IEID_DESTINATION_IPV6_PREFIX_LENGTH
+}
+
+func (fv *DestinationIPv6PrefixLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DestinationIPv6PrefixLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *DestinationIPv6PrefixLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *DestinationIPv6PrefixLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *DestinationIPv6PrefixLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowLabelIPv6 is flowLabelIPv6 (31), unsigned32 identifier.
+type FlowLabelIPv6 struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *FlowLabelIPv6) ElementID() uint16 {
+	return IEID_FLOW_LABEL_IPV6
+}
+
+func (fv *FlowLabelIPv6) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowLabelIPv6) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowLabelIPv6) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *FlowLabelIPv6) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *FlowLabelIPv6) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IcmpTypeCodeIPv4 is icmpTypeCodeIPv4 (32), unsigned16 identifier.
+type IcmpTypeCodeIPv4 struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *IcmpTypeCodeIPv4) ElementID() uint16 {
+	return IEID_ICMP_TYPE_CODE_IPV4
+}
+
+func (fv *IcmpTypeCodeIPv4) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IcmpTypeCodeIPv4) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IcmpTypeCodeIPv4) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *IcmpTypeCodeIPv4) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *IcmpTypeCodeIPv4) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IgmpType is igmpType (33), unsigned8 identifier.
+type IgmpType struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IgmpType) ElementID() uint16 {
+	return IEID_IGMP_TYPE
+}
+
+func (fv *IgmpType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IgmpType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IgmpType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IgmpType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IgmpType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingInterval is samplingInterval (34), unsigned32 quantity in packets.
+//
+// Deprecated: samplingInterval is deprecated in the IANA registry.
+type SamplingInterval struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingInterval) ElementID() uint16 {
+	return IEID_SAMPLING_INTERVAL
+}
+
+func (fv *SamplingInterval) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingInterval) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplingInterval) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *SamplingInterval) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *SamplingInterval) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingAlgorithm is samplingAlgorithm (35), unsigned8 identifier.
+//
+// Deprecated: samplingAlgorithm is deprecated in the IANA registry.
+type SamplingAlgorithm struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingAlgorithm) ElementID() uint16 {
+	return IEID_SAMPLING_ALGORITHM
+}
+
+func (fv *SamplingAlgorithm) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingAlgorithm) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplingAlgorithm) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *SamplingAlgorithm) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *SamplingAlgorithm) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowActiveTimeout is flowActiveTimeout (36), unsigned16 in seconds.
+type FlowActiveTimeout struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *FlowActiveTimeout) ElementID() uint16 {
+	return IEID_FLOW_ACTIVE_TIMEOUT
+}
+
+func (fv *FlowActiveTimeout) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowActiveTimeout) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowActiveTimeout) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *FlowActiveTimeout) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *FlowActiveTimeout) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowIdleTimeout is flowIdleTimeout (37), unsigned16 in seconds.
+type FlowIdleTimeout struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *FlowIdleTimeout) ElementID() uint16 {
+	return IEID_FLOW_INACTIVE_TIMEOUT
+}
+
+func (fv *FlowIdleTimeout) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowIdleTimeout) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowIdleTimeout) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *FlowIdleTimeout) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *FlowIdleTimeout) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EngineType is engineType (38), unsigned8 identifier.
+//
+// Deprecated: engineType is deprecated in the IANA registry.
+type EngineType struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *EngineType) ElementID() uint16 {
+	return IEID_ENGINE_TYPE
+}
+
+func (fv *EngineType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EngineType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *EngineType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *EngineType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *EngineType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EngineId is engineId (39), unsigned8 identifier.
+//
+// Deprecated: engineId is deprecated in the IANA registry.
+type EngineId struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *EngineId) ElementID() uint16 {
+	return IEID_ENGINE_ID
+}
+
+func (fv *EngineId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EngineId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *EngineId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *EngineId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *EngineId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Ipv4RouterSc is ipv4RouterSc (43), ipv4Address.
+//
+// Deprecated: ipv4RouterSc is deprecated in the IANA registry.
+type Ipv4RouterSc struct {
+	Val netip.Addr
+}
+
+func (fv *Ipv4RouterSc) ElementID() uint16 {
+	return IEID_IPV4_ROUTER_SC
+}
+
+func (fv *Ipv4RouterSc) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Ipv4RouterSc) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *Ipv4RouterSc) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *Ipv4RouterSc) Len() uint16 {
+	return 4
+}
+
+func (fv *Ipv4RouterSc) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SourceIPv4Prefix is sourceIPv4Prefix (44), ipv4Address.
+type SourceIPv4Prefix struct {
+	Val netip.Addr
+}
+
+func (fv *SourceIPv4Prefix) ElementID() uint16 {
+	return IEID_SOURCE_IPV4_PREFIX
+}
+
+func (fv *SourceIPv4Prefix) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SourceIPv4Prefix) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *SourceIPv4Prefix) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *SourceIPv4Prefix) Len() uint16 {
+	return 4
+}
+
+func (fv *SourceIPv4Prefix) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DestinationIPv4Prefix is destinationIPv4Prefix (45), ipv4Address.
+type DestinationIPv4Prefix struct {
+	Val netip.Addr
+}
+
+func (fv *DestinationIPv4Prefix) ElementID() uint16 {
+	return IEID_DESTINATION_IPV4_PREFIX
+}
+
+func (fv *DestinationIPv4Prefix) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DestinationIPv4Prefix) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *DestinationIPv4Prefix) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *DestinationIPv4Prefix) Len() uint16 {
+	return 4
+}
+
+func (fv *DestinationIPv4Prefix) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsTopLabelType is mplsTopLabelType (46), unsigned8 identifier.
+type MplsTopLabelType struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *MplsTopLabelType) ElementID() uint16 {
+	return IEID_MPLS_TOP_LABEL_TYPE
+}
+
+func (fv *MplsTopLabelType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsTopLabelType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MplsTopLabelType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *MplsTopLabelType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *MplsTopLabelType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsTopLabelIPv4Address is mplsTopLabelIPv4Address (47), ipv4Address.
+type MplsTopLabelIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *MplsTopLabelIPv4Address) ElementID() uint16 {
+	return IEID_MPLS_TOP_LABEL_IPV4_ADDRESS
+}
+
+func (fv *MplsTopLabelIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsTopLabelIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *MplsTopLabelIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *MplsTopLabelIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *MplsTopLabelIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplerId is samplerId (48), unsigned8 identifier.
+//
+// Deprecated: samplerId is deprecated in the IANA registry.
+type SamplerId struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *SamplerId) ElementID() uint16 {
+	return IEID_SAMPLER_ID
+}
+
+func (fv *SamplerId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplerId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplerId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *SamplerId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *SamplerId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplerMode is samplerMode (49), unsigned8 identifier.
+//
+// Deprecated: samplerMode is deprecated in the IANA registry.
+type SamplerMode struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *SamplerMode) ElementID() uint16 {
+	return IEID_SAMPLER_MODE
+}
+
+func (fv *SamplerMode) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplerMode) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplerMode) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *SamplerMode) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *SamplerMode) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplerRandomInterval is samplerRandomInterval (50), unsigned32 quantity.
+//
+// Deprecated: samplerRandomInterval is deprecated in the IANA registry.
+type SamplerRandomInterval struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *SamplerRandomInterval) ElementID() uint16 {
+	return IEID_SAMPLER_RANDOM_INTERVAL
+}
+
+func (fv *SamplerRandomInterval) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplerRandomInterval) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplerRandomInterval) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *SamplerRandomInterval) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *SamplerRandomInterval) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ClassId is classId (51), unsigned8 identifier.
+//
+// Deprecated: classId is deprecated in the IANA registry.
+type ClassId struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *ClassId) ElementID() uint16 {
+	return IEID_CLASS_ID
+}
+
+func (fv *ClassId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ClassId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ClassId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *ClassId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *ClassId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MinimumTTL is minimumTTL (52), unsigned8 in hops.
+type MinimumTTL struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *MinimumTTL) ElementID() uint16 {
+	return IEID_MINIMUM_TTL
+}
+
+func (fv *MinimumTTL) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MinimumTTL) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MinimumTTL) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *MinimumTTL) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *MinimumTTL) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaximumTTL is maximumTTL (53), unsigned8 in hops.
+type MaximumTTL struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *MaximumTTL) ElementID() uint16 {
+	return IEID_MAXIMUM_TTL
+}
+
+func (fv *MaximumTTL) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaximumTTL) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MaximumTTL) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *MaximumTTL) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *MaximumTTL) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FragmentIdentification is fragmentIdentification (54), unsigned32 identifier.
+type FragmentIdentification struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *FragmentIdentification) ElementID() uint16 {
+	return IEID_FRAGMENT_IDENTIFICATION
+}
+
+func (fv *FragmentIdentification) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FragmentIdentification) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FragmentIdentification) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *FragmentIdentification) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *FragmentIdentification) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostIpClassOfService is postIpClassOfService (55), unsigned8 identifier.
+type PostIpClassOfService struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *PostIpClassOfService) ElementID() uint16 {
+	return IEID_POST_IP_CLASS_OF_SERVICE
+}
+
+func (fv *PostIpClassOfService) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostIpClassOfService) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PostIpClassOfService) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *PostIpClassOfService) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *PostIpClassOfService) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SourceMacAddress is sourceMacAddress (56), macAddress.
+type SourceMacAddress struct {
+	Val net.HardwareAddr
+}
+
+func (fv *SourceMacAddress) ElementID() uint16 {
+	return IEID_SOURCE_MAC_ADDRESS
+}
+
+func (fv *SourceMacAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SourceMacAddress) AppendTo(dst []uint8) []uint8 {
+	return appendMacAddress(dst, fv.Val)
+}
+
+func (fv *SourceMacAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 6); err != nil {
+		return err
+	}
+	fv.Val = decodeMacAddress(data)
+	return nil
+}
+
+func (fv *SourceMacAddress) Len() uint16 {
+	return 6
+}
+
+func (fv *SourceMacAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostDestinationMacAddress is postDestinationMacAddress (57), macAddress.
+type PostDestinationMacAddress struct {
+	Val net.HardwareAddr
+}
+
+func (fv *PostDestinationMacAddress) ElementID() uint16 {
+	return IEID_POST_DESTINATION_MAC_ADDRESS
+}
+
+func (fv *PostDestinationMacAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostDestinationMacAddress) AppendTo(dst []uint8) []uint8 {
+	return appendMacAddress(dst, fv.Val)
+}
+
+func (fv *PostDestinationMacAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 6); err != nil {
+		return err
+	}
+	fv.Val = decodeMacAddress(data)
+	return nil
+}
+
+func (fv *PostDestinationMacAddress) Len() uint16 {
+	return 6
+}
+
+func (fv *PostDestinationMacAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// VlanId is vlanId (58), unsigned16 identifier.
+type VlanId struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *VlanId) ElementID() uint16 {
+	return IEID_VLAN_ID
+}
+
+func (fv *VlanId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *VlanId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *VlanId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *VlanId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *VlanId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostVlanId is postVlanId (59), unsigned16 identifier.
+type PostVlanId struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PostVlanId) ElementID() uint16 {
+	return IEID_POST_VLAN_ID
+}
+
+func (fv *PostVlanId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostVlanId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PostVlanId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PostVlanId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PostVlanId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpVersion is ipVersion (60), unsigned8 identifier.
+type IpVersion struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IpVersion) ElementID() uint16 {
+	return IEID_IP_VERSION
+}
+
+func (fv *IpVersion) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpVersion) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IpVersion) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IpVersion) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IpVersion) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowDirection is flowDirection (61), unsigned8 identifier.
+type FlowDirection struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *FlowDirection) ElementID() uint16 {
+	return IEID_FLOW_DIRECTION
+}
+
+func (fv *FlowDirection) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowDirection) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowDirection) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *FlowDirection) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *FlowDirection) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpNextHopIPv6Address is ipNextHopIPv6Address (62), ipv6Address.
+type IpNextHopIPv6Address struct {
+	Val netip.Addr
+}
+
+func (fv *IpNextHopIPv6Address) ElementID() uint16 {
+	return IEID_IP_NEXT_HOP_IPV6_ADDRESS
+}
+
+func (fv *IpNextHopIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpNextHopIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *IpNextHopIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *IpNextHopIPv6Address) Len() uint16 {
+	return 16
+}
+
+func (fv *IpNextHopIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpNextHopIPv6Address is bgpNextHopIPv6Address (63), ipv6Address.
+type BgpNextHopIPv6Address struct {
+	Val netip.Addr
+}
+
+func (fv *BgpNextHopIPv6Address) ElementID() uint16 {
+	return IEID_BGP_NEXT_HOP_IPV6_ADDRESS
+}
+
+func (fv *BgpNextHopIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpNextHopIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *BgpNextHopIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *BgpNextHopIPv6Address) Len() uint16 {
+	return 16
+}
+
+func (fv *BgpNextHopIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Ipv6ExtensionHeaders is ipv6ExtensionHeaders (64), unsigned32 flags.
+type Ipv6ExtensionHeaders struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *Ipv6ExtensionHeaders) ElementID() uint16 {
+	return IEID_IPV6_EXTENSION_HEADERS
+}
+
+func (fv *Ipv6ExtensionHeaders) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Ipv6ExtensionHeaders) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Ipv6ExtensionHeaders) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *Ipv6ExtensionHeaders) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *Ipv6ExtensionHeaders) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsTopLabelStackSection is mplsTopLabelStackSection (70), octetArray.
+type MplsTopLabelStackSection struct {
+	Val []uint8
+}
+
+func (fv *MplsTopLabelStackSection) ElementID() uint16 {
+	return IEID_MPLS_TOP_LABEL_STACK_SECTION
+}
+
+func (fv *MplsTopLabelStackSection) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsTopLabelStackSection) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsTopLabelStackSection) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsTopLabelStackSection) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsTopLabelStackSection) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackSection2 is mplsLabelStackSection2 (71), octetArray.
+type MplsLabelStackSection2 struct {
+	Val []uint8
+}
+
+func (fv *MplsLabelStackSection2) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_SECTION2
+}
+
+func (fv *MplsLabelStackSection2) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackSection2) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsLabelStackSection2) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsLabelStackSection2) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsLabelStackSection2) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackSection3 is mplsLabelStackSection3 (72), octetArray.
+type MplsLabelStackSection3 struct {
+	Val []uint8
+}
+
+func (fv *MplsLabelStackSection3) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_SECTION3
+}
+
+func (fv *MplsLabelStackSection3) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackSection3) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsLabelStackSection3) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsLabelStackSection3) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsLabelStackSection3) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackSection4 is mplsLabelStackSection4 (73), octetArray.
+type MplsLabelStackSection4 struct {
+	Val []uint8
+}
+
+func (fv *MplsLabelStackSection4) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_SECTION4
+}
+
+func (fv *MplsLabelStackSection4) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackSection4) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsLabelStackSection4) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsLabelStackSection4) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsLabelStackSection4) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackSection5 is mplsLabelStackSection5 (74), octetArray.
+type MplsLabelStackSection5 struct {
+	Val []uint8
+}
+
+func (fv *MplsLabelStackSection5) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_SECTION5
+}
+
+func (fv *MplsLabelStackSection5) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackSection5) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsLabelStackSection5) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsLabelStackSection5) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsLabelStackSection5) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackSection6 is mplsLabelStackSection6 (75), octetArray.
+type MplsLabelStackSection6 struct {
+	Val []uint8
+}
+
+func (fv *MplsLabelStackSection6) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_SECTION6
+}
+
+func (fv *MplsLabelStackSection6) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackSection6) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsLabelStackSection6) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsLabelStackSection6) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsLabelStackSection6) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackSection7 is mplsLabelStackSection7 (76), octetArray.
+type MplsLabelStackSection7 struct {
+	Val []uint8
+}
+
+func (fv *MplsLabelStackSection7) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_SECTION7
+}
+
+func (fv *MplsLabelStackSection7) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackSection7) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsLabelStackSection7) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsLabelStackSection7) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsLabelStackSection7) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackSection8 is mplsLabelStackSection8 (77), octetArray.
+type MplsLabelStackSection8 struct {
+	Val []uint8
+}
+
+func (fv *MplsLabelStackSection8) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_SECTION8
+}
+
+func (fv *MplsLabelStackSection8) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackSection8) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsLabelStackSection8) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsLabelStackSection8) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsLabelStackSection8) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackSection9 is mplsLabelStackSection9 (78), octetArray.
+type MplsLabelStackSection9 struct {
+	Val []uint8
+}
+
+func (fv *MplsLabelStackSection9) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_SECTION9
+}
+
+func (fv *MplsLabelStackSection9) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackSection9) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsLabelStackSection9) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsLabelStackSection9) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsLabelStackSection9) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackSection10 is mplsLabelStackSection10 (79), octetArray.
+type MplsLabelStackSection10 struct {
+	Val []uint8
+}
+
+func (fv *MplsLabelStackSection10) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_SECTION10
+}
+
+func (fv *MplsLabelStackSection10) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackSection10) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsLabelStackSection10) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsLabelStackSection10) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsLabelStackSection10) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DestinationMacAddress is destinationMacAddress (80), macAddress.
+type DestinationMacAddress struct {
+	Val net.HardwareAddr
+}
+
+func (fv *DestinationMacAddress) ElementID() uint16 {
+	return IEID_DESTINATION_MAC_ADDRESS
+}
+
+func (fv *DestinationMacAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DestinationMacAddress) AppendTo(dst []uint8) []uint8 {
+	return appendMacAddress(dst, fv.Val)
+}
+
+func (fv *DestinationMacAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 6); err != nil {
+		return err
+	}
+	fv.Val = decodeMacAddress(data)
+	return nil
+}
+
+func (fv *DestinationMacAddress) Len() uint16 {
+	return 6
+}
+
+func (fv *DestinationMacAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostSourceMacAddress is postSourceMacAddress (81), macAddress.
+type PostSourceMacAddress struct {
+	Val net.HardwareAddr
+}
+
+func (fv *PostSourceMacAddress) ElementID() uint16 {
+	return IEID_POST_SOURCE_MAC_ADDRESS
+}
+
+func (fv *PostSourceMacAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostSourceMacAddress) AppendTo(dst []uint8) []uint8 {
+	return appendMacAddress(dst, fv.Val)
+}
+
+func (fv *PostSourceMacAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 6); err != nil {
+		return err
+	}
+	fv.Val = decodeMacAddress(data)
+	return nil
+}
+
+func (fv *PostSourceMacAddress) Len() uint16 {
+	return 6
+}
+
+func (fv *PostSourceMacAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InterfaceName is interfaceName (82), string.
+type InterfaceName struct {
+	Val string
+}
+
+func (fv *InterfaceName) ElementID() uint16 {
+	return IEID_INTERFACE_NAME
+}
+
+func (fv *InterfaceName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InterfaceName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *InterfaceName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *InterfaceName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *InterfaceName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InterfaceDescription is interfaceDescription (83), string.
+type InterfaceDescription struct {
+	Val string
+}
+
+func (fv *InterfaceDescription) ElementID() uint16 {
+	return IEID_INTERFACE_DESCRIPTION
+}
+
+func (fv *InterfaceDescription) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InterfaceDescription) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *InterfaceDescription) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *InterfaceDescription) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *InterfaceDescription) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplerName is samplerName (84), string.
+//
+// Deprecated: samplerName is deprecated in the IANA registry.
+type SamplerName struct {
+	Val string
+}
+
+func (fv *SamplerName) ElementID() uint16 {
+	return IEID_SAMPLER_NAME
+}
+
+func (fv *SamplerName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplerName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *SamplerName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *SamplerName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *SamplerName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// OctetTotalCount is octetTotalCount (85), unsigned64 totalCounter in octets.
+type OctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *OctetTotalCount) ElementID() uint16 {
+	return IEID_OCTET_TOTAL_COUNT
+}
+
+func (fv *OctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *OctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *OctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *OctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlagsAndSamplerId is flagsAndSamplerId (87), unsigned32 identifier.
+//
+// Deprecated: flagsAndSamplerId is deprecated in the IANA registry.
+type FlagsAndSamplerId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *FlagsAndSamplerId) ElementID() uint16 {
+	return IEID_FLAGS_AND_SAMPLING_ID
+}
+
+func (fv *FlagsAndSamplerId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlagsAndSamplerId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlagsAndSamplerId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *FlagsAndSamplerId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *FlagsAndSamplerId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FragmentOffset is fragmentOffset (88), unsigned16 quantity.
+type FragmentOffset struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *FragmentOffset) ElementID() uint16 {
+	return IEID_FRAGMENT_OFFSET
+}
+
+func (fv *FragmentOffset) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FragmentOffset) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FragmentOffset) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *FragmentOffset) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *FragmentOffset) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ForwardingStatus is forwardingStatus (89), unsigned8 identifier.
+type ForwardingStatus struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *ForwardingStatus) ElementID() uint16 {
+	return IEID_FOREARDING_STATUS
+}
+
+func (fv *ForwardingStatus) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ForwardingStatus) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ForwardingStatus) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *ForwardingStatus) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *ForwardingStatus) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsVpnRouteDistinguisher is mplsVpnRouteDistinguisher (90), octetArray.
+type MplsVpnRouteDistinguisher struct {
+	Val []uint8
+}
+
+func (fv *MplsVpnRouteDistinguisher) ElementID() uint16 {
+	return IEID_MPLS_VPN_ROUTE_DISTINGUISHER
+}
+
+func (fv *MplsVpnRouteDistinguisher) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsVpnRouteDistinguisher) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsVpnRouteDistinguisher) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsVpnRouteDistinguisher) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsVpnRouteDistinguisher) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsTopLabelPrefixLength is mplsTopLabelPrefixLength (91), unsigned8 quantity in bits.
+type MplsTopLabelPrefixLength struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *MplsTopLabelPrefixLength) ElementID() uint16 {
+	return IEID_MPLS_TOP_LABEL_PREFIX_LENGTH
+}
+
+func (fv *MplsTopLabelPrefixLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsTopLabelPrefixLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MplsTopLabelPrefixLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *MplsTopLabelPrefixLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *MplsTopLabelPrefixLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SrcTrafficIndex is srcTrafficIndex (92), unsigned32 identifier.
+type SrcTrafficIndex struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *SrcTrafficIndex) ElementID() uint16 {
+	return IEID_SRC_TRAFFIC_INDEX
+}
+
+func (fv *SrcTrafficIndex) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SrcTrafficIndex) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SrcTrafficIndex) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *SrcTrafficIndex) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *SrcTrafficIndex) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DstTrafficIndex is dstTrafficIndex (93), unsigned32 identifier.
+type DstTrafficIndex struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *DstTrafficIndex) ElementID() uint16 {
+	return IEID_DST_TRAFFIC_INDEX
+}
+
+func (fv *DstTrafficIndex) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DstTrafficIndex) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *DstTrafficIndex) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *DstTrafficIndex) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *DstTrafficIndex) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ApplicationDescription is applicationDescription (94), string.
+type ApplicationDescription struct {
+	Val string
+}
+
+func (fv *ApplicationDescription) ElementID() uint16 {
+	return IEID_APPLICATION_DESCRIPTION
+}
+
+func (fv *ApplicationDescription) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ApplicationDescription) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *ApplicationDescription) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *ApplicationDescription) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *ApplicationDescription) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ApplicationId is applicationId (95), octetArray.
+type ApplicationId struct {
+	Val []uint8
+}
+
+func (fv *ApplicationId) ElementID() uint16 {
+	return IEID_APPLICATION_ID
+}
+
+func (fv *ApplicationId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ApplicationId) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *ApplicationId) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *ApplicationId) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *ApplicationId) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ApplicationName is applicationName (96), string.
+type ApplicationName struct {
+	Val string
+}
+
+func (fv *ApplicationName) ElementID() uint16 {
+	return IEID_APPLICATION_NAME
+}
+
+func (fv *ApplicationName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ApplicationName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *ApplicationName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *ApplicationName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *ApplicationName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostIpDiffServCodePoint is postIpDiffServCodePoint (98), unsigned8 identifier.
+type PostIpDiffServCodePoint struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *PostIpDiffServCodePoint) ElementID() uint16 {
+	return IEID_POST_IP_DIFF_SERV_CODE_POINT
+}
+
+func (fv *PostIpDiffServCodePoint) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostIpDiffServCodePoint) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PostIpDiffServCodePoint) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *PostIpDiffServCodePoint) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *PostIpDiffServCodePoint) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MulticastReplicationFactor is multicastReplicationFactor (99), unsigned32 quantity.
+type MulticastReplicationFactor struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MulticastReplicationFactor) ElementID() uint16 {
+	return IEID_MULTICAST_REPLICATION_FACTOR
+}
+
+func (fv *MulticastReplicationFactor) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MulticastReplicationFactor) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MulticastReplicationFactor) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MulticastReplicationFactor) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MulticastReplicationFactor) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ClassName is className (100), string.
+//
+// Deprecated: className is deprecated in the IANA registry.
+type ClassName struct {
+	Val string
+}
+
+func (fv *ClassName) ElementID() uint16 {
+	return IEID_CLASS_NAME
+}
+
+func (fv *ClassName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ClassName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *ClassName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *ClassName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *ClassName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ClassificationEngineId is classificationEngineId (101), unsigned8 identifier.
+type ClassificationEngineId struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *ClassificationEngineId) ElementID() uint16 {
+	return IEID_CLASSIFICATION_ENGINE_ID
+}
+
+func (fv *ClassificationEngineId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ClassificationEngineId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ClassificationEngineId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *ClassificationEngineId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *ClassificationEngineId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Layer2packetSectionOffset is layer2packetSectionOffset (102), unsigned16 quantity.
+//
+// Deprecated: layer2packetSectionOffset is deprecated in the IANA registry.
+type Layer2packetSectionOffset struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *Layer2packetSectionOffset) ElementID() uint16 {
+	return IEID_LAYER2_PACKET_SECTION_OFFSET
+}
+
+func (fv *Layer2packetSectionOffset) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Layer2packetSectionOffset) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Layer2packetSectionOffset) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *Layer2packetSectionOffset) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *Layer2packetSectionOffset) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Layer2packetSectionSize is layer2packetSectionSize (103), unsigned16 quantity.
+//
+// Deprecated: layer2packetSectionSize is deprecated in the IANA registry.
+type Layer2packetSectionSize struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *Layer2packetSectionSize) ElementID() uint16 {
+	return IEID_LAYER2_PACKET_SECTION_SIZE
+}
+
+func (fv *Layer2packetSectionSize) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Layer2packetSectionSize) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Layer2packetSectionSize) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *Layer2packetSectionSize) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *Layer2packetSectionSize) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Layer2packetSectionData is layer2packetSectionData (104), octetArray.
+//
+// Deprecated: layer2packetSectionData is deprecated in the IANA registry.
+type Layer2packetSectionData struct {
+	Val []uint8
+}
+
+func (fv *Layer2packetSectionData) ElementID() uint16 {
+	return IEID_LAYER2_PACKET_SECTION_DATA
+}
+
+func (fv *Layer2packetSectionData) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Layer2packetSectionData) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *Layer2packetSectionData) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *Layer2packetSectionData) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *Layer2packetSectionData) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpNextAdjacentAsNumber is bgpNextAdjacentAsNumber (128), unsigned32 identifier.
+type BgpNextAdjacentAsNumber struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *BgpNextAdjacentAsNumber) ElementID() uint16 {
+	return IEID_BGP_NEXT_ADJACENT_AS_NUMBER
+}
+
+func (fv *BgpNextAdjacentAsNumber) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpNextAdjacentAsNumber) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *BgpNextAdjacentAsNumber) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *BgpNextAdjacentAsNumber) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *BgpNextAdjacentAsNumber) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpPrevAdjacentAsNumber is bgpPrevAdjacentAsNumber (129), unsigned32 identifier.
+type BgpPrevAdjacentAsNumber struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *BgpPrevAdjacentAsNumber) ElementID() uint16 {
+	return IEID_BGP_PREV_ADJACENT_AS_NUMBER
+}
+
+func (fv *BgpPrevAdjacentAsNumber) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpPrevAdjacentAsNumber) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *BgpPrevAdjacentAsNumber) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *BgpPrevAdjacentAsNumber) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *BgpPrevAdjacentAsNumber) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ExporterIPv4Address is exporterIPv4Address (130), ipv4Address.
+type ExporterIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *ExporterIPv4Address) ElementID() uint16 {
+	return IEID_EXPORTER_IPV4_ADDRESS
+}
+
+func (fv *ExporterIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExporterIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *ExporterIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *ExporterIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *ExporterIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ExporterIPv6Address is exporterIPv6Address (131), ipv6Address.
+type ExporterIPv6Address struct {
+	Val netip.Addr
+}
+
+func (fv *ExporterIPv6Address) ElementID() uint16 {
+	return IEID_EXPORTER_IPV6_ADDRESS
+}
+
+func (fv *ExporterIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExporterIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *ExporterIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *ExporterIPv6Address) Len() uint16 {
+	return 16
+}
+
+func (fv *ExporterIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DroppedOctetDeltaCount is droppedOctetDeltaCount (132), unsigned64 deltaCounter in octets.
+type DroppedOctetDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DroppedOctetDeltaCount) ElementID() uint16 {
+	return IEID_DROPPED_OCTET_DELTA_COUNT
+}
+
+func (fv *DroppedOctetDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DroppedOctetDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DroppedOctetDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DroppedOctetDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DroppedOctetDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DroppedPacketDeltaCount is droppedPacketDeltaCount (133), unsigned64 deltaCounter in packets.
+type DroppedPacketDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DroppedPacketDeltaCount) ElementID() uint16 {
+	return IEID_DROPPED_PACKET_DELTA_COUNT
+}
+
+func (fv *DroppedPacketDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DroppedPacketDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DroppedPacketDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DroppedPacketDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DroppedPacketDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DroppedOctetTotalCount is droppedOctetTotalCount (134), unsigned64 totalCounter in octets.
+type DroppedOctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DroppedOctetTotalCount) ElementID() uint16 {
+	return IEID_DROPPED_OCTET_TOTAL_COUNT
+}
+
+func (fv *DroppedOctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DroppedOctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DroppedOctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DroppedOctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DroppedOctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DroppedPacketTotalCount is droppedPacketTotalCount (135), unsigned64 totalCounter in packets.
+type DroppedPacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DroppedPacketTotalCount) ElementID() uint16 {
+	return IEID_DROPPED_PACKET_TOTAL_COUNT
+}
+
+func (fv *DroppedPacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DroppedPacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DroppedPacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DroppedPacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DroppedPacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowEndReason is flowEndReason (136), unsigned8 identifier.
+type FlowEndReason struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *FlowEndReason) ElementID() uint16 {
+	return IEID_FLOW_END_REASON
+}
+
+func (fv *FlowEndReason) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowEndReason) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowEndReason) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *FlowEndReason) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *FlowEndReason) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// CommonPropertiesId is commonPropertiesId (137), unsigned64 identifier.
+type CommonPropertiesId struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *CommonPropertiesId) ElementID() uint16 {
+	return IEID_COMMON_PROPERTIES_ID
+}
+
+func (fv *CommonPropertiesId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *CommonPropertiesId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *CommonPropertiesId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *CommonPropertiesId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *CommonPropertiesId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ObservationPointId is observationPointId (138), unsigned64 identifier.
+type ObservationPointId struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *ObservationPointId) ElementID() uint16 {
+	return IEID_OBSERVATION_POINT_ID
+}
+
+func (fv *ObservationPointId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ObservationPointId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *ObservationPointId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *ObservationPointId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *ObservationPointId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IcmpTypeCodeIPv6 is icmpTypeCodeIPv6 (139), unsigned16 identifier.
+type IcmpTypeCodeIPv6 struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *IcmpTypeCodeIPv6) ElementID() uint16 {
+	return IEID_ICMP_TYPE_CODE_IPV6
+}
+
+func (fv *IcmpTypeCodeIPv6) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IcmpTypeCodeIPv6) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IcmpTypeCodeIPv6) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *IcmpTypeCodeIPv6) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *IcmpTypeCodeIPv6) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsTopLabelIPv6Address is mplsTopLabelIPv6Address (140), ipv6Address.
+type MplsTopLabelIPv6Address struct {
+	Val netip.Addr
+}
+
+func (fv *MplsTopLabelIPv6Address) ElementID() uint16 {
+	return IEID_MPLS_TOP_LABEL_IPV6_ADDRESS
+}
+
+func (fv *MplsTopLabelIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsTopLabelIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *MplsTopLabelIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *MplsTopLabelIPv6Address) Len() uint16 {
+	return 16
+}
+
+func (fv *MplsTopLabelIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// LineCardId is lineCardId (141), unsigned32 identifier.
+type LineCardId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *LineCardId) ElementID() uint16 {
+	return IEID_LINE_CARD_ID
+}
+
+func (fv *LineCardId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *LineCardId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *LineCardId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *LineCardId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *LineCardId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PortId is portId (142), unsigned32 identifier.
+type PortId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *PortId) ElementID() uint16 {
+	return IEID_PORT_ID
+}
+
+func (fv *PortId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PortId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PortId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *PortId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *PortId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MeteringProcessId is meteringProcessId (143), unsigned32 identifier.
+type MeteringProcessId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MeteringProcessId) ElementID() uint16 {
+	return IEID_METERING_PROCESS_ID
+}
+
+func (fv *MeteringProcessId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MeteringProcessId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MeteringProcessId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MeteringProcessId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MeteringProcessId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ExportingProcessId is exportingProcessId (144), unsigned32 identifier.
+type ExportingProcessId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *ExportingProcessId) ElementID() uint16 {
+	return IEID_EXPORTING_PROCESS_ID
+}
+
+func (fv *ExportingProcessId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExportingProcessId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ExportingProcessId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *ExportingProcessId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *ExportingProcessId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TemplateId is templateId (145), unsigned16 identifier.
+type TemplateId struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *TemplateId) ElementID() uint16 {
+	return IEID_TEMPLATE_ID
+}
+
+func (fv *TemplateId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TemplateId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TemplateId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *TemplateId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *TemplateId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// WlanChannelId is wlanChannelId (146), unsigned8 identifier.
+type WlanChannelId struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *WlanChannelId) ElementID() uint16 {
+	return IEID_WLAN_CHANNEL_ID
+}
+
+func (fv *WlanChannelId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *WlanChannelId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *WlanChannelId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *WlanChannelId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *WlanChannelId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// WlanSSID is wlanSSID (147), string.
+type WlanSSID struct {
+	Val string
+}
+
+func (fv *WlanSSID) ElementID() uint16 {
+	return IEID_WLAN_SSID
+}
+
+func (fv *WlanSSID) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *WlanSSID) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *WlanSSID) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *WlanSSID) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *WlanSSID) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowId is flowId (148), unsigned64 identifier.
+type FlowId struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *FlowId) ElementID() uint16 {
+	return IEID_FLOW_ID
+}
+
+func (fv *FlowId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *FlowId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *FlowId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *FlowId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowStartSeconds is flowStartSeconds (150), dateTimeSeconds in seconds.
+type FlowStartSeconds struct {
+	Val time.Time
+}
+
+func (fv *FlowStartSeconds) ElementID() uint16 {
+	return IEID_FLOW_START_SECONDS
+}
+
+func (fv *FlowStartSeconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowStartSeconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeSeconds(dst, fv.Val)
+}
+
+func (fv *FlowStartSeconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeSeconds(data)
+	return nil
+}
+
+func (fv *FlowStartSeconds) Len() uint16 {
+	return 4
+}
+
+func (fv *FlowStartSeconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowEndSeconds is flowEndSeconds (151), dateTimeSeconds in seconds.
+type FlowEndSeconds struct {
+	Val time.Time
+}
+
+func (fv *FlowEndSeconds) ElementID() uint16 {
+	return IEID_FLOW_END_SECONDS
+}
+
+func (fv *FlowEndSeconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowEndSeconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeSeconds(dst, fv.Val)
+}
+
+func (fv *FlowEndSeconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeSeconds(data)
+	return nil
+}
+
+func (fv *FlowEndSeconds) Len() uint16 {
+	return 4
+}
+
+func (fv *FlowEndSeconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowStartMicroseconds is flowStartMicroseconds (154), dateTimeMicroseconds in microseconds.
+type FlowStartMicroseconds struct {
+	Val time.Time
+}
+
+func (fv *FlowStartMicroseconds) ElementID() uint16 {
+	return IEID_FLOW_START_MICROSECONDS
+}
+
+func (fv *FlowStartMicroseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowStartMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMicroseconds(dst, fv.Val)
+}
+
+func (fv *FlowStartMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMicroseconds(data)
+	return nil
+}
+
+func (fv *FlowStartMicroseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *FlowStartMicroseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowEndMicroseconds is flowEndMicroseconds (155), dateTimeMicroseconds in microseconds.
+type FlowEndMicroseconds struct {
+	Val time.Time
+}
+
+func (fv *FlowEndMicroseconds) ElementID() uint16 {
+	return IEID_FLOW_END_MICROSECONDS
+}
+
+func (fv *FlowEndMicroseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowEndMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMicroseconds(dst, fv.Val)
+}
+
+func (fv *FlowEndMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMicroseconds(data)
+	return nil
+}
+
+func (fv *FlowEndMicroseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *FlowEndMicroseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowStartNanoseconds is flowStartNanoseconds (156), dateTimeNanoseconds in nanoseconds.
+type FlowStartNanoseconds struct {
+	Val time.Time
+}
+
+func (fv *FlowStartNanoseconds) ElementID() uint16 {
+	return IEID_FLOW_START_NANOSECONDS
+}
+
+func (fv *FlowStartNanoseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowStartNanoseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeNanoseconds(dst, fv.Val)
+}
+
+func (fv *FlowStartNanoseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeNanoseconds(data)
+	return nil
+}
+
+func (fv *FlowStartNanoseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *FlowStartNanoseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowEndNanoseconds is flowEndNanoseconds (157), dateTimeNanoseconds in nanoseconds.
+type FlowEndNanoseconds struct {
+	Val time.Time
+}
+
+func (fv *FlowEndNanoseconds) ElementID() uint16 {
+	return IEID_FLOW_END_NANOSECONDS
+}
+
+func (fv *FlowEndNanoseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowEndNanoseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeNanoseconds(dst, fv.Val)
+}
+
+func (fv *FlowEndNanoseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeNanoseconds(data)
+	return nil
+}
+
+func (fv *FlowEndNanoseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *FlowEndNanoseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowStartDeltaMicroseconds is flowStartDeltaMicroseconds (158), unsigned32 in microseconds.
+type FlowStartDeltaMicroseconds struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *FlowStartDeltaMicroseconds) ElementID() uint16 {
+	return IEID_FLOW_START_DELTA_MICROSECONDS
+}
+
+func (fv *FlowStartDeltaMicroseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowStartDeltaMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowStartDeltaMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *FlowStartDeltaMicroseconds) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *FlowStartDeltaMicroseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowEndDeltaMicroseconds is flowEndDeltaMicroseconds (159), unsigned32 in microseconds.
+type FlowEndDeltaMicroseconds struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *FlowEndDeltaMicroseconds) ElementID() uint16 {
+	return IEID_FLOW_END_DELTA_MICROSECONDS
+}
+
+func (fv *FlowEndDeltaMicroseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowEndDeltaMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowEndDeltaMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *FlowEndDeltaMicroseconds) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *FlowEndDeltaMicroseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SystemInitTimeMilliseconds is systemInitTimeMilliseconds (160), dateTimeMilliseconds in milliseconds.
+type SystemInitTimeMilliseconds struct {
+	Val time.Time
+}
+
+func (fv *SystemInitTimeMilliseconds) ElementID() uint16 {
+	return IEID_SYSTEM_INIT_TIME_MILLISECONDS
+}
+
+func (fv *SystemInitTimeMilliseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SystemInitTimeMilliseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMilliseconds(dst, fv.Val)
+}
+
+func (fv *SystemInitTimeMilliseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMilliseconds(data)
+	return nil
+}
+
+func (fv *SystemInitTimeMilliseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *SystemInitTimeMilliseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowDurationMilliseconds is flowDurationMilliseconds (161), unsigned32 in milliseconds.
+type FlowDurationMilliseconds struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *FlowDurationMilliseconds) ElementID() uint16 {
+	return IEID_FLOW_DURATION_MILLISECONDS
+}
+
+func (fv *FlowDurationMilliseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowDurationMilliseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowDurationMilliseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *FlowDurationMilliseconds) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *FlowDurationMilliseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowDurationMicroseconds is flowDurationMicroseconds (162), unsigned32 in microseconds.
+type FlowDurationMicroseconds struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *FlowDurationMicroseconds) ElementID() uint16 {
+	return IEID_FLOW_DURATION_MICROSECONDS
+}
+
+func (fv *FlowDurationMicroseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowDurationMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowDurationMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *FlowDurationMicroseconds) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *FlowDurationMicroseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ObservedFlowTotalCount is observedFlowTotalCount (163), unsigned64 totalCounter in flows.
+type ObservedFlowTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *ObservedFlowTotalCount) ElementID() uint16 {
+	return IEID_OBSERVED_FLOW_TOTAL_COUNT
+}
+
+func (fv *ObservedFlowTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ObservedFlowTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *ObservedFlowTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *ObservedFlowTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *ObservedFlowTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IgnoredOctetTotalCount is ignoredOctetTotalCount (165), unsigned64 totalCounter in octets.
+type IgnoredOctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *IgnoredOctetTotalCount) ElementID() uint16 {
+	return IEID_IGNORED_OCTET_TOTAL_COUNT
+}
+
+func (fv *IgnoredOctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IgnoredOctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *IgnoredOctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *IgnoredOctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *IgnoredOctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NotSentPacketTotalCount is notSentPacketTotalCount (167), unsigned64 totalCounter in packets.
+type NotSentPacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *NotSentPacketTotalCount) ElementID() uint16 {
+	return IEID_NOT_SENT_PACKET_TOTAL_COUNT
+}
+
+func (fv *NotSentPacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NotSentPacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *NotSentPacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *NotSentPacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *NotSentPacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NotSentOctetTotalCount is notSentOctetTotalCount (168), unsigned64 totalCounter in octets.
+type NotSentOctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *NotSentOctetTotalCount) ElementID() uint16 {
+	return IEID_NOT_SENT_OCTET_TOTAL_COUNT
+}
+
+func (fv *NotSentOctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NotSentOctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *NotSentOctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *NotSentOctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *NotSentOctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DestinationIPv6Prefix is destinationIPv6Prefix (169), ipv6Address.
+type DestinationIPv6Prefix struct {
+	Val netip.Addr
+}
+
+func (fv *DestinationIPv6Prefix) ElementID() uint16 {
+	return IEID_DESTINATION_IPV6_PREFIX
+}
+
+func (fv *DestinationIPv6Prefix) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DestinationIPv6Prefix) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *DestinationIPv6Prefix) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *DestinationIPv6Prefix) Len() uint16 {
+	return 16
+}
+
+func (fv *DestinationIPv6Prefix) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SourceIPv6Prefix is sourceIPv6Prefix (170), ipv6Address.
+type SourceIPv6Prefix struct {
+	Val netip.Addr
+}
+
+func (fv *SourceIPv6Prefix) ElementID() uint16 {
+	return IEID_SOURCE_IPV6_PREFIX
+}
+
+func (fv *SourceIPv6Prefix) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SourceIPv6Prefix) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *SourceIPv6Prefix) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *SourceIPv6Prefix) Len() uint16 {
+	return 16
+}
+
+func (fv *SourceIPv6Prefix) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostOctetTotalCount is postOctetTotalCount (171), unsigned64 totalCounter in octets.
+type PostOctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostOctetTotalCount) ElementID() uint16 {
+	return IEID_POST_OCTET_TOTAL_COUNT
+}
+
+func (fv *PostOctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostOctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostOctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostOctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostOctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostPacketTotalCount is postPacketTotalCount (172), unsigned64 totalCounter in packets.
+type PostPacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostPacketTotalCount) ElementID() uint16 {
+	return IEID_POST_PACKET_TOTAL_COUNT
+}
+
+func (fv *PostPacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostPacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostPacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostPacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostPacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowKeyIndicator is flowKeyIndicator (173), unsigned64 flags.
+type FlowKeyIndicator struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *FlowKeyIndicator) ElementID() uint16 {
+	return IEID_FLOW_KEY_INDICATOR
+}
+
+func (fv *FlowKeyIndicator) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowKeyIndicator) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *FlowKeyIndicator) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *FlowKeyIndicator) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *FlowKeyIndicator) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostMCastPacketTotalCount is postMCastPacketTotalCount (174), unsigned64 totalCounter in packets.
+type PostMCastPacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostMCastPacketTotalCount) ElementID() uint16 {
+	return IEID_POST_MCAST_PACKET_TOTAL_COUNT
+}
+
+func (fv *PostMCastPacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostMCastPacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostMCastPacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostMCastPacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostMCastPacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostMCastOctetTotalCount is postMCastOctetTotalCount (175), unsigned64 totalCounter in octets.
+type PostMCastOctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostMCastOctetTotalCount) ElementID() uint16 {
+	return IEID_POST_MCAST_OCTET_TOTAL_COUNT
+}
+
+func (fv *PostMCastOctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostMCastOctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostMCastOctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostMCastOctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostMCastOctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IcmpTypeIPv4 is icmpTypeIPv4 (176), unsigned8 identifier.
+type IcmpTypeIPv4 struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IcmpTypeIPv4) ElementID() uint16 {
+	return IEID_ICMP_TYPE_IPV4
+}
+
+func (fv *IcmpTypeIPv4) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IcmpTypeIPv4) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IcmpTypeIPv4) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IcmpTypeIPv4) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IcmpTypeIPv4) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IcmpCodeIPv4 is icmpCodeIPv4 (177), unsigned8 identifier.
+type IcmpCodeIPv4 struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IcmpCodeIPv4) ElementID() uint16 {
+	return IEID_ICMP_CODE_IPV4
+}
+
+func (fv *IcmpCodeIPv4) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IcmpCodeIPv4) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IcmpCodeIPv4) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IcmpCodeIPv4) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IcmpCodeIPv4) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IcmpTypeIPv6 is icmpTypeIPv6 (178), unsigned8 identifier.
+type IcmpTypeIPv6 struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IcmpTypeIPv6) ElementID() uint16 {
+	return IEID_ICMP_TYPE_IPV6
+}
+
+func (fv *IcmpTypeIPv6) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IcmpTypeIPv6) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IcmpTypeIPv6) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IcmpTypeIPv6) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IcmpTypeIPv6) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IcmpCodeIPv6 is icmpCodeIPv6 (179), unsigned8 identifier.
+type IcmpCodeIPv6 struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IcmpCodeIPv6) ElementID() uint16 {
+	return IEID_ICMP_CODE_IPV6
+}
+
+func (fv *IcmpCodeIPv6) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IcmpCodeIPv6) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IcmpCodeIPv6) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IcmpCodeIPv6) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IcmpCodeIPv6) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// UdpSourcePort is udpSourcePort (180), unsigned16 identifier.
+type UdpSourcePort struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *UdpSourcePort) ElementID() uint16 {
+	return IEID_UDP_SOURCE_PORT
+}
+
+func (fv *UdpSourcePort) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *UdpSourcePort) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *UdpSourcePort) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *UdpSourcePort) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *UdpSourcePort) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// UdpDestinationPort is udpDestinationPort (181), unsigned16 identifier.
+type UdpDestinationPort struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *UdpDestinationPort) ElementID() uint16 {
+	return IEID_UDP_DESTINATION_PORT
+}
+
+func (fv *UdpDestinationPort) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *UdpDestinationPort) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *UdpDestinationPort) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *UdpDestinationPort) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *UdpDestinationPort) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpSourcePort is tcpSourcePort (182), unsigned16 identifier.
+type TcpSourcePort struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *TcpSourcePort) ElementID() uint16 {
+	return IEID_TCP_SOURCE_PORT
+}
+
+func (fv *TcpSourcePort) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpSourcePort) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TcpSourcePort) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *TcpSourcePort) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *TcpSourcePort) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpDestinationPort is tcpDestinationPort (183), unsigned16 identifier.
+type TcpDestinationPort struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *TcpDestinationPort) ElementID() uint16 {
+	return IEID_TCP_DESTINATION_PORT
+}
+
+func (fv *TcpDestinationPort) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpDestinationPort) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TcpDestinationPort) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *TcpDestinationPort) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *TcpDestinationPort) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpSequenceNumber is tcpSequenceNumber (184), unsigned32.
+type TcpSequenceNumber struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *TcpSequenceNumber) ElementID() uint16 {
+	return IEID_TCP_SEQUENCE_NUMBER
+}
+
+func (fv *TcpSequenceNumber) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpSequenceNumber) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TcpSequenceNumber) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *TcpSequenceNumber) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *TcpSequenceNumber) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpAcknowledgementNumber is tcpAcknowledgementNumber (185), unsigned32.
+type TcpAcknowledgementNumber struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *TcpAcknowledgementNumber) ElementID() uint16 {
+	return IEID_TCP_ACKNOWLEDGEMENT_NUMBER
+}
+
+func (fv *TcpAcknowledgementNumber) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpAcknowledgementNumber) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TcpAcknowledgementNumber) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *TcpAcknowledgementNumber) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *TcpAcknowledgementNumber) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpWindowSize is tcpWindowSize (186), unsigned16.
+type TcpWindowSize struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *TcpWindowSize) ElementID() uint16 {
+	return IEID_TCP_WINDOW_SIZE
+}
+
+func (fv *TcpWindowSize) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpWindowSize) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TcpWindowSize) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *TcpWindowSize) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *TcpWindowSize) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpUrgentPointer is tcpUrgentPointer (187), unsigned16.
+type TcpUrgentPointer struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *TcpUrgentPointer) ElementID() uint16 {
+	return IEID_TCP_URGENT_POINTER
+}
+
+func (fv *TcpUrgentPointer) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpUrgentPointer) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TcpUrgentPointer) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *TcpUrgentPointer) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *TcpUrgentPointer) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpHeaderLength is tcpHeaderLength (188), unsigned8 in octets.
+type TcpHeaderLength struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *TcpHeaderLength) ElementID() uint16 {
+	return IEID_TCP_HEADER_LENGTH
+}
+
+func (fv *TcpHeaderLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpHeaderLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TcpHeaderLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *TcpHeaderLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *TcpHeaderLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpHeaderLength is ipHeaderLength (189), unsigned8 in octets.
+type IpHeaderLength struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IpHeaderLength) ElementID() uint16 {
+	return IEID_IP_HEADER_LENGTH
+}
+
+func (fv *IpHeaderLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpHeaderLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IpHeaderLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IpHeaderLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IpHeaderLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TotalLengthIPv4 is totalLengthIPv4 (190), unsigned16 in octets.
+type TotalLengthIPv4 struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *TotalLengthIPv4) ElementID() uint16 {
+	return IEID_TOTAL_LENGTH_IPV4
+}
+
+func (fv *TotalLengthIPv4) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TotalLengthIPv4) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TotalLengthIPv4) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *TotalLengthIPv4) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *TotalLengthIPv4) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PayloadLengthIPv6 is payloadLengthIPv6 (191), unsigned16 in octets.
+type PayloadLengthIPv6 struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PayloadLengthIPv6) ElementID() uint16 {
+	return IEID_PAYLOAD_LENGTH_IPV6
+}
+
+func (fv *PayloadLengthIPv6) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PayloadLengthIPv6) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PayloadLengthIPv6) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PayloadLengthIPv6) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PayloadLengthIPv6) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpTTL is ipTTL (192), unsigned8 in hops.
+type IpTTL struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IpTTL) ElementID() uint16 {
+	return IEID_IP_TTL
+}
+
+func (fv *IpTTL) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpTTL) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IpTTL) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IpTTL) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IpTTL) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NextHeaderIPv6 is nextHeaderIPv6 (193), unsigned8.
+type NextHeaderIPv6 struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *NextHeaderIPv6) ElementID() uint16 {
+	return IEID_NEXT_HEADER_IPV6
+}
+
+func (fv *NextHeaderIPv6) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NextHeaderIPv6) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *NextHeaderIPv6) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *NextHeaderIPv6) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *NextHeaderIPv6) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsPayloadLength is mplsPayloadLength (194), unsigned32 in octets.
+type MplsPayloadLength struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MplsPayloadLength) ElementID() uint16 {
+	return IEID_MPLS_PAYLOAD_LENGTH
+}
+
+func (fv *MplsPayloadLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsPayloadLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MplsPayloadLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MplsPayloadLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MplsPayloadLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpDiffServCodePoint is ipDiffServCodePoint (195), unsigned8 identifier.
+type IpDiffServCodePoint struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IpDiffServCodePoint) ElementID() uint16 {
+	return IEID_IP_DIFF_SERV_CODE_POINT
+}
+
+func (fv *IpDiffServCodePoint) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpDiffServCodePoint) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IpDiffServCodePoint) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IpDiffServCodePoint) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IpDiffServCodePoint) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpPrecedence is ipPrecedence (196), unsigned8 identifier.
+type IpPrecedence struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IpPrecedence) ElementID() uint16 {
+	return IEID_IP_PRECEDENCE
+}
+
+func (fv *IpPrecedence) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpPrecedence) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IpPrecedence) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IpPrecedence) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IpPrecedence) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FragmentFlags is fragmentFlags (197), unsigned8 flags.
+type FragmentFlags struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *FragmentFlags) ElementID() uint16 {
+	return IEID_FRAGMENT_FLAGS
+}
+
+func (fv *FragmentFlags) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FragmentFlags) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FragmentFlags) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *FragmentFlags) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *FragmentFlags) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// OctetDeltaSumOfSquares is octetDeltaSumOfSquares (198), unsigned64.
+type OctetDeltaSumOfSquares struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *OctetDeltaSumOfSquares) ElementID() uint16 {
+	return IEID_OCTET_DELTA_SUM_OF_SQUARES
+}
+
+func (fv *OctetDeltaSumOfSquares) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OctetDeltaSumOfSquares) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *OctetDeltaSumOfSquares) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *OctetDeltaSumOfSquares) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *OctetDeltaSumOfSquares) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// OctetTotalSumOfSquares is octetTotalSumOfSquares (199), unsigned64 in octets.
+type OctetTotalSumOfSquares struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *OctetTotalSumOfSquares) ElementID() uint16 {
+	return IEID_OCTET_TOTAL_SUM_OF_SQUARES
+}
+
+func (fv *OctetTotalSumOfSquares) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OctetTotalSumOfSquares) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *OctetTotalSumOfSquares) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *OctetTotalSumOfSquares) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *OctetTotalSumOfSquares) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsTopLabelTTL is mplsTopLabelTTL (200), unsigned8 in hops.
+type MplsTopLabelTTL struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *MplsTopLabelTTL) ElementID() uint16 {
+	return IEID_MPLS_TOP_LABEL_TTL
+}
+
+func (fv *MplsTopLabelTTL) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsTopLabelTTL) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MplsTopLabelTTL) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *MplsTopLabelTTL) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *MplsTopLabelTTL) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackLength is mplsLabelStackLength (201), unsigned32 in octets.
+type MplsLabelStackLength struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MplsLabelStackLength) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_LENGTH
+}
+
+func (fv *MplsLabelStackLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MplsLabelStackLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MplsLabelStackLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MplsLabelStackLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackDepth is mplsLabelStackDepth (202), unsigned32 in entries.
+type MplsLabelStackDepth struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MplsLabelStackDepth) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_DEPTH
+}
+
+func (fv *MplsLabelStackDepth) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackDepth) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MplsLabelStackDepth) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MplsLabelStackDepth) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MplsLabelStackDepth) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsTopLabelExp is mplsTopLabelExp (203), unsigned8 flags.
+type MplsTopLabelExp struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *MplsTopLabelExp) ElementID() uint16 {
+	return IEID_MPLS_TOP_LABEL_EXP
+}
+
+func (fv *MplsTopLabelExp) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsTopLabelExp) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MplsTopLabelExp) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *MplsTopLabelExp) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *MplsTopLabelExp) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpPayloadLength is ipPayloadLength (204), unsigned32 in octets.
+type IpPayloadLength struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *IpPayloadLength) ElementID() uint16 {
+	return IEID_IP_PAYLOAD_LENGTH
+}
+
+func (fv *IpPayloadLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpPayloadLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IpPayloadLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *IpPayloadLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *IpPayloadLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// UdpMessageLength is udpMessageLength (205), unsigned16 in octets.
+type UdpMessageLength struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *UdpMessageLength) ElementID() uint16 {
+	return IEID_UDP_MESSAGE_LENGTH
+}
+
+func (fv *UdpMessageLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *UdpMessageLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *UdpMessageLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *UdpMessageLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *UdpMessageLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IsMulticast is isMulticast (206), unsigned8 flags.
+type IsMulticast struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *IsMulticast) ElementID() uint16 {
+	return IEID_IS_MULTICAST
+}
+
+func (fv *IsMulticast) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IsMulticast) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IsMulticast) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *IsMulticast) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *IsMulticast) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Ipv4IHL is ipv4IHL (207), unsigned8 in 4-octet words.
+type Ipv4IHL struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *Ipv4IHL) ElementID() uint16 {
+	return IEID_IPV4_IHL
+}
+
+func (fv *Ipv4IHL) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Ipv4IHL) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Ipv4IHL) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *Ipv4IHL) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *Ipv4IHL) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Ipv4Options is ipv4Options (208), unsigned32 flags.
+type Ipv4Options struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *Ipv4Options) ElementID() uint16 {
+	return IEID_IPV4_OPTIONS
+}
+
+func (fv *Ipv4Options) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Ipv4Options) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Ipv4Options) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *Ipv4Options) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *Ipv4Options) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpOptions is tcpOptions (209), unsigned64 flags.
+type TcpOptions struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *TcpOptions) ElementID() uint16 {
+	return IEID_TCP_OPTIONS
+}
+
+func (fv *TcpOptions) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpOptions) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *TcpOptions) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *TcpOptions) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *TcpOptions) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PaddingOctets is paddingOctets (210), octetArray.
+type PaddingOctets struct {
+	Val []uint8
+}
+
+func (fv *PaddingOctets) ElementID() uint16 {
+	return IEID_PADDING_OCTETS
+}
+
+func (fv *PaddingOctets) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PaddingOctets) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *PaddingOctets) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *PaddingOctets) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *PaddingOctets) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// CollectorIPv4Address is collectorIPv4Address (211), ipv4Address.
+type CollectorIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *CollectorIPv4Address) ElementID() uint16 {
+	return IEID_COLLECTOR_IPV4_ADDRESS
+}
+
+func (fv *CollectorIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *CollectorIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *CollectorIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *CollectorIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *CollectorIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// CollectorIPv6Address is collectorIPv6Address (212), ipv6Address.
+type CollectorIPv6Address struct {
+	Val netip.Addr
+}
+
+func (fv *CollectorIPv6Address) ElementID() uint16 {
+	return IEID_COLLECTOR_IPV6_ADDRESS
+}
+
+func (fv *CollectorIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *CollectorIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *CollectorIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *CollectorIPv6Address) Len() uint16 {
+	return 16
+}
+
+func (fv *CollectorIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ExportInterface is exportInterface (213), unsigned32 identifier.
+type ExportInterface struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *ExportInterface) ElementID() uint16 {
+	return IEID_EXPORT_INTERFACE
+}
+
+func (fv *ExportInterface) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExportInterface) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ExportInterface) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *ExportInterface) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *ExportInterface) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ExportProtocolVersion is exportProtocolVersion (214), unsigned8 identifier.
+type ExportProtocolVersion struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *ExportProtocolVersion) ElementID() uint16 {
+	return IEID_EXPORT_PROTOCOL_VERSION
+}
+
+func (fv *ExportProtocolVersion) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExportProtocolVersion) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ExportProtocolVersion) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *ExportProtocolVersion) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *ExportProtocolVersion) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ExportTransportProtocol is exportTransportProtocol (215), unsigned8 identifier.
+type ExportTransportProtocol struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *ExportTransportProtocol) ElementID() uint16 {
+	return IEID_EXPORT_TRANSPORT_PROTOCOL
+}
+
+func (fv *ExportTransportProtocol) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExportTransportProtocol) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ExportTransportProtocol) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *ExportTransportProtocol) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *ExportTransportProtocol) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// CollectorTransportPort is collectorTransportPort (216), unsigned16 identifier.
+type CollectorTransportPort struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *CollectorTransportPort) ElementID() uint16 {
+	return IEID_COLLECTOR_TRANSPORT_PORT
+}
+
+func (fv *CollectorTransportPort) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *CollectorTransportPort) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *CollectorTransportPort) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *CollectorTransportPort) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *CollectorTransportPort) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ExporterTransportPort is exporterTransportPort (217), unsigned16 identifier.
+type ExporterTransportPort struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *ExporterTransportPort) ElementID() uint16 {
+	return IEID_EXPORTER_TRANSPORT_PORT
+}
+
+func (fv *ExporterTransportPort) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExporterTransportPort) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ExporterTransportPort) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *ExporterTransportPort) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *ExporterTransportPort) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpSynTotalCount is tcpSynTotalCount (218), unsigned64 totalCounter in packets.
+type TcpSynTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *TcpSynTotalCount) ElementID() uint16 {
+	return IEID_TCP_SYN_TOTAL_COUNT
+}
+
+func (fv *TcpSynTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpSynTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *TcpSynTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *TcpSynTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *TcpSynTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpFinTotalCount is tcpFinTotalCount (219), unsigned64 totalCounter in packets.
+type TcpFinTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *TcpFinTotalCount) ElementID() uint16 {
+	return IEID_TCP_FIN_TOTAL_COUNT
+}
+
+func (fv *TcpFinTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpFinTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *TcpFinTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *TcpFinTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *TcpFinTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpRstTotalCount is tcpRstTotalCount (220), unsigned64 totalCounter in packets.
+type TcpRstTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *TcpRstTotalCount) ElementID() uint16 {
+	return IEID_TCP_RST_TOTAL_COUNT
+}
+
+func (fv *TcpRstTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpRstTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *TcpRstTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *TcpRstTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *TcpRstTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpPshTotalCount is tcpPshTotalCount (221), unsigned64 totalCounter in packets.
+type TcpPshTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *TcpPshTotalCount) ElementID() uint16 {
+	return IEID_TCP_PSH_TOTAL_COUNT
+}
+
+func (fv *TcpPshTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpPshTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *TcpPshTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *TcpPshTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *TcpPshTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpAckTotalCount is tcpAckTotalCount (222), unsigned64 totalCounter in packets.
+type TcpAckTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *TcpAckTotalCount) ElementID() uint16 {
+	return IEID_TCP_ACK_TOTAL_COUNT
+}
+
+func (fv *TcpAckTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpAckTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *TcpAckTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *TcpAckTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *TcpAckTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpUrgTotalCount is tcpUrgTotalCount (223), unsigned64 totalCounter in packets.
+type TcpUrgTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *TcpUrgTotalCount) ElementID() uint16 {
+	return IEID_TCP_URG_TOTAL_COUNT
+}
+
+func (fv *TcpUrgTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpUrgTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *TcpUrgTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *TcpUrgTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *TcpUrgTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpTotalLength is ipTotalLength (224), unsigned64 in octets.
+type IpTotalLength struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *IpTotalLength) ElementID() uint16 {
+	return IEID_IP_TOTAL_LENGTH
+}
+
+func (fv *IpTotalLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpTotalLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *IpTotalLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *IpTotalLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *IpTotalLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostNATSourceIPv4Address is postNATSourceIPv4Address (225), ipv4Address.
+type PostNATSourceIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *PostNATSourceIPv4Address) ElementID() uint16 {
+	return IEID_POST_NAT_SOURCE_IPV4_ADDRESS
+}
+
+func (fv *PostNATSourceIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostNATSourceIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *PostNATSourceIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *PostNATSourceIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *PostNATSourceIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostNATDestinationIPv4Address is postNATDestinationIPv4Address (226), ipv4Address.
+type PostNATDestinationIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *PostNATDestinationIPv4Address) ElementID() uint16 {
+	return IEID_POST_NAT_DESTINATION_IPV4_ADDRESS
+}
+
+func (fv *PostNATDestinationIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostNATDestinationIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *PostNATDestinationIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *PostNATDestinationIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *PostNATDestinationIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostNAPTSourceTransportPort is postNAPTSourceTransportPort (227), unsigned16 identifier.
+type PostNAPTSourceTransportPort struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PostNAPTSourceTransportPort) ElementID() uint16 {
+	return IEID_POST_NAPT_SOURCE_TRANSPORT_PORT
+}
+
+func (fv *PostNAPTSourceTransportPort) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostNAPTSourceTransportPort) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PostNAPTSourceTransportPort) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PostNAPTSourceTransportPort) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PostNAPTSourceTransportPort) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostNAPTDestinationTransportPort is postNAPTDestinationTransportPort (228), unsigned16 identifier.
+type PostNAPTDestinationTransportPort struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PostNAPTDestinationTransportPort) ElementID() uint16 {
+	return IEID_POST_NAPT_DESTINATION_TRANSPORT_PORT
+}
+
+func (fv *PostNAPTDestinationTransportPort) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostNAPTDestinationTransportPort) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PostNAPTDestinationTransportPort) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PostNAPTDestinationTransportPort) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PostNAPTDestinationTransportPort) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NatOriginatingAddressRealm is natOriginatingAddressRealm (229), unsigned8 identifier.
+type NatOriginatingAddressRealm struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *NatOriginatingAddressRealm) ElementID() uint16 {
+	return IEID_NAT_ORIGINATING_ADDRESS_REALM
+}
+
+func (fv *NatOriginatingAddressRealm) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NatOriginatingAddressRealm) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *NatOriginatingAddressRealm) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *NatOriginatingAddressRealm) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *NatOriginatingAddressRealm) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NatEvent is natEvent (230), unsigned8 identifier.
+type NatEvent struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *NatEvent) ElementID() uint16 {
+	return IEID_NAT_EVENT
+}
+
+func (fv *NatEvent) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NatEvent) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *NatEvent) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *NatEvent) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *NatEvent) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InitiatorOctets is initiatorOctets (231), unsigned64 deltaCounter in octets.
+type InitiatorOctets struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *InitiatorOctets) ElementID() uint16 {
+	return IEID_INITIATOR_OCTETS
+}
+
+func (fv *InitiatorOctets) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InitiatorOctets) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *InitiatorOctets) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *InitiatorOctets) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *InitiatorOctets) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ResponderOctets is responderOctets (232), unsigned64 deltaCounter in octets.
+type ResponderOctets struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *ResponderOctets) ElementID() uint16 {
+	return IEID_RESPONDER_OCTETS
+}
+
+func (fv *ResponderOctets) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ResponderOctets) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *ResponderOctets) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *ResponderOctets) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *ResponderOctets) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FirewallEvent is firewallEvent (233), unsigned8.
+type FirewallEvent struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *FirewallEvent) ElementID() uint16 {
+	return IEID_FIREWALL_EVENT
+}
+
+func (fv *FirewallEvent) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FirewallEvent) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FirewallEvent) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *FirewallEvent) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *FirewallEvent) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IngressVRFID is ingressVRFID (234), unsigned32.
+type IngressVRFID struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *IngressVRFID) ElementID() uint16 {
+	return IEID_INGRESS_VRFID
+}
+
+func (fv *IngressVRFID) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IngressVRFID) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IngressVRFID) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *IngressVRFID) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *IngressVRFID) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EgressVRFID is egressVRFID (235), unsigned32.
+type EgressVRFID struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *EgressVRFID) ElementID() uint16 {
+	return IEID_EGRESS_VRFID
+}
+
+func (fv *EgressVRFID) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EgressVRFID) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *EgressVRFID) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *EgressVRFID) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *EgressVRFID) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// VRFname is VRFname (236), string.
+type VRFname struct {
+	Val string
+}
+
+func (fv *VRFname) ElementID() uint16 {
+	return IEID_VRF_NAME
+}
+
+func (fv *VRFname) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *VRFname) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *VRFname) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *VRFname) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *VRFname) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostMplsTopLabelExp is postMplsTopLabelExp (237), unsigned8 flags.
+type PostMplsTopLabelExp struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *PostMplsTopLabelExp) ElementID() uint16 {
+	return IEID_POST_MPLS_TOP_LABEL_EXP
+}
+
+func (fv *PostMplsTopLabelExp) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostMplsTopLabelExp) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PostMplsTopLabelExp) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *PostMplsTopLabelExp) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *PostMplsTopLabelExp) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TcpWindowScale is tcpWindowScale (238), unsigned16.
+type TcpWindowScale struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *TcpWindowScale) ElementID() uint16 {
+	return IEID_TCP_WINDOW_SCALE
+}
+
+func (fv *TcpWindowScale) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TcpWindowScale) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *TcpWindowScale) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *TcpWindowScale) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *TcpWindowScale) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BiflowDirection is biflowDirection (239), unsigned8 identifier.
+type BiflowDirection struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *BiflowDirection) ElementID() uint16 {
+	return IEID_BIFLOW_DIRECTION
+}
+
+func (fv *BiflowDirection) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BiflowDirection) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *BiflowDirection) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *BiflowDirection) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *BiflowDirection) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EthernetHeaderLength is ethernetHeaderLength (240), unsigned8 quantity in octets.
+type EthernetHeaderLength struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *EthernetHeaderLength) ElementID() uint16 {
+	return IEID_ETHERNET_HEADER_LENGTH
+}
+
+func (fv *EthernetHeaderLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EthernetHeaderLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *EthernetHeaderLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *EthernetHeaderLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *EthernetHeaderLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EthernetPayloadLength is ethernetPayloadLength (241), unsigned16 quantity in octets.
+type EthernetPayloadLength struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *EthernetPayloadLength) ElementID() uint16 {
+	return IEID_ETHERNET_PAYLOAD_LENGTH
+}
+
+func (fv *EthernetPayloadLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EthernetPayloadLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *EthernetPayloadLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *EthernetPayloadLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *EthernetPayloadLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EthernetTotalLength is ethernetTotalLength (242), unsigned16 quantity in octets.
+type EthernetTotalLength struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *EthernetTotalLength) ElementID() uint16 {
+	return IEID_ETHERNET_TOTAL_LENGTH
+}
+
+func (fv *EthernetTotalLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EthernetTotalLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *EthernetTotalLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *EthernetTotalLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *EthernetTotalLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qVlanId is dot1qVlanId (243), unsigned16 identifier.
+type Dot1qVlanId struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *Dot1qVlanId) ElementID() uint16 {
+	return IEID_DOT1Q_VLAN_ID
+}
+
+func (fv *Dot1qVlanId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qVlanId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Dot1qVlanId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *Dot1qVlanId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *Dot1qVlanId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qPriority is dot1qPriority (244), unsigned8 identifier.
+type Dot1qPriority struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *Dot1qPriority) ElementID() uint16 {
+	return IEID_DOT1Q_PRIORITY
+}
+
+func (fv *Dot1qPriority) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qPriority) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Dot1qPriority) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *Dot1qPriority) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *Dot1qPriority) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qCustomerVlanId is dot1qCustomerVlanId (245), unsigned16 identifier.
+type Dot1qCustomerVlanId struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *Dot1qCustomerVlanId) ElementID() uint16 {
+	return IEID_DOT1Q_CUSTOMER_VLAN_ID
+}
+
+func (fv *Dot1qCustomerVlanId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qCustomerVlanId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Dot1qCustomerVlanId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *Dot1qCustomerVlanId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *Dot1qCustomerVlanId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qCustomerPriority is dot1qCustomerPriority (246), unsigned8 identifier.
+type Dot1qCustomerPriority struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *Dot1qCustomerPriority) ElementID() uint16 {
+	return IEID_DOT1Q_CUSTOMER_PRIORITY
+}
+
+func (fv *Dot1qCustomerPriority) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qCustomerPriority) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Dot1qCustomerPriority) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *Dot1qCustomerPriority) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *Dot1qCustomerPriority) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MetroEvcId is metroEvcId (247), string.
+type MetroEvcId struct {
+	Val string
+}
+
+func (fv *MetroEvcId) ElementID() uint16 {
+	return IEID_METRO_EVC_ID
+}
+
+func (fv *MetroEvcId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MetroEvcId) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MetroEvcId) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *MetroEvcId) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MetroEvcId) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MetroEvcType is metroEvcType (248), unsigned8 identifier.
+type MetroEvcType struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *MetroEvcType) ElementID() uint16 {
+	return IEID_METRO_EVC_TYPE
+}
+
+func (fv *MetroEvcType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MetroEvcType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MetroEvcType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *MetroEvcType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *MetroEvcType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PseudoWireId is pseudoWireId (249), unsigned32 identifier.
+type PseudoWireId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *PseudoWireId) ElementID() uint16 {
+	return IEID_PSEUDO_WIRE_ID
+}
+
+func (fv *PseudoWireId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PseudoWireId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PseudoWireId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *PseudoWireId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *PseudoWireId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PseudoWireType is pseudoWireType (250), unsigned16 identifier.
+type PseudoWireType struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PseudoWireType) ElementID() uint16 {
+	return IEID_PSEUDO_WIRE_TYPE
+}
+
+func (fv *PseudoWireType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PseudoWireType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PseudoWireType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PseudoWireType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PseudoWireType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PseudoWireControlWord is pseudoWireControlWord (251), unsigned32 identifier.
+type PseudoWireControlWord struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *PseudoWireControlWord) ElementID() uint16 {
+	return IEID_PSEUDO_WIRE_CONTROL_WORD
+}
+
+func (fv *PseudoWireControlWord) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PseudoWireControlWord) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PseudoWireControlWord) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *PseudoWireControlWord) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *PseudoWireControlWord) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IngressPhysicalInterface is ingressPhysicalInterface (252), unsigned32 identifier.
+type IngressPhysicalInterface struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *IngressPhysicalInterface) ElementID() uint16 {
+	return IEID_INGRESS_PHYSICAL_INTERFACE
+}
+
+func (fv *IngressPhysicalInterface) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IngressPhysicalInterface) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IngressPhysicalInterface) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *IngressPhysicalInterface) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *IngressPhysicalInterface) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EgressPhysicalInterface is egressPhysicalInterface (253), unsigned32 identifier.
+type EgressPhysicalInterface struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *EgressPhysicalInterface) ElementID() uint16 {
+	return IEID_EGRESS_PHYSICAL_INTERFACE
+}
+
+func (fv *EgressPhysicalInterface) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EgressPhysicalInterface) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *EgressPhysicalInterface) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *EgressPhysicalInterface) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *EgressPhysicalInterface) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostDot1qVlanId is postDot1qVlanId (254), unsigned16 identifier.
+type PostDot1qVlanId struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PostDot1qVlanId) ElementID() uint16 {
+	return IEID_POST_DOT1Q_VLAN_ID
+}
+
+func (fv *PostDot1qVlanId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostDot1qVlanId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PostDot1qVlanId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PostDot1qVlanId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PostDot1qVlanId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostDot1qCustomerVlanId is postDot1qCustomerVlanId (255), unsigned16 identifier.
+type PostDot1qCustomerVlanId struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PostDot1qCustomerVlanId) ElementID() uint16 {
+	return IEID_POST_DOT1Q_CUSTOMER_VLAN_ID
+}
+
+func (fv *PostDot1qCustomerVlanId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostDot1qCustomerVlanId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PostDot1qCustomerVlanId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PostDot1qCustomerVlanId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PostDot1qCustomerVlanId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EthernetType is ethernetType (256), unsigned16 identifier.
+type EthernetType struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *EthernetType) ElementID() uint16 {
+	return IEID_ETHERNET_TYPE
+}
+
+func (fv *EthernetType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EthernetType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *EthernetType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *EthernetType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *EthernetType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostIpPrecedence is postIpPrecedence (257), unsigned8 identifier.
+type PostIpPrecedence struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *PostIpPrecedence) ElementID() uint16 {
+	return IEID_POST_IP_PRECEDENCE
+}
+
+func (fv *PostIpPrecedence) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostIpPrecedence) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PostIpPrecedence) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *PostIpPrecedence) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *PostIpPrecedence) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// CollectionTimeMilliseconds is collectionTimeMilliseconds (258), dateTimeMilliseconds in milliseconds.
+type CollectionTimeMilliseconds struct {
+	Val time.Time
+}
+
+func (fv *CollectionTimeMilliseconds) ElementID() uint16 {
+	return IEID_COLLECTION_TIME_MILLISECONDS
+}
+
+func (fv *CollectionTimeMilliseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *CollectionTimeMilliseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMilliseconds(dst, fv.Val)
+}
+
+func (fv *CollectionTimeMilliseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMilliseconds(data)
+	return nil
+}
+
+func (fv *CollectionTimeMilliseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *CollectionTimeMilliseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ExportSctpStreamId is exportSctpStreamId (259), unsigned16 identifier.
+type ExportSctpStreamId struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *ExportSctpStreamId) ElementID() uint16 {
+	return IEID_EXPORT_SCTP_STREAM_ID
+}
+
+func (fv *ExportSctpStreamId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExportSctpStreamId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ExportSctpStreamId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *ExportSctpStreamId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *ExportSctpStreamId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaxExportSeconds is maxExportSeconds (260), dateTimeSeconds in seconds.
+type MaxExportSeconds struct {
+	Val time.Time
+}
+
+func (fv *MaxExportSeconds) ElementID() uint16 {
+	return IEID_MAX_EXPORT_SECONDS
+}
+
+func (fv *MaxExportSeconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaxExportSeconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeSeconds(dst, fv.Val)
+}
+
+func (fv *MaxExportSeconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeSeconds(data)
+	return nil
+}
+
+func (fv *MaxExportSeconds) Len() uint16 {
+	return 4
+}
+
+func (fv *MaxExportSeconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaxFlowEndSeconds is maxFlowEndSeconds (261), dateTimeSeconds in seconds.
+type MaxFlowEndSeconds struct {
+	Val time.Time
+}
+
+func (fv *MaxFlowEndSeconds) ElementID() uint16 {
+	return IEID_MAX_FLOW_END_SECONDS
+}
+
+func (fv *MaxFlowEndSeconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaxFlowEndSeconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeSeconds(dst, fv.Val)
+}
+
+func (fv *MaxFlowEndSeconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeSeconds(data)
+	return nil
+}
+
+func (fv *MaxFlowEndSeconds) Len() uint16 {
+	return 4
+}
+
+func (fv *MaxFlowEndSeconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MessageMD5Checksum is messageMD5Checksum (262), octetArray.
+type MessageMD5Checksum struct {
+	Val []uint8
+}
+
+func (fv *MessageMD5Checksum) ElementID() uint16 {
+	return IEID_MESSAGE_MD5_CHECKSUM
+}
+
+func (fv *MessageMD5Checksum) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MessageMD5Checksum) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MessageMD5Checksum) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MessageMD5Checksum) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MessageMD5Checksum) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MessageScope is messageScope (263), unsigned8.
+type MessageScope struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *MessageScope) ElementID() uint16 {
+	return IEID_MESSAGE_SCOPE
+}
+
+func (fv *MessageScope) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MessageScope) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MessageScope) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *MessageScope) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *MessageScope) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MinExportSeconds is minExportSeconds (264), dateTimeSeconds in seconds.
+type MinExportSeconds struct {
+	Val time.Time
+}
+
+func (fv *MinExportSeconds) ElementID() uint16 {
+	return IEID_MIN_EXPORT_SECONDS
+}
+
+func (fv *MinExportSeconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MinExportSeconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeSeconds(dst, fv.Val)
+}
+
+func (fv *MinExportSeconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeSeconds(data)
+	return nil
+}
+
+func (fv *MinExportSeconds) Len() uint16 {
+	return 4
+}
+
+func (fv *MinExportSeconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MinFlowStartSeconds is minFlowStartSeconds (265), dateTimeSeconds in seconds.
+type MinFlowStartSeconds struct {
+	Val time.Time
+}
+
+func (fv *MinFlowStartSeconds) ElementID() uint16 {
+	return IEID_MIN_FLOW_START_SECONDS
+}
+
+func (fv *MinFlowStartSeconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MinFlowStartSeconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeSeconds(dst, fv.Val)
+}
+
+func (fv *MinFlowStartSeconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeSeconds(data)
+	return nil
+}
+
+func (fv *MinFlowStartSeconds) Len() uint16 {
+	return 4
+}
+
+func (fv *MinFlowStartSeconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// OpaqueOctets is opaqueOctets (266), octetArray.
+type OpaqueOctets struct {
+	Val []uint8
+}
+
+func (fv *OpaqueOctets) ElementID() uint16 {
+	return IEID_OPAQUE_OCTETS
+}
+
+func (fv *OpaqueOctets) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OpaqueOctets) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *OpaqueOctets) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *OpaqueOctets) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *OpaqueOctets) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SessionScope is sessionScope (267), unsigned8.
+type SessionScope struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *SessionScope) ElementID() uint16 {
+	return IEID_SESSION_SCOPE
+}
+
+func (fv *SessionScope) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SessionScope) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SessionScope) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *SessionScope) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *SessionScope) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaxFlowEndMicroseconds is maxFlowEndMicroseconds (268), dateTimeMicroseconds in microseconds.
+type MaxFlowEndMicroseconds struct {
+	Val time.Time
+}
+
+func (fv *MaxFlowEndMicroseconds) ElementID() uint16 {
+	return IEID_MAX_FLOW_END_MICROSECONDS
+}
+
+func (fv *MaxFlowEndMicroseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaxFlowEndMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMicroseconds(dst, fv.Val)
+}
+
+func (fv *MaxFlowEndMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMicroseconds(data)
+	return nil
+}
+
+func (fv *MaxFlowEndMicroseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *MaxFlowEndMicroseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaxFlowEndMilliseconds is maxFlowEndMilliseconds (269), dateTimeMilliseconds in milliseconds.
+type MaxFlowEndMilliseconds struct {
+	Val time.Time
+}
+
+func (fv *MaxFlowEndMilliseconds) ElementID() uint16 {
+	return IEID_MAX_FLOW_END_MILLISECONDS
+}
+
+func (fv *MaxFlowEndMilliseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaxFlowEndMilliseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMilliseconds(dst, fv.Val)
+}
+
+func (fv *MaxFlowEndMilliseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMilliseconds(data)
+	return nil
+}
+
+func (fv *MaxFlowEndMilliseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *MaxFlowEndMilliseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaxFlowEndNanoseconds is maxFlowEndNanoseconds (270), dateTimeNanoseconds in nanoseconds.
+type MaxFlowEndNanoseconds struct {
+	Val time.Time
+}
+
+func (fv *MaxFlowEndNanoseconds) ElementID() uint16 {
+	return IEID_MAX_FLOW_END_NANOSECONDS
+}
+
+func (fv *MaxFlowEndNanoseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaxFlowEndNanoseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeNanoseconds(dst, fv.Val)
+}
+
+func (fv *MaxFlowEndNanoseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeNanoseconds(data)
+	return nil
+}
+
+func (fv *MaxFlowEndNanoseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *MaxFlowEndNanoseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MinFlowStartMicroseconds is minFlowStartMicroseconds (271), dateTimeMicroseconds in microseconds.
+type MinFlowStartMicroseconds struct {
+	Val time.Time
+}
+
+func (fv *MinFlowStartMicroseconds) ElementID() uint16 {
+	return IEID_MIN_FLOW_START_MICROSECONDS
+}
+
+func (fv *MinFlowStartMicroseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MinFlowStartMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMicroseconds(dst, fv.Val)
+}
+
+func (fv *MinFlowStartMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMicroseconds(data)
+	return nil
+}
+
+func (fv *MinFlowStartMicroseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *MinFlowStartMicroseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MinFlowStartMilliseconds is minFlowStartMilliseconds (272), dateTimeMilliseconds in milliseconds.
+type MinFlowStartMilliseconds struct {
+	Val time.Time
+}
+
+func (fv *MinFlowStartMilliseconds) ElementID() uint16 {
+	return IEID_MIN_FLOW_START_MILLISECONDS
+}
+
+func (fv *MinFlowStartMilliseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MinFlowStartMilliseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMilliseconds(dst, fv.Val)
+}
+
+func (fv *MinFlowStartMilliseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMilliseconds(data)
+	return nil
+}
+
+func (fv *MinFlowStartMilliseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *MinFlowStartMilliseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MinFlowStartNanoseconds is minFlowStartNanoseconds (273), dateTimeNanoseconds in nanoseconds.
+type MinFlowStartNanoseconds struct {
+	Val time.Time
+}
+
+func (fv *MinFlowStartNanoseconds) ElementID() uint16 {
+	return IEID_MIN_FLOW_START_NANOSECONDS
+}
+
+func (fv *MinFlowStartNanoseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MinFlowStartNanoseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeNanoseconds(dst, fv.Val)
+}
+
+func (fv *MinFlowStartNanoseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeNanoseconds(data)
+	return nil
+}
+
+func (fv *MinFlowStartNanoseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *MinFlowStartNanoseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// CollectorCertificate is collectorCertificate (274), octetArray.
+type CollectorCertificate struct {
+	Val []uint8
+}
+
+func (fv *CollectorCertificate) ElementID() uint16 {
+	return IEID_COLLECTOR_CERTIFICATE
+}
+
+func (fv *CollectorCertificate) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *CollectorCertificate) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *CollectorCertificate) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *CollectorCertificate) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *CollectorCertificate) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ExporterCertificate is exporterCertificate (275), octetArray.
+type ExporterCertificate struct {
+	Val []uint8
+}
+
+func (fv *ExporterCertificate) ElementID() uint16 {
+	return IEID_EXPORTER_CERTIFICATE
+}
+
+func (fv *ExporterCertificate) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExporterCertificate) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *ExporterCertificate) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *ExporterCertificate) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *ExporterCertificate) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DataRecordsReliability is dataRecordsReliability (276), boolean.
+type DataRecordsReliability struct {
+	Val bool
+}
+
+func (fv *DataRecordsReliability) ElementID() uint16 {
+	return IEID_DATA_RECORDS_RELIABILITY
+}
+
+func (fv *DataRecordsReliability) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DataRecordsReliability) AppendTo(dst []uint8) []uint8 {
+	return appendBoolean(dst, fv.Val)
+}
+
+func (fv *DataRecordsReliability) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 1); err != nil {
+		return err
+	}
+	fv.Val = decodeBoolean(data)
+	return nil
+}
+
+func (fv *DataRecordsReliability) Len() uint16 {
+	return 1
+}
+
+func (fv *DataRecordsReliability) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ObservationPointType is observationPointType (277), unsigned8 identifier.
+type ObservationPointType struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *ObservationPointType) ElementID() uint16 {
+	return IEID_OBSERVATION_POINT_TYPE
+}
+
+func (fv *ObservationPointType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ObservationPointType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ObservationPointType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *ObservationPointType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *ObservationPointType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NewConnectionDeltaCount is newConnectionDeltaCount (278), unsigned32 deltaCounter.
+type NewConnectionDeltaCount struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *NewConnectionDeltaCount) ElementID() uint16 {
+	return IEID_NEW_CONNECTION_DELTA_COUNT
+}
+
+func (fv *NewConnectionDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NewConnectionDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *NewConnectionDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *NewConnectionDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *NewConnectionDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ConnectionSumDurationSeconds is connectionSumDurationSeconds (279), unsigned64 in seconds.
+type ConnectionSumDurationSeconds struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *ConnectionSumDurationSeconds) ElementID() uint16 {
+	return IEID_CONNECTION_SUM_DURATION_SECONDS
+}
+
+func (fv *ConnectionSumDurationSeconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ConnectionSumDurationSeconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *ConnectionSumDurationSeconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *ConnectionSumDurationSeconds) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *ConnectionSumDurationSeconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ConnectionTransactionId is connectionTransactionId (280), unsigned64 identifier.
+type ConnectionTransactionId struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *ConnectionTransactionId) ElementID() uint16 {
+	return IEID_CONNECTION_TRANSACTION_ID
+}
+
+func (fv *ConnectionTransactionId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ConnectionTransactionId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *ConnectionTransactionId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *ConnectionTransactionId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *ConnectionTransactionId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostNATSourceIPv6Address is postNATSourceIPv6Address (281), ipv6Address.
+type PostNATSourceIPv6Address struct {
+	Val netip.Addr
+}
+
+func (fv *PostNATSourceIPv6Address) ElementID() uint16 {
+	return IEID_POST_NAT_SOURCE_IPV6_ADDRESS
+}
+
+func (fv *PostNATSourceIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostNATSourceIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *PostNATSourceIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *PostNATSourceIPv6Address) Len() uint16 {
+	return 16
+}
+
+func (fv *PostNATSourceIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostNATDestinationIPv6Address is postNATDestinationIPv6Address (282), ipv6Address.
+type PostNATDestinationIPv6Address struct {
+	Val netip.Addr
+}
+
+func (fv *PostNATDestinationIPv6Address) ElementID() uint16 {
+	return IEID_POST_NAT_DESTINATION_IPV6_ADDRESS
+}
+
+func (fv *PostNATDestinationIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostNATDestinationIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *PostNATDestinationIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *PostNATDestinationIPv6Address) Len() uint16 {
+	return 16
+}
+
+func (fv *PostNATDestinationIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NatPoolId is natPoolId (283), unsigned32 identifier.
+type NatPoolId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *NatPoolId) ElementID() uint16 {
+	return IEID_NAT_POOL_ID
+}
+
+func (fv *NatPoolId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NatPoolId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *NatPoolId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *NatPoolId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *NatPoolId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NatPoolName is natPoolName (284), string.
+type NatPoolName struct {
+	Val string
+}
+
+func (fv *NatPoolName) ElementID() uint16 {
+	return IEID_NAT_POOL_NAME
+}
+
+func (fv *NatPoolName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NatPoolName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *NatPoolName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *NatPoolName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *NatPoolName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// AnonymizationFlags is anonymizationFlags (285), unsigned16 flags.
+type AnonymizationFlags struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *AnonymizationFlags) ElementID() uint16 {
+	return IEID_ANONYMIZATION_FLAGS
+}
+
+func (fv *AnonymizationFlags) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *AnonymizationFlags) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *AnonymizationFlags) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *AnonymizationFlags) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *AnonymizationFlags) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// AnonymizationTechnique is anonymizationTechnique (286), unsigned16 identifier.
+type AnonymizationTechnique struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *AnonymizationTechnique) ElementID() uint16 {
+	return IEID_ANONYMIZATION_TECHNIQUE
+}
+
+func (fv *AnonymizationTechnique) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *AnonymizationTechnique) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *AnonymizationTechnique) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *AnonymizationTechnique) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *AnonymizationTechnique) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InformationElementIndex is informationElementIndex (287), unsigned16 identifier.
+type InformationElementIndex struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *InformationElementIndex) ElementID() uint16 {
+	return IEID_INFORMATION_ELEMENT_INDEX
+}
+
+func (fv *InformationElementIndex) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InformationElementIndex) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *InformationElementIndex) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *InformationElementIndex) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *InformationElementIndex) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// P2pTechnology is p2pTechnology (288), string.
+type P2pTechnology struct {
+	Val string
+}
+
+func (fv *P2pTechnology) ElementID() uint16 {
+	return IEID_P2P_TECHNOLOGY
+}
+
+func (fv *P2pTechnology) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *P2pTechnology) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *P2pTechnology) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *P2pTechnology) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *P2pTechnology) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TunnelTechnology is tunnelTechnology (289), string.
+type TunnelTechnology struct {
+	Val string
+}
+
+func (fv *TunnelTechnology) ElementID() uint16 {
+	return IEID_TUNNEL_TECHNOLOGY
+}
+
+func (fv *TunnelTechnology) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TunnelTechnology) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *TunnelTechnology) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *TunnelTechnology) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *TunnelTechnology) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EncryptedTechnology is encryptedTechnology (290), string.
+type EncryptedTechnology struct {
+	Val string
+}
+
+func (fv *EncryptedTechnology) ElementID() uint16 {
+	return IEID_ENCRYPTED_TECHNOLOGY
+}
+
+func (fv *EncryptedTechnology) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EncryptedTechnology) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *EncryptedTechnology) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *EncryptedTechnology) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *EncryptedTechnology) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpValidityState is bgpValidityState (294), unsigned8 identifier.
+type BgpValidityState struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *BgpValidityState) ElementID() uint16 {
+	return IEID_BGP_VALIDITY_STATE
+}
+
+func (fv *BgpValidityState) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpValidityState) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *BgpValidityState) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *BgpValidityState) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *BgpValidityState) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IPSecSPI is IPSecSPI (295), unsigned32 identifier.
+type IPSecSPI struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *IPSecSPI) ElementID() uint16 {
+	return IEID_IPSEC_SPI
+}
+
+func (fv *IPSecSPI) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IPSecSPI) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IPSecSPI) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *IPSecSPI) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *IPSecSPI) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// GreKey is greKey (296), unsigned32 identifier.
+type GreKey struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *GreKey) ElementID() uint16 {
+	return IEID_GRE_KEY
+}
+
+func (fv *GreKey) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *GreKey) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *GreKey) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *GreKey) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *GreKey) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NatType is natType (297), unsigned8 identifier.
+type NatType struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *NatType) ElementID() uint16 {
+	return IEID_NAT_TYPE
+}
+
+func (fv *NatType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NatType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *NatType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *NatType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *NatType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InitiatorPackets is initiatorPackets (298), unsigned64 deltaCounter in packets.
+type InitiatorPackets struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *InitiatorPackets) ElementID() uint16 {
+	return IEID_INITIATOR_PACKETS
+}
+
+func (fv *InitiatorPackets) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InitiatorPackets) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *InitiatorPackets) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *InitiatorPackets) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *InitiatorPackets) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ResponderPackets is responderPackets (299), unsigned64 deltaCounter in packets.
+type ResponderPackets struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *ResponderPackets) ElementID() uint16 {
+	return IEID_RESPONDER_PACKETS
+}
+
+func (fv *ResponderPackets) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ResponderPackets) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *ResponderPackets) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *ResponderPackets) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *ResponderPackets) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ObservationDomainName is observationDomainName (300), string.
+type ObservationDomainName struct {
+	Val string
+}
+
+func (fv *ObservationDomainName) ElementID() uint16 {
+	return IEID_OBSERVATION_DOMAIN_NAME
+}
+
+func (fv *ObservationDomainName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ObservationDomainName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *ObservationDomainName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *ObservationDomainName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *ObservationDomainName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SelectionSequenceId is selectionSequenceId (301), unsigned64 identifier.
+type SelectionSequenceId struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *SelectionSequenceId) ElementID() uint16 {
+	return IEID_SELECTION_SEQUENCE_ID
+}
+
+func (fv *SelectionSequenceId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SelectionSequenceId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *SelectionSequenceId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *SelectionSequenceId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *SelectionSequenceId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SelectorId is selectorId (302), unsigned64 identifier.
+type SelectorId struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *SelectorId) ElementID() uint16 {
+	return IEID_SELECTOR_ID
+}
+
+func (fv *SelectorId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SelectorId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *SelectorId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *SelectorId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *SelectorId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InformationElementId is informationElementId (303), unsigned16 identifier.
+type InformationElementId struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *InformationElementId) ElementID() uint16 {
+	return IEID_INFORMATION_ELEMENT_ID
+}
+
+func (fv *InformationElementId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InformationElementId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *InformationElementId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *InformationElementId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *InformationElementId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SelectorAlgorithm is selectorAlgorithm (304), unsigned16 identifier.
+type SelectorAlgorithm struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *SelectorAlgorithm) ElementID() uint16 {
+	return IEID_SELECTOR_ALGORITHM
+}
+
+func (fv *SelectorAlgorithm) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SelectorAlgorithm) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SelectorAlgorithm) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *SelectorAlgorithm) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *SelectorAlgorithm) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingPacketInterval is samplingPacketInterval (305), unsigned32 quantity in packets.
+type SamplingPacketInterval struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingPacketInterval) ElementID() uint16 {
+	return IEID_SAMPLING_PACKET_INTERVAL
+}
+
+func (fv *SamplingPacketInterval) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingPacketInterval) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplingPacketInterval) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *SamplingPacketInterval) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *SamplingPacketInterval) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingPacketSpace is samplingPacketSpace (306), unsigned32 quantity in packets.
+type SamplingPacketSpace struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingPacketSpace) ElementID() uint16 {
+	return IEID_SAMPLING_PACKET_SPACE
+}
+
+func (fv *SamplingPacketSpace) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingPacketSpace) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplingPacketSpace) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *SamplingPacketSpace) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *SamplingPacketSpace) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingTimeInterval is samplingTimeInterval (307), unsigned32 quantity in microseconds.
+type SamplingTimeInterval struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingTimeInterval) ElementID() uint16 {
+	return IEID_SAMPLING_TIME_INTERVAL
+}
+
+func (fv *SamplingTimeInterval) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingTimeInterval) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplingTimeInterval) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *SamplingTimeInterval) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *SamplingTimeInterval) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingTimeSpace is samplingTimeSpace (308), unsigned32 quantity in microseconds.
+type SamplingTimeSpace struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingTimeSpace) ElementID() uint16 {
+	return IEID_SAMPLING_TIME_SPACE
+}
+
+func (fv *SamplingTimeSpace) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingTimeSpace) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplingTimeSpace) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *SamplingTimeSpace) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *SamplingTimeSpace) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingSize is samplingSize (309), unsigned32 quantity in packets.
+type SamplingSize struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingSize) ElementID() uint16 {
+	return IEID_SAMPLING_SIZE
+}
+
+func (fv *SamplingSize) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingSize) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplingSize) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *SamplingSize) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *SamplingSize) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingPopulation is samplingPopulation (310), unsigned32 quantity in packets.
+type SamplingPopulation struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingPopulation) ElementID() uint16 {
+	return IEID_SAMPLING_POPULATION
+}
+
+func (fv *SamplingPopulation) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingPopulation) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SamplingPopulation) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *SamplingPopulation) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *SamplingPopulation) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingProbability is samplingProbability (311), float64 quantity.
+type SamplingProbability struct {
+	Val float64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingProbability) ElementID() uint16 {
+	return IEID_SAMPLING_PROBABILITY
+}
+
+func (fv *SamplingProbability) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingProbability) AppendTo(dst []uint8) []uint8 {
+	return appendFloat64(dst, fv.Val, fv.Len())
+}
+
+func (fv *SamplingProbability) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_FLOAT64); err != nil {
+		return err
+	}
+	fv.Val = decodeFloat64(data)
+	fv.Length = reducedSize(data, DATA_TYPE_FLOAT64)
+	return nil
+}
+
+func (fv *SamplingProbability) Len() uint16 {
+	return DATA_TYPE_FLOAT64.reducedLength(fv.Length)
+}
+
+func (fv *SamplingProbability) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DataLinkFrameSize is dataLinkFrameSize (312), unsigned16 quantity.
+type DataLinkFrameSize struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *DataLinkFrameSize) ElementID() uint16 {
+	return IEID_DATA_LINK_FRAME_SIZE
+}
+
+func (fv *DataLinkFrameSize) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DataLinkFrameSize) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *DataLinkFrameSize) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *DataLinkFrameSize) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *DataLinkFrameSize) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpHeaderPacketSection is ipHeaderPacketSection (313), octetArray.
+type IpHeaderPacketSection struct {
+	Val []uint8
+}
+
+func (fv *IpHeaderPacketSection) ElementID() uint16 {
+	return IEID_IP_HEADER_PACKET_SECTION
+}
+
+func (fv *IpHeaderPacketSection) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpHeaderPacketSection) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *IpHeaderPacketSection) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *IpHeaderPacketSection) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *IpHeaderPacketSection) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IpPayloadPacketSection is ipPayloadPacketSection (314), octetArray.
+type IpPayloadPacketSection struct {
+	Val []uint8
+}
+
+func (fv *IpPayloadPacketSection) ElementID() uint16 {
+	return IEID_IP_PAYLOAD_PACKET_SECTION
+}
+
+func (fv *IpPayloadPacketSection) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IpPayloadPacketSection) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *IpPayloadPacketSection) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *IpPayloadPacketSection) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *IpPayloadPacketSection) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DataLinkFrameSection is dataLinkFrameSection (315), octetArray.
+type DataLinkFrameSection struct {
+	Val []uint8
+}
+
+func (fv *DataLinkFrameSection) ElementID() uint16 {
+	return IEID_DATA_LINK_FRAME_SECTION
+}
+
+func (fv *DataLinkFrameSection) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DataLinkFrameSection) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *DataLinkFrameSection) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *DataLinkFrameSection) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *DataLinkFrameSection) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsLabelStackSection is mplsLabelStackSection (316), octetArray.
+type MplsLabelStackSection struct {
+	Val []uint8
+}
+
+func (fv *MplsLabelStackSection) ElementID() uint16 {
+	return IEID_MPLS_LABEL_STACK_SECTION
+}
+
+func (fv *MplsLabelStackSection) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsLabelStackSection) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsLabelStackSection) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsLabelStackSection) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsLabelStackSection) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MplsPayloadPacketSection is mplsPayloadPacketSection (317), octetArray.
+type MplsPayloadPacketSection struct {
+	Val []uint8
+}
+
+func (fv *MplsPayloadPacketSection) ElementID() uint16 {
+	return IEID_MPLS_PAYLOAD_PACKET_SECTION
+}
+
+func (fv *MplsPayloadPacketSection) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MplsPayloadPacketSection) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MplsPayloadPacketSection) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MplsPayloadPacketSection) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MplsPayloadPacketSection) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SelectorIdTotalPktsObserved is selectorIdTotalPktsObserved (318), unsigned64 totalCounter in packets.
+type SelectorIdTotalPktsObserved struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *SelectorIdTotalPktsObserved) ElementID() uint16 {
+	return IEID_SELECTOR_ID_TOTAL_PKTS_OBSERVED
+}
+
+func (fv *SelectorIdTotalPktsObserved) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SelectorIdTotalPktsObserved) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *SelectorIdTotalPktsObserved) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *SelectorIdTotalPktsObserved) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *SelectorIdTotalPktsObserved) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SelectorIdTotalPktsSelected is selectorIdTotalPktsSelected (319), unsigned64 totalCounter in packets.
+type SelectorIdTotalPktsSelected struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *SelectorIdTotalPktsSelected) ElementID() uint16 {
+	return IEID_SELECTOR_ID_TOTAL_PKTS_SELECTED
+}
+
+func (fv *SelectorIdTotalPktsSelected) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SelectorIdTotalPktsSelected) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *SelectorIdTotalPktsSelected) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *SelectorIdTotalPktsSelected) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *SelectorIdTotalPktsSelected) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// AbsoluteError is absoluteError (320), float64 quantity in inferred.
+type AbsoluteError struct {
+	Val float64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *AbsoluteError) ElementID() uint16 {
+	return IEID_ABSOLUTE_ERROR
+}
+
+func (fv *AbsoluteError) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *AbsoluteError) AppendTo(dst []uint8) []uint8 {
+	return appendFloat64(dst, fv.Val, fv.Len())
+}
+
+func (fv *AbsoluteError) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_FLOAT64); err != nil {
+		return err
+	}
+	fv.Val = decodeFloat64(data)
+	fv.Length = reducedSize(data, DATA_TYPE_FLOAT64)
+	return nil
+}
+
+func (fv *AbsoluteError) Len() uint16 {
+	return DATA_TYPE_FLOAT64.reducedLength(fv.Length)
+}
+
+func (fv *AbsoluteError) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// RelativeError is relativeError (321), float64 quantity.
+type RelativeError struct {
+	Val float64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *RelativeError) ElementID() uint16 {
+	return IEID_RELATIVE_ERROR
+}
+
+func (fv *RelativeError) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *RelativeError) AppendTo(dst []uint8) []uint8 {
+	return appendFloat64(dst, fv.Val, fv.Len())
+}
+
+func (fv *RelativeError) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_FLOAT64); err != nil {
+		return err
+	}
+	fv.Val = decodeFloat64(data)
+	fv.Length = reducedSize(data, DATA_TYPE_FLOAT64)
+	return nil
+}
+
+func (fv *RelativeError) Len() uint16 {
+	return DATA_TYPE_FLOAT64.reducedLength(fv.Length)
+}
+
+func (fv *RelativeError) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ObservationTimeSeconds is observationTimeSeconds (322), dateTimeSeconds in seconds.
+type ObservationTimeSeconds struct {
+	Val time.Time
+}
+
+func (fv *ObservationTimeSeconds) ElementID() uint16 {
+	return IEID_OBSERVATION_TIME_SECONDS
+}
+
+func (fv *ObservationTimeSeconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ObservationTimeSeconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeSeconds(dst, fv.Val)
+}
+
+func (fv *ObservationTimeSeconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeSeconds(data)
+	return nil
+}
+
+func (fv *ObservationTimeSeconds) Len() uint16 {
+	return 4
+}
+
+func (fv *ObservationTimeSeconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ObservationTimeMilliseconds is observationTimeMilliseconds (323), dateTimeMilliseconds in milliseconds.
+type ObservationTimeMilliseconds struct {
+	Val time.Time
+}
+
+func (fv *ObservationTimeMilliseconds) ElementID() uint16 {
+	return IEID_OBSERVATION_TIME_MILLI_SECONDS
+}
+
+func (fv *ObservationTimeMilliseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ObservationTimeMilliseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMilliseconds(dst, fv.Val)
+}
+
+func (fv *ObservationTimeMilliseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMilliseconds(data)
+	return nil
+}
+
+func (fv *ObservationTimeMilliseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *ObservationTimeMilliseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ObservationTimeMicroseconds is observationTimeMicroseconds (324), dateTimeMicroseconds in microseconds.
+type ObservationTimeMicroseconds struct {
+	Val time.Time
+}
+
+func (fv *ObservationTimeMicroseconds) ElementID() uint16 {
+	return IEID_OBSERVATION_TIME_MICRO_SECONDS
+}
+
+func (fv *ObservationTimeMicroseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ObservationTimeMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMicroseconds(dst, fv.Val)
+}
+
+func (fv *ObservationTimeMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMicroseconds(data)
+	return nil
+}
+
+func (fv *ObservationTimeMicroseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *ObservationTimeMicroseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ObservationTimeNanoseconds is observationTimeNanoseconds (325), dateTimeNanoseconds in nanoseconds.
+type ObservationTimeNanoseconds struct {
+	Val time.Time
+}
+
+func (fv *ObservationTimeNanoseconds) ElementID() uint16 {
+	return IEID_OBSERVATION_TIME_NANO_SECONDS
+}
+
+func (fv *ObservationTimeNanoseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ObservationTimeNanoseconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeNanoseconds(dst, fv.Val)
+}
+
+func (fv *ObservationTimeNanoseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeNanoseconds(data)
+	return nil
+}
+
+func (fv *ObservationTimeNanoseconds) Len() uint16 {
+	return 8
+}
+
+func (fv *ObservationTimeNanoseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DigestHashValue is digestHashValue (326), unsigned64 quantity.
+type DigestHashValue struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DigestHashValue) ElementID() uint16 {
+	return IEID_DIGEST_HASH_VALUE
+}
+
+func (fv *DigestHashValue) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DigestHashValue) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DigestHashValue) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DigestHashValue) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DigestHashValue) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HashIPPayloadOffset is hashIPPayloadOffset (327), unsigned64 quantity.
+type HashIPPayloadOffset struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *HashIPPayloadOffset) ElementID() uint16 {
+	return IEID_HASH_IP_PAYLOAD_OFFSET
+}
+
+func (fv *HashIPPayloadOffset) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HashIPPayloadOffset) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *HashIPPayloadOffset) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *HashIPPayloadOffset) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *HashIPPayloadOffset) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HashIPPayloadSize is hashIPPayloadSize (328), unsigned64 quantity.
+type HashIPPayloadSize struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *HashIPPayloadSize) ElementID() uint16 {
+	return IEID_HASH_IP_PAYLOAD_SIZE
+}
+
+func (fv *HashIPPayloadSize) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HashIPPayloadSize) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *HashIPPayloadSize) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *HashIPPayloadSize) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *HashIPPayloadSize) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HashOutputRangeMin is hashOutputRangeMin (329), unsigned64 quantity.
+type HashOutputRangeMin struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *HashOutputRangeMin) ElementID() uint16 {
+	return IEID_HASH_OUTPUT_RANGE_MIN
+}
+
+func (fv *HashOutputRangeMin) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HashOutputRangeMin) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *HashOutputRangeMin) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *HashOutputRangeMin) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *HashOutputRangeMin) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HashOutputRangeMax is hashOutputRangeMax (330), unsigned64 quantity.
+type HashOutputRangeMax struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *HashOutputRangeMax) ElementID() uint16 {
+	return IEID_HASH_OUTPUT_RANGE_MAX
+}
+
+func (fv *HashOutputRangeMax) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HashOutputRangeMax) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *HashOutputRangeMax) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *HashOutputRangeMax) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *HashOutputRangeMax) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HashSelectedRangeMin is hashSelectedRangeMin (331), unsigned64 quantity.
+type HashSelectedRangeMin struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *HashSelectedRangeMin) ElementID() uint16 {
+	return IEID_HASH_SELECTED_RANGE_MIN
+}
+
+func (fv *HashSelectedRangeMin) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HashSelectedRangeMin) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *HashSelectedRangeMin) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *HashSelectedRangeMin) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *HashSelectedRangeMin) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HashSelectedRangeMax is hashSelectedRangeMax (332), unsigned64 quantity.
+type HashSelectedRangeMax struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *HashSelectedRangeMax) ElementID() uint16 {
+	return IEID_HASH_SELECTED_RANGE_MAX
+}
+
+func (fv *HashSelectedRangeMax) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HashSelectedRangeMax) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *HashSelectedRangeMax) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *HashSelectedRangeMax) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *HashSelectedRangeMax) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HashDigestOutput is hashDigestOutput (333), boolean.
+type HashDigestOutput struct {
+	Val bool
+}
+
+func (fv *HashDigestOutput) ElementID() uint16 {
+	return IEID_HASH_DIGEST_OUTPUT
+}
+
+func (fv *HashDigestOutput) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HashDigestOutput) AppendTo(dst []uint8) []uint8 {
+	return appendBoolean(dst, fv.Val)
+}
+
+func (fv *HashDigestOutput) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 1); err != nil {
+		return err
+	}
+	fv.Val = decodeBoolean(data)
+	return nil
+}
+
+func (fv *HashDigestOutput) Len() uint16 {
+	return 1
+}
+
+func (fv *HashDigestOutput) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HashInitialiserValue is hashInitialiserValue (334), unsigned64 quantity.
+type HashInitialiserValue struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *HashInitialiserValue) ElementID() uint16 {
+	return IEID_HASH_INITIALISATION_VALUE
+}
+
+func (fv *HashInitialiserValue) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HashInitialiserValue) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *HashInitialiserValue) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *HashInitialiserValue) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *HashInitialiserValue) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SelectorName is selectorName (335), string.
+type SelectorName struct {
+	Val string
+}
+
+func (fv *SelectorName) ElementID() uint16 {
+	return IEID_SELECTOR_NAME
+}
+
+func (fv *SelectorName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SelectorName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *SelectorName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *SelectorName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *SelectorName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// UpperCILimit is upperCILimit (336), float64 quantity.
+type UpperCILimit struct {
+	Val float64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *UpperCILimit) ElementID() uint16 {
+	return IEID_UPPER_CI_LIMIT
+}
+
+func (fv *UpperCILimit) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *UpperCILimit) AppendTo(dst []uint8) []uint8 {
+	return appendFloat64(dst, fv.Val, fv.Len())
+}
+
+func (fv *UpperCILimit) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_FLOAT64); err != nil {
+		return err
+	}
+	fv.Val = decodeFloat64(data)
+	fv.Length = reducedSize(data, DATA_TYPE_FLOAT64)
+	return nil
+}
+
+func (fv *UpperCILimit) Len() uint16 {
+	return DATA_TYPE_FLOAT64.reducedLength(fv.Length)
+}
+
+func (fv *UpperCILimit) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// LowerCILimit is lowerCILimit (337), float64 quantity.
+type LowerCILimit struct {
+	Val float64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *LowerCILimit) ElementID() uint16 {
+	return IEID_LOWER_CI_LIMIT
+}
+
+func (fv *LowerCILimit) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *LowerCILimit) AppendTo(dst []uint8) []uint8 {
+	return appendFloat64(dst, fv.Val, fv.Len())
+}
+
+func (fv *LowerCILimit) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_FLOAT64); err != nil {
+		return err
+	}
+	fv.Val = decodeFloat64(data)
+	fv.Length = reducedSize(data, DATA_TYPE_FLOAT64)
+	return nil
+}
+
+func (fv *LowerCILimit) Len() uint16 {
+	return DATA_TYPE_FLOAT64.reducedLength(fv.Length)
+}
+
+func (fv *LowerCILimit) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ConfidenceLevel is confidenceLevel (338), float64 quantity.
+type ConfidenceLevel struct {
+	Val float64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *ConfidenceLevel) ElementID() uint16 {
+	return IEID_CONFIDENCE_LEVEL
+}
+
+func (fv *ConfidenceLevel) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ConfidenceLevel) AppendTo(dst []uint8) []uint8 {
+	return appendFloat64(dst, fv.Val, fv.Len())
+}
+
+func (fv *ConfidenceLevel) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_FLOAT64); err != nil {
+		return err
+	}
+	fv.Val = decodeFloat64(data)
+	fv.Length = reducedSize(data, DATA_TYPE_FLOAT64)
+	return nil
+}
+
+func (fv *ConfidenceLevel) Len() uint16 {
+	return DATA_TYPE_FLOAT64.reducedLength(fv.Length)
+}
+
+func (fv *ConfidenceLevel) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InformationElementDataType is informationElementDataType (339), unsigned8.
+type InformationElementDataType struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *InformationElementDataType) ElementID() uint16 {
+	return IEID_INFORMATION_ELEMENT_DATA_TYPE
+}
+
+func (fv *InformationElementDataType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InformationElementDataType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *InformationElementDataType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *InformationElementDataType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *InformationElementDataType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InformationElementDescription is informationElementDescription (340), string.
+type InformationElementDescription struct {
+	Val string
+}
+
+func (fv *InformationElementDescription) ElementID() uint16 {
+	return IEID_INFORMATION_ELEMENT_DESCRIPTION
+}
+
+func (fv *InformationElementDescription) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InformationElementDescription) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *InformationElementDescription) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *InformationElementDescription) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *InformationElementDescription) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InformationElementName is informationElementName (341), string.
+type InformationElementName struct {
+	Val string
+}
+
+func (fv *InformationElementName) ElementID() uint16 {
+	return IEID_INFORMATION_ELEMENT_NAME
+}
+
+func (fv *InformationElementName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InformationElementName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *InformationElementName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *InformationElementName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *InformationElementName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InformationElementRangeBegin is informationElementRangeBegin (342), unsigned64 quantity.
+type InformationElementRangeBegin struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *InformationElementRangeBegin) ElementID() uint16 {
+	return IEID_INFORMATION_ELEMENT_RANGE_BEGIN
+}
+
+func (fv *InformationElementRangeBegin) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InformationElementRangeBegin) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *InformationElementRangeBegin) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *InformationElementRangeBegin) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *InformationElementRangeBegin) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InformationElementRangeEnd is informationElementRangeEnd (343), unsigned64 quantity.
+type InformationElementRangeEnd struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *InformationElementRangeEnd) ElementID() uint16 {
+	return IEID_INFORMATION_ELEMENT_RANGE_END
+}
+
+func (fv *InformationElementRangeEnd) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InformationElementRangeEnd) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *InformationElementRangeEnd) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *InformationElementRangeEnd) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *InformationElementRangeEnd) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InformationElementSemantics is informationElementSemantics (344), unsigned8.
+type InformationElementSemantics struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *InformationElementSemantics) ElementID() uint16 {
+	return IEID_INFORMATION_ELEMENT_SEMANTICS
+}
+
+func (fv *InformationElementSemantics) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InformationElementSemantics) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *InformationElementSemantics) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *InformationElementSemantics) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *InformationElementSemantics) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InformationElementUnits is informationElementUnits (345), unsigned16.
+type InformationElementUnits struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *InformationElementUnits) ElementID() uint16 {
+	return IEID_INFORMATION_ELEMENT_UNITS
+}
+
+func (fv *InformationElementUnits) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InformationElementUnits) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *InformationElementUnits) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *InformationElementUnits) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *InformationElementUnits) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PrivateEnterpriseNumber is privateEnterpriseNumber (346), unsigned32 identifier.
+type PrivateEnterpriseNumber struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *PrivateEnterpriseNumber) ElementID() uint16 {
+	return IEID_PRIVATE_ENTERPRISE_NUMBER
+}
+
+func (fv *PrivateEnterpriseNumber) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PrivateEnterpriseNumber) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PrivateEnterpriseNumber) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *PrivateEnterpriseNumber) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *PrivateEnterpriseNumber) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// VirtualStationInterfaceId is virtualStationInterfaceId (347), octetArray.
+type VirtualStationInterfaceId struct {
+	Val []uint8
+}
+
+func (fv *VirtualStationInterfaceId) ElementID() uint16 {
+	return IEID_VIRTUAL_STATION_INTERFACE_ID
+}
+
+func (fv *VirtualStationInterfaceId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *VirtualStationInterfaceId) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *VirtualStationInterfaceId) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *VirtualStationInterfaceId) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *VirtualStationInterfaceId) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// VirtualStationInterfaceName is virtualStationInterfaceName (348), string.
+type VirtualStationInterfaceName struct {
+	Val string
+}
+
+func (fv *VirtualStationInterfaceName) ElementID() uint16 {
+	return IEID_VIRTUAL_STATION_INTERFACE_NAME
+}
+
+func (fv *VirtualStationInterfaceName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *VirtualStationInterfaceName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *VirtualStationInterfaceName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *VirtualStationInterfaceName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *VirtualStationInterfaceName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// VirtualStationUUID is virtualStationUUID (349), octetArray.
+type VirtualStationUUID struct {
+	Val []uint8
+}
+
+func (fv *VirtualStationUUID) ElementID() uint16 {
+	return IEID_VIRTUAL_STATION_UUID
+}
+
+func (fv *VirtualStationUUID) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *VirtualStationUUID) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *VirtualStationUUID) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *VirtualStationUUID) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *VirtualStationUUID) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// VirtualStationName is virtualStationName (350), string.
+type VirtualStationName struct {
+	Val string
+}
+
+func (fv *VirtualStationName) ElementID() uint16 {
+	return IEID_VIRTUAL_STATION_NAME
+}
+
+func (fv *VirtualStationName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *VirtualStationName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *VirtualStationName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *VirtualStationName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *VirtualStationName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Layer2SegmentId is layer2SegmentId (351), unsigned64 identifier.
+type Layer2SegmentId struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *Layer2SegmentId) ElementID() uint16 {
+	return IEID_LAYER2_SEGMENT_ID
+}
+
+func (fv *Layer2SegmentId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Layer2SegmentId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *Layer2SegmentId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *Layer2SegmentId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *Layer2SegmentId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Layer2OctetDeltaCount is layer2OctetDeltaCount (352), unsigned64 deltaCounter in octets.
+type Layer2OctetDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *Layer2OctetDeltaCount) ElementID() uint16 {
+	return IEID_LAYER2_OCTET_DELTA_COUNT
+}
+
+func (fv *Layer2OctetDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Layer2OctetDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *Layer2OctetDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *Layer2OctetDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *Layer2OctetDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Layer2OctetTotalCount is layer2OctetTotalCount (353), unsigned64 totalCounter in octets.
+type Layer2OctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *Layer2OctetTotalCount) ElementID() uint16 {
+	return IEID_LAYER2_OUCET_TOTAL_COUNT
+}
+
+func (fv *Layer2OctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Layer2OctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *Layer2OctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *Layer2OctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *Layer2OctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IngressUnicastPacketTotalCount is ingressUnicastPacketTotalCount (354), unsigned64 totalCounter in packets.
+type IngressUnicastPacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *IngressUnicastPacketTotalCount) ElementID() uint16 {
+	return IEID_INGRESS_UNICAST_PACKET_TOTAL_COUNT
+}
+
+func (fv *IngressUnicastPacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IngressUnicastPacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *IngressUnicastPacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *IngressUnicastPacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *IngressUnicastPacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IngressMulticastPacketTotalCount is ingressMulticastPacketTotalCount (355), unsigned64 totalCounter in packets.
+type IngressMulticastPacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *IngressMulticastPacketTotalCount) ElementID() uint16 {
+	return IEID_INGRESS_MULTICAST_PACKET_TOTAL_COUNT
+}
+
+func (fv *IngressMulticastPacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IngressMulticastPacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *IngressMulticastPacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *IngressMulticastPacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *IngressMulticastPacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IngressBroadcastPacketTotalCount is ingressBroadcastPacketTotalCount (356), unsigned64 totalCounter in packets.
+type IngressBroadcastPacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *IngressBroadcastPacketTotalCount) ElementID() uint16 {
+	return IEID_INGRESS_BROADCAST_PACKET_TOTAL_COUNT
+}
+
+func (fv *IngressBroadcastPacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IngressBroadcastPacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *IngressBroadcastPacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *IngressBroadcastPacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *IngressBroadcastPacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EgressUnicastPacketTotalCount is egressUnicastPacketTotalCount (357), unsigned64 totalCounter in packets.
+type EgressUnicastPacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *EgressUnicastPacketTotalCount) ElementID() uint16 {
+	return IEID_EGRESS_UNICAST_PACKET_TOTAL_COUNT
+}
+
+func (fv *EgressUnicastPacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EgressUnicastPacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *EgressUnicastPacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *EgressUnicastPacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *EgressUnicastPacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EgressBroadcastPacketTotalCount is egressBroadcastPacketTotalCount (358), unsigned64 totalCounter in packets.
+type EgressBroadcastPacketTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *EgressBroadcastPacketTotalCount) ElementID() uint16 {
+	return IEID_EGRESS_BROADCAST_PACKET_TOTAL_COUNT
+}
+
+func (fv *EgressBroadcastPacketTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EgressBroadcastPacketTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *EgressBroadcastPacketTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *EgressBroadcastPacketTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *EgressBroadcastPacketTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MonitoringIntervalStartMilliSeconds is monitoringIntervalStartMilliSeconds (359), dateTimeMilliseconds in milliseconds.
+type MonitoringIntervalStartMilliSeconds struct {
+	Val time.Time
+}
+
+func (fv *MonitoringIntervalStartMilliSeconds) ElementID() uint16 {
+	return IEID_MONITORING_INTERVAL_START_MILLI_SECOUNDS
+}
+
+func (fv *MonitoringIntervalStartMilliSeconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MonitoringIntervalStartMilliSeconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMilliseconds(dst, fv.Val)
+}
+
+func (fv *MonitoringIntervalStartMilliSeconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMilliseconds(data)
+	return nil
+}
+
+func (fv *MonitoringIntervalStartMilliSeconds) Len() uint16 {
+	return 8
+}
+
+func (fv *MonitoringIntervalStartMilliSeconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MonitoringIntervalEndMilliSeconds is monitoringIntervalEndMilliSeconds (360), dateTimeMilliseconds in milliseconds.
+type MonitoringIntervalEndMilliSeconds struct {
+	Val time.Time
+}
+
+func (fv *MonitoringIntervalEndMilliSeconds) ElementID() uint16 {
+	return IEID_MONITORING_INTERVAL_END_MILLI_SECOUNDS
+}
+
+func (fv *MonitoringIntervalEndMilliSeconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MonitoringIntervalEndMilliSeconds) AppendTo(dst []uint8) []uint8 {
+	return appendDateTimeMilliseconds(dst, fv.Val)
+}
+
+func (fv *MonitoringIntervalEndMilliSeconds) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 8); err != nil {
+		return err
+	}
+	fv.Val = decodeDateTimeMilliseconds(data)
+	return nil
+}
+
+func (fv *MonitoringIntervalEndMilliSeconds) Len() uint16 {
+	return 8
+}
+
+func (fv *MonitoringIntervalEndMilliSeconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PortRangeStart is portRangeStart (361), unsigned16 identifier.
+type PortRangeStart struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PortRangeStart) ElementID() uint16 {
+	return IEID_PORT_RANGE_START
+}
+
+func (fv *PortRangeStart) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PortRangeStart) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PortRangeStart) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PortRangeStart) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PortRangeStart) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PortRangeEnd is portRangeEnd (362), unsigned16 identifier.
+type PortRangeEnd struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PortRangeEnd) ElementID() uint16 {
+	return IEID_PORT_RANGE_END
+}
+
+func (fv *PortRangeEnd) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PortRangeEnd) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PortRangeEnd) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PortRangeEnd) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PortRangeEnd) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PortRangeStepSize is portRangeStepSize (363), unsigned16 identifier.
+type PortRangeStepSize struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PortRangeStepSize) ElementID() uint16 {
+	return IEID_PORT_RANGE_STEP_SIZE
+}
+
+func (fv *PortRangeStepSize) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PortRangeStepSize) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PortRangeStepSize) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PortRangeStepSize) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PortRangeStepSize) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PortRangeNumPorts is portRangeNumPorts (364), unsigned16 identifier.
+type PortRangeNumPorts struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *PortRangeNumPorts) ElementID() uint16 {
+	return IEID_PORT_RANGE_NUM_PORTS
+}
+
+func (fv *PortRangeNumPorts) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PortRangeNumPorts) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *PortRangeNumPorts) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *PortRangeNumPorts) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *PortRangeNumPorts) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// StaMacAddress is staMacAddress (365), macAddress.
+type StaMacAddress struct {
+	Val net.HardwareAddr
+}
+
+func (fv *StaMacAddress) ElementID() uint16 {
+	return IEID_STA_MAC_ADDRESS
+}
+
+func (fv *StaMacAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *StaMacAddress) AppendTo(dst []uint8) []uint8 {
+	return appendMacAddress(dst, fv.Val)
+}
+
+func (fv *StaMacAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 6); err != nil {
+		return err
+	}
+	fv.Val = decodeMacAddress(data)
+	return nil
+}
+
+func (fv *StaMacAddress) Len() uint16 {
+	return 6
+}
+
+func (fv *StaMacAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// StaIPv4Address is staIPv4Address (366), ipv4Address.
+type StaIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *StaIPv4Address) ElementID() uint16 {
+	return IEID_STA_IPV4_ADDRESS
+}
+
+func (fv *StaIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *StaIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *StaIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *StaIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *StaIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// WtpMacAddress is wtpMacAddress (367), macAddress.
+type WtpMacAddress struct {
+	Val net.HardwareAddr
+}
+
+func (fv *WtpMacAddress) ElementID() uint16 {
+	return IEID_WTP_MAC_ADDRESS
+}
+
+func (fv *WtpMacAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *WtpMacAddress) AppendTo(dst []uint8) []uint8 {
+	return appendMacAddress(dst, fv.Val)
+}
+
+func (fv *WtpMacAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 6); err != nil {
+		return err
+	}
+	fv.Val = decodeMacAddress(data)
+	return nil
+}
+
+func (fv *WtpMacAddress) Len() uint16 {
+	return 6
+}
+
+func (fv *WtpMacAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IngressInterfaceType is ingressInterfaceType (368), unsigned32 identifier.
+type IngressInterfaceType struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *IngressInterfaceType) ElementID() uint16 {
+	return IEID_INGRESS_INTERFACE_TYPE
+}
+
+func (fv *IngressInterfaceType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IngressInterfaceType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IngressInterfaceType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *IngressInterfaceType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *IngressInterfaceType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// EgressInterfaceType is egressInterfaceType (369), unsigned32 identifier.
+type EgressInterfaceType struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *EgressInterfaceType) ElementID() uint16 {
+	return IEID_EGRESS_INTERFACE_TYPE
+}
+
+func (fv *EgressInterfaceType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *EgressInterfaceType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *EgressInterfaceType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *EgressInterfaceType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *EgressInterfaceType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// RtpSequenceNumber is rtpSequenceNumber (370), unsigned16.
+type RtpSequenceNumber struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *RtpSequenceNumber) ElementID() uint16 {
+	return IEID_RTP_SEQUENCE_NUMBER
+}
+
+func (fv *RtpSequenceNumber) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *RtpSequenceNumber) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *RtpSequenceNumber) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *RtpSequenceNumber) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *RtpSequenceNumber) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// UserName is userName (371), string.
+type UserName struct {
+	Val string
+}
+
+func (fv *UserName) ElementID() uint16 {
+	return IEID_USER_NAME
+}
+
+func (fv *UserName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *UserName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *UserName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *UserName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *UserName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ApplicationCategoryName is applicationCategoryName (372), string.
+type ApplicationCategoryName struct {
+	Val string
+}
+
+func (fv *ApplicationCategoryName) ElementID() uint16 {
+	return IEID_APPLICATION_CATEGORY_NAME
+}
+
+func (fv *ApplicationCategoryName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ApplicationCategoryName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *ApplicationCategoryName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *ApplicationCategoryName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *ApplicationCategoryName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ApplicationSubCategoryName is applicationSubCategoryName (373), string.
+type ApplicationSubCategoryName struct {
+	Val string
+}
+
+func (fv *ApplicationSubCategoryName) ElementID() uint16 {
+	return IEID_APPLICATION_SUB_CATEGORY_NAME
+}
+
+func (fv *ApplicationSubCategoryName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ApplicationSubCategoryName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *ApplicationSubCategoryName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *ApplicationSubCategoryName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *ApplicationSubCategoryName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ApplicationGroupName is applicationGroupName (374), string.
+type ApplicationGroupName struct {
+	Val string
+}
+
+func (fv *ApplicationGroupName) ElementID() uint16 {
+	return IEID_APPLICATION_GROUP_NAME
+}
+
+func (fv *ApplicationGroupName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ApplicationGroupName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *ApplicationGroupName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *ApplicationGroupName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *ApplicationGroupName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// OriginalFlowsPresent is originalFlowsPresent (375), unsigned64 deltaCounter in flows.
+type OriginalFlowsPresent struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *OriginalFlowsPresent) ElementID() uint16 {
+	return IEID_ORIGINAL_FLOWS_PRESENT
+}
+
+func (fv *OriginalFlowsPresent) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OriginalFlowsPresent) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *OriginalFlowsPresent) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *OriginalFlowsPresent) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *OriginalFlowsPresent) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// OriginalFlowsInitiated is originalFlowsInitiated (376), unsigned64 deltaCounter in flows.
+type OriginalFlowsInitiated struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *OriginalFlowsInitiated) ElementID() uint16 {
+	return IEID_ORIGINAL_FLOWS_INITIATED
+}
+
+func (fv *OriginalFlowsInitiated) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OriginalFlowsInitiated) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *OriginalFlowsInitiated) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *OriginalFlowsInitiated) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *OriginalFlowsInitiated) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// OriginalFlowsCompleted is originalFlowsCompleted (377), unsigned64 deltaCounter in flows.
+type OriginalFlowsCompleted struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *OriginalFlowsCompleted) ElementID() uint16 {
+	return IEID_ORIGINAL_FLOWS_COMPLETED
+}
+
+func (fv *OriginalFlowsCompleted) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OriginalFlowsCompleted) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *OriginalFlowsCompleted) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *OriginalFlowsCompleted) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *OriginalFlowsCompleted) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DistinctCountOfSourceIPAddress is distinctCountOfSourceIPAddress (378), unsigned64 totalCounter.
+type DistinctCountOfSourceIPAddress struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DistinctCountOfSourceIPAddress) ElementID() uint16 {
+	return IEID_DISTINCT_COUNT_OF_SOURCE_IP_ADDRESS
+}
+
+func (fv *DistinctCountOfSourceIPAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DistinctCountOfSourceIPAddress) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DistinctCountOfSourceIPAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DistinctCountOfSourceIPAddress) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DistinctCountOfSourceIPAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DistinctCountOfDestinationIPAddress is distinctCountOfDestinationIPAddress (379), unsigned64 totalCounter.
+type DistinctCountOfDestinationIPAddress struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DistinctCountOfDestinationIPAddress) ElementID() uint16 {
+	return IEID_DISTINCT_COUNT_OF_DESTINATION_IP_ADDRESS
+}
+
+func (fv *DistinctCountOfDestinationIPAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DistinctCountOfDestinationIPAddress) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DistinctCountOfDestinationIPAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DistinctCountOfDestinationIPAddress) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DistinctCountOfDestinationIPAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DistinctCountOfSourceIPv4Address is distinctCountOfSourceIPv4Address (380), unsigned32 totalCounter.
+type DistinctCountOfSourceIPv4Address struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *DistinctCountOfSourceIPv4Address) ElementID() uint16 {
+	return IEID_DISTINCT_COUNT_OF_SOURCE_IPV4_ADDRESS
+}
+
+func (fv *DistinctCountOfSourceIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DistinctCountOfSourceIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *DistinctCountOfSourceIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *DistinctCountOfSourceIPv4Address) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *DistinctCountOfSourceIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DistinctCountOfDestinationIPv4Address is distinctCountOfDestinationIPv4Address (381), unsigned32 totalCounter.
+type DistinctCountOfDestinationIPv4Address struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *DistinctCountOfDestinationIPv4Address) ElementID() uint16 {
+	return IEID_DISTINCT_COUNT_OF_DESTINATION_IPV4_ADDRESS
+}
+
+func (fv *DistinctCountOfDestinationIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DistinctCountOfDestinationIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *DistinctCountOfDestinationIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *DistinctCountOfDestinationIPv4Address) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *DistinctCountOfDestinationIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DistinctCountOfSourceIPv6Address is distinctCountOfSourceIPv6Address (382), unsigned64 totalCounter.
+type DistinctCountOfSourceIPv6Address struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DistinctCountOfSourceIPv6Address) ElementID() uint16 {
+	return IEID_DISTINCT_COUNT_OF_SOURCE_IPV6_ADDRESS
+}
+
+func (fv *DistinctCountOfSourceIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DistinctCountOfSourceIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DistinctCountOfSourceIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DistinctCountOfSourceIPv6Address) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DistinctCountOfSourceIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DistinctCountOfDestinationIPv6Address is distinctCountOfDestinationIPv6Address (383), unsigned64 totalCounter.
+type DistinctCountOfDestinationIPv6Address struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DistinctCountOfDestinationIPv6Address) ElementID() uint16 {
+	return IEID_DISTINCT_COUNT_OF_DESTINATION_IPV6_ADDRESS
+}
+
+func (fv *DistinctCountOfDestinationIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DistinctCountOfDestinationIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DistinctCountOfDestinationIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DistinctCountOfDestinationIPv6Address) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DistinctCountOfDestinationIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ValueDistributionMethod is valueDistributionMethod (384), unsigned8.
+type ValueDistributionMethod struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *ValueDistributionMethod) ElementID() uint16 {
+	return IEID_VALUE_DISTRIBUTION_METHOD
+}
+
+func (fv *ValueDistributionMethod) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ValueDistributionMethod) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *ValueDistributionMethod) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *ValueDistributionMethod) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *ValueDistributionMethod) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Rfc3550JitterMilliseconds is rfc3550JitterMilliseconds (385), unsigned32 quantity in milliseconds.
+type Rfc3550JitterMilliseconds struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *Rfc3550JitterMilliseconds) ElementID() uint16 {
+	return IEID_RFC3550_JITTER_MILLISECONDS
+}
+
+func (fv *Rfc3550JitterMilliseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Rfc3550JitterMilliseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Rfc3550JitterMilliseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *Rfc3550JitterMilliseconds) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *Rfc3550JitterMilliseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Rfc3550JitterMicroseconds is rfc3550JitterMicroseconds (386), unsigned32 quantity in microseconds.
+type Rfc3550JitterMicroseconds struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *Rfc3550JitterMicroseconds) ElementID() uint16 {
+	return IEID_RFC3550_JITTER_MICROSECONDS
+}
+
+func (fv *Rfc3550JitterMicroseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Rfc3550JitterMicroseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Rfc3550JitterMicroseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *Rfc3550JitterMicroseconds) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *Rfc3550JitterMicroseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Rfc3550JitterNanoseconds is rfc3550JitterNanoseconds (387), unsigned32 quantity in nanoseconds.
+type Rfc3550JitterNanoseconds struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *Rfc3550JitterNanoseconds) ElementID() uint16 {
+	return IEID_RFC3550_JITTER_NANOSECONDS
+}
+
+func (fv *Rfc3550JitterNanoseconds) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Rfc3550JitterNanoseconds) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Rfc3550JitterNanoseconds) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *Rfc3550JitterNanoseconds) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *Rfc3550JitterNanoseconds) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qDEI is dot1qDEI (388), boolean.
+type Dot1qDEI struct {
+	Val bool
+}
+
+func (fv *Dot1qDEI) ElementID() uint16 {
+	return IEID_DOT1Q_DEI
+}
+
+func (fv *Dot1qDEI) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qDEI) AppendTo(dst []uint8) []uint8 {
+	return appendBoolean(dst, fv.Val)
+}
+
+func (fv *Dot1qDEI) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 1); err != nil {
+		return err
+	}
+	fv.Val = decodeBoolean(data)
+	return nil
+}
+
+func (fv *Dot1qDEI) Len() uint16 {
+	return 1
+}
+
+func (fv *Dot1qDEI) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qCustomerDEI is dot1qCustomerDEI (389), boolean.
+type Dot1qCustomerDEI struct {
+	Val bool
+}
+
+func (fv *Dot1qCustomerDEI) ElementID() uint16 {
+	return IEID_DOT1Q_CUSTOMER_DEI
+}
+
+func (fv *Dot1qCustomerDEI) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qCustomerDEI) AppendTo(dst []uint8) []uint8 {
+	return appendBoolean(dst, fv.Val)
+}
+
+func (fv *Dot1qCustomerDEI) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 1); err != nil {
+		return err
+	}
+	fv.Val = decodeBoolean(data)
+	return nil
+}
+
+func (fv *Dot1qCustomerDEI) Len() uint16 {
+	return 1
+}
+
+func (fv *Dot1qCustomerDEI) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowSelectorAlgorithm is flowSelectorAlgorithm (390), unsigned16 identifier.
+type FlowSelectorAlgorithm struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *FlowSelectorAlgorithm) ElementID() uint16 {
+	return IEID_FLOW_SELECTOR_ALGORITHM
+}
+
+func (fv *FlowSelectorAlgorithm) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowSelectorAlgorithm) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *FlowSelectorAlgorithm) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *FlowSelectorAlgorithm) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *FlowSelectorAlgorithm) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowSelectedOctetDeltaCount is flowSelectedOctetDeltaCount (391), unsigned64 deltaCounter in octets.
+type FlowSelectedOctetDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *FlowSelectedOctetDeltaCount) ElementID() uint16 {
+	return IEID_FLOW_SELECTED_OCTET_DELTA_COUNT
+}
+
+func (fv *FlowSelectedOctetDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowSelectedOctetDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *FlowSelectedOctetDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *FlowSelectedOctetDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *FlowSelectedOctetDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowSelectedPacketDeltaCount is flowSelectedPacketDeltaCount (392), unsigned64 deltaCounter in packets.
+type FlowSelectedPacketDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *FlowSelectedPacketDeltaCount) ElementID() uint16 {
+	return IEID_FLOW_SELECTED_PACKET_DELTA_COUNT
+}
+
+func (fv *FlowSelectedPacketDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowSelectedPacketDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *FlowSelectedPacketDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *FlowSelectedPacketDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *FlowSelectedPacketDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowSelectedFlowDeltaCount is flowSelectedFlowDeltaCount (393), unsigned64 deltaCounter in flows.
+type FlowSelectedFlowDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *FlowSelectedFlowDeltaCount) ElementID() uint16 {
+	return IEID_FLOW_SELECTED_FLOW_DELTA_COUNT
+}
+
+func (fv *FlowSelectedFlowDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowSelectedFlowDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *FlowSelectedFlowDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *FlowSelectedFlowDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *FlowSelectedFlowDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SelectorIDTotalFlowsObserved is selectorIDTotalFlowsObserved (394), unsigned64 in flows.
+type SelectorIDTotalFlowsObserved struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *SelectorIDTotalFlowsObserved) ElementID() uint16 {
+	return IEID_SELECTOR_ID_TOTAL_FLOWS_OBSERVED
+}
+
+func (fv *SelectorIDTotalFlowsObserved) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SelectorIDTotalFlowsObserved) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *SelectorIDTotalFlowsObserved) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *SelectorIDTotalFlowsObserved) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *SelectorIDTotalFlowsObserved) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SelectorIDTotalFlowsSelected is selectorIDTotalFlowsSelected (395), unsigned64 in flows.
+type SelectorIDTotalFlowsSelected struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *SelectorIDTotalFlowsSelected) ElementID() uint16 {
+	return IEID_SELECTOR_ID_TOTAL_FLOWS_SELECTED
+}
+
+func (fv *SelectorIDTotalFlowsSelected) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SelectorIDTotalFlowsSelected) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *SelectorIDTotalFlowsSelected) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *SelectorIDTotalFlowsSelected) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *SelectorIDTotalFlowsSelected) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingFlowInterval is samplingFlowInterval (396), unsigned64 in flows.
+type SamplingFlowInterval struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingFlowInterval) ElementID() uint16 {
+	return IEID_SAMPLING_FLOW_INTERVAL
+}
+
+func (fv *SamplingFlowInterval) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingFlowInterval) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *SamplingFlowInterval) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *SamplingFlowInterval) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *SamplingFlowInterval) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SamplingFlowSpacing is samplingFlowSpacing (397), unsigned64 in flows.
+type SamplingFlowSpacing struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *SamplingFlowSpacing) ElementID() uint16 {
+	return IEID_SAMPLING_FLOW_SPACING
+}
+
+func (fv *SamplingFlowSpacing) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SamplingFlowSpacing) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *SamplingFlowSpacing) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *SamplingFlowSpacing) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *SamplingFlowSpacing) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowSamplingTimeInterval is flowSamplingTimeInterval (398), unsigned64 in microseconds.
+type FlowSamplingTimeInterval struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *FlowSamplingTimeInterval) ElementID() uint16 {
+	return IEID_FLOW_SAMPLING_TIME_INTERVAL
+}
+
+func (fv *FlowSamplingTimeInterval) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowSamplingTimeInterval) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *FlowSamplingTimeInterval) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *FlowSamplingTimeInterval) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *FlowSamplingTimeInterval) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// FlowSamplingTimeSpacing is flowSamplingTimeSpacing (399), unsigned64 in microseconds.
+type FlowSamplingTimeSpacing struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *FlowSamplingTimeSpacing) ElementID() uint16 {
+	return IEID_FLOW_SAMPLING_TIME_SPACING
+}
+
+func (fv *FlowSamplingTimeSpacing) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *FlowSamplingTimeSpacing) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *FlowSamplingTimeSpacing) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *FlowSamplingTimeSpacing) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *FlowSamplingTimeSpacing) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HashFlowDomain is hashFlowDomain (400), unsigned16 identifier.
+type HashFlowDomain struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *HashFlowDomain) ElementID() uint16 {
+	return IEID_HASH_FLOW_DOMAIN
+}
+
+func (fv *HashFlowDomain) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HashFlowDomain) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *HashFlowDomain) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *HashFlowDomain) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *HashFlowDomain) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TransportOctetDeltaCount is transportOctetDeltaCount (401), unsigned64 deltaCounter in octets.
+type TransportOctetDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *TransportOctetDeltaCount) ElementID() uint16 {
+	return IEID_TRANSPORT_OCTET_DELTA_COUNT
+}
+
+func (fv *TransportOctetDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TransportOctetDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *TransportOctetDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *TransportOctetDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *TransportOctetDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// TransportPacketDeltaCount is transportPacketDeltaCount (402), unsigned64 deltaCounter in packets.
+type TransportPacketDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *TransportPacketDeltaCount) ElementID() uint16 {
+	return IEID_TRANSPORT_PACKET_DELTA_COUNT
+}
+
+func (fv *TransportPacketDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *TransportPacketDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *TransportPacketDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *TransportPacketDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *TransportPacketDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// OriginalExporterIPv4Address is originalExporterIPv4Address (403), ipv4Address.
+type OriginalExporterIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *OriginalExporterIPv4Address) ElementID() uint16 {
+	return IEID_ORIGINAL_EXPORTER_IPV4_ADDRESS
+}
+
+func (fv *OriginalExporterIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OriginalExporterIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *OriginalExporterIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *OriginalExporterIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *OriginalExporterIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// OriginalExporterIPv6Address is originalExporterIPv6Address (404), ipv6Address.
+type OriginalExporterIPv6Address struct {
+	Val netip.Addr
+}
+
+func (fv *OriginalExporterIPv6Address) ElementID() uint16 {
+	return IEID_ORIGINAL_EXPORTER_IPV6_ADDRESS
+}
+
+func (fv *OriginalExporterIPv6Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OriginalExporterIPv6Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv6Address(dst, fv.Val)
+}
+
+func (fv *OriginalExporterIPv6Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 16); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom16([16]uint8(data))
+	return nil
+}
+
+func (fv *OriginalExporterIPv6Address) Len() uint16 {
+	return 16
+}
+
+func (fv *OriginalExporterIPv6Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// OriginalObservationDomainId is originalObservationDomainId (405), unsigned32 identifier.
+type OriginalObservationDomainId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *OriginalObservationDomainId) ElementID() uint16 {
+	return IEID_ORIGINAL_OBSERVATION_DOMAIN_ID
+}
+
+func (fv *OriginalObservationDomainId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *OriginalObservationDomainId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *OriginalObservationDomainId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *OriginalObservationDomainId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *OriginalObservationDomainId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IntermediateProcessId is intermediateProcessId (406), unsigned32 identifier.
+type IntermediateProcessId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *IntermediateProcessId) ElementID() uint16 {
+	return IEID_INTERMEDIATE_PROCESS_ID
+}
+
+func (fv *IntermediateProcessId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IntermediateProcessId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *IntermediateProcessId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *IntermediateProcessId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *IntermediateProcessId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IgnoredDataRecordTotalCount is ignoredDataRecordTotalCount (407), unsigned64 totalCounter.
+type IgnoredDataRecordTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *IgnoredDataRecordTotalCount) ElementID() uint16 {
+	return IEID_IGNORED_DATA_RECORD_TOTAL_COUNT
+}
+
+func (fv *IgnoredDataRecordTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IgnoredDataRecordTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *IgnoredDataRecordTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *IgnoredDataRecordTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *IgnoredDataRecordTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DataLinkFrameType is dataLinkFrameType (408), unsigned16 flags.
+type DataLinkFrameType struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *DataLinkFrameType) ElementID() uint16 {
+	return IEID_DATA_LINK_FRAME_TYPE
+}
+
+func (fv *DataLinkFrameType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DataLinkFrameType) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *DataLinkFrameType) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *DataLinkFrameType) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *DataLinkFrameType) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SectionOffset is sectionOffset (409), unsigned16 quantity.
+type SectionOffset struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *SectionOffset) ElementID() uint16 {
+	return IEID_SECTION_OFFSET
+}
+
+func (fv *SectionOffset) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SectionOffset) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SectionOffset) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *SectionOffset) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *SectionOffset) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SectionExportedOctets is sectionExportedOctets (410), unsigned16 quantity.
+type SectionExportedOctets struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *SectionExportedOctets) ElementID() uint16 {
+	return IEID_SECTION_EXPORTED_OCTETS
+}
+
+func (fv *SectionExportedOctets) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SectionExportedOctets) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SectionExportedOctets) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *SectionExportedOctets) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *SectionExportedOctets) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qServiceInstanceTag is dot1qServiceInstanceTag (411), octetArray.
+type Dot1qServiceInstanceTag struct {
+	Val []uint8
+}
+
+func (fv *Dot1qServiceInstanceTag) ElementID() uint16 {
+	return IEID_DOT1Q_SERVICE_INSTANCE_TAG
+}
+
+func (fv *Dot1qServiceInstanceTag) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qServiceInstanceTag) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *Dot1qServiceInstanceTag) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *Dot1qServiceInstanceTag) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *Dot1qServiceInstanceTag) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qServiceInstanceId is dot1qServiceInstanceId (412), unsigned32 identifier.
+type Dot1qServiceInstanceId struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *Dot1qServiceInstanceId) ElementID() uint16 {
+	return IEID_DOT1Q_SERVICE_INSTANCE_ID
+}
+
+func (fv *Dot1qServiceInstanceId) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qServiceInstanceId) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Dot1qServiceInstanceId) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *Dot1qServiceInstanceId) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *Dot1qServiceInstanceId) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qServiceInstancePriority is dot1qServiceInstancePriority (413), unsigned8 identifier.
+type Dot1qServiceInstancePriority struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *Dot1qServiceInstancePriority) ElementID() uint16 {
+	return IEID_DOT1Q_SERVICE_INSTANCE_PRIORITY
+}
+
+func (fv *Dot1qServiceInstancePriority) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qServiceInstancePriority) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *Dot1qServiceInstancePriority) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *Dot1qServiceInstancePriority) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *Dot1qServiceInstancePriority) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qCustomerSourceMacAddress is dot1qCustomerSourceMacAddress (414), macAddress.
+type Dot1qCustomerSourceMacAddress struct {
+	Val net.HardwareAddr
+}
+
+func (fv *Dot1qCustomerSourceMacAddress) ElementID() uint16 {
+	return IEID_DOT1Q_CUSTOMER_SOURCE_MAC_ADDRESS
+}
+
+func (fv *Dot1qCustomerSourceMacAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qCustomerSourceMacAddress) AppendTo(dst []uint8) []uint8 {
+	return appendMacAddress(dst, fv.Val)
+}
+
+func (fv *Dot1qCustomerSourceMacAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 6); err != nil {
+		return err
+	}
+	fv.Val = decodeMacAddress(data)
+	return nil
+}
+
+func (fv *Dot1qCustomerSourceMacAddress) Len() uint16 {
+	return 6
+}
+
+func (fv *Dot1qCustomerSourceMacAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Dot1qCustomerDestinationMacAddress is dot1qCustomerDestinationMacAddress (415), macAddress.
+type Dot1qCustomerDestinationMacAddress struct {
+	Val net.HardwareAddr
+}
+
+func (fv *Dot1qCustomerDestinationMacAddress) ElementID() uint16 {
+	return IEID_DOT1Q_CUSTOMER_DESTINATION_MAC_ADDRESS
+}
+
+func (fv *Dot1qCustomerDestinationMacAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Dot1qCustomerDestinationMacAddress) AppendTo(dst []uint8) []uint8 {
+	return appendMacAddress(dst, fv.Val)
+}
+
+func (fv *Dot1qCustomerDestinationMacAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 6); err != nil {
+		return err
+	}
+	fv.Val = decodeMacAddress(data)
+	return nil
+}
+
+func (fv *Dot1qCustomerDestinationMacAddress) Len() uint16 {
+	return 6
+}
+
+func (fv *Dot1qCustomerDestinationMacAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostLayer2OctetDeltaCount is postLayer2OctetDeltaCount (417), unsigned64 deltaCounter in octets.
+type PostLayer2OctetDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostLayer2OctetDeltaCount) ElementID() uint16 {
+	return IEID_POST_LAYER2_OCTET_DELTA_COUNT
+}
+
+func (fv *PostLayer2OctetDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostLayer2OctetDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostLayer2OctetDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostLayer2OctetDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostLayer2OctetDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostMCastLayer2OctetDeltaCount is postMCastLayer2OctetDeltaCount (418), unsigned64 deltaCounter in octets.
+type PostMCastLayer2OctetDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostMCastLayer2OctetDeltaCount) ElementID() uint16 {
+	return IEID_POST_MCAST_LAYER2_OCTET_DELTA_COUNT
+}
+
+func (fv *PostMCastLayer2OctetDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostMCastLayer2OctetDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostMCastLayer2OctetDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostMCastLayer2OctetDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostMCastLayer2OctetDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostLayer2OctetTotalCount is postLayer2OctetTotalCount (420), unsigned64 totalCounter in octets.
+type PostLayer2OctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostLayer2OctetTotalCount) ElementID() uint16 {
+	return IEID_POST_LAYER2_OCTET_TOTAL_COUNT
+}
+
+func (fv *PostLayer2OctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostLayer2OctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostLayer2OctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostLayer2OctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostLayer2OctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PostMCastLayer2OctetTotalCount is postMCastLayer2OctetTotalCount (421), unsigned64 totalCounter in octets.
+type PostMCastLayer2OctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *PostMCastLayer2OctetTotalCount) ElementID() uint16 {
+	return IEID_POST_MCAST_LAYER2_OCTET_TOTAL_COUNT
+}
+
+func (fv *PostMCastLayer2OctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PostMCastLayer2OctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *PostMCastLayer2OctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *PostMCastLayer2OctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *PostMCastLayer2OctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MinimumLayer2TotalLength is minimumLayer2TotalLength (422), unsigned64 in octets.
+type MinimumLayer2TotalLength struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *MinimumLayer2TotalLength) ElementID() uint16 {
+	return IEID_MINIMUM_LAYER2_TOTAL_LENGTH
+}
+
+func (fv *MinimumLayer2TotalLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MinimumLayer2TotalLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *MinimumLayer2TotalLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *MinimumLayer2TotalLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *MinimumLayer2TotalLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaximumLayer2TotalLength is maximumLayer2TotalLength (423), unsigned64 in octets.
+type MaximumLayer2TotalLength struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *MaximumLayer2TotalLength) ElementID() uint16 {
+	return IEID_MAXIMUM_LAYER2_TOTAL_LENGTH
+}
+
+func (fv *MaximumLayer2TotalLength) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaximumLayer2TotalLength) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *MaximumLayer2TotalLength) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *MaximumLayer2TotalLength) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *MaximumLayer2TotalLength) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DroppedLayer2OctetDeltaCount is droppedLayer2OctetDeltaCount (424), unsigned64 deltaCounter in octets.
+type DroppedLayer2OctetDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DroppedLayer2OctetDeltaCount) ElementID() uint16 {
+	return IEID_DROPPED_LAYER2_OCTET_DELTA_COUNT
+}
+
+func (fv *DroppedLayer2OctetDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DroppedLayer2OctetDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DroppedLayer2OctetDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DroppedLayer2OctetDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DroppedLayer2OctetDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// DroppedLayer2OctetTotalCount is droppedLayer2OctetTotalCount (425), unsigned64 totalCounter in octets.
+type DroppedLayer2OctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *DroppedLayer2OctetTotalCount) ElementID() uint16 {
+	return IEID_DROPPED_LAYER2_OCTET_TOTAL_COUNT
+}
+
+func (fv *DroppedLayer2OctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *DroppedLayer2OctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *DroppedLayer2OctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *DroppedLayer2OctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *DroppedLayer2OctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IgnoredLayer2OctetTotalCount is ignoredLayer2OctetTotalCount (426), unsigned64 totalCounter in octets.
+type IgnoredLayer2OctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *IgnoredLayer2OctetTotalCount) ElementID() uint16 {
+	return IEID_IGNORED_LAYER2_OCTET_TOTAL_COUNT
+}
+
+func (fv *IgnoredLayer2OctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IgnoredLayer2OctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *IgnoredLayer2OctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *IgnoredLayer2OctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *IgnoredLayer2OctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NotSentLayer2OctetTotalCount is notSentLayer2OctetTotalCount (427), unsigned64 totalCounter in octets.
+type NotSentLayer2OctetTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *NotSentLayer2OctetTotalCount) ElementID() uint16 {
+	return IEID_NOT_SENT_LAYER2_OCTET_TOTAL_COUNT
+}
+
+func (fv *NotSentLayer2OctetTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NotSentLayer2OctetTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *NotSentLayer2OctetTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *NotSentLayer2OctetTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *NotSentLayer2OctetTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Layer2OctetDeltaSumOfSquares is layer2OctetDeltaSumOfSquares (428), unsigned64 deltaCounter in octets.
+type Layer2OctetDeltaSumOfSquares struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *Layer2OctetDeltaSumOfSquares) ElementID() uint16 {
+	return IEID_LAYER2_OCTET_DELTA_SUM_OF_SQUARES
+}
+
+func (fv *Layer2OctetDeltaSumOfSquares) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Layer2OctetDeltaSumOfSquares) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *Layer2OctetDeltaSumOfSquares) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *Layer2OctetDeltaSumOfSquares) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *Layer2OctetDeltaSumOfSquares) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Layer2OctetTotalSumOfSquares is layer2OctetTotalSumOfSquares (429), unsigned64 totalCounter in octets.
+type Layer2OctetTotalSumOfSquares struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *Layer2OctetTotalSumOfSquares) ElementID() uint16 {
+	return 429
+}
+
+func (fv *Layer2OctetTotalSumOfSquares) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Layer2OctetTotalSumOfSquares) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *Layer2OctetTotalSumOfSquares) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *Layer2OctetTotalSumOfSquares) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *Layer2OctetTotalSumOfSquares) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Layer2FrameDeltaCount is layer2FrameDeltaCount (430), unsigned64 deltaCounter in frames.
+type Layer2FrameDeltaCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *Layer2FrameDeltaCount) ElementID() uint16 {
+	return IEID_LAYER2_FRAME_DELTA_COUNT
+}
+
+func (fv *Layer2FrameDeltaCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Layer2FrameDeltaCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *Layer2FrameDeltaCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *Layer2FrameDeltaCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *Layer2FrameDeltaCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// Layer2FrameTotalCount is layer2FrameTotalCount (431), unsigned64 totalCounter in frames.
+type Layer2FrameTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *Layer2FrameTotalCount) ElementID() uint16 {
+	return IEID_LAYER2_FRAME_TOTAL_COUNT
+}
+
+func (fv *Layer2FrameTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *Layer2FrameTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *Layer2FrameTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *Layer2FrameTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *Layer2FrameTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// PseudoWireDestinationIPv4Address is pseudoWireDestinationIPv4Address (432), ipv4Address.
+type PseudoWireDestinationIPv4Address struct {
+	Val netip.Addr
+}
+
+func (fv *PseudoWireDestinationIPv4Address) ElementID() uint16 {
+	return IEID_PSEUDOWIRE_DESTINATION_IPV4_ADDRESS
+}
+
+func (fv *PseudoWireDestinationIPv4Address) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *PseudoWireDestinationIPv4Address) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *PseudoWireDestinationIPv4Address) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *PseudoWireDestinationIPv4Address) Len() uint16 {
+	return 4
+}
+
+func (fv *PseudoWireDestinationIPv4Address) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// IgnoredLayer2FrameTotalCount is ignoredLayer2FrameTotalCount (433), unsigned64 totalCounter in frames.
+type IgnoredLayer2FrameTotalCount struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *IgnoredLayer2FrameTotalCount) ElementID() uint16 {
+	return IEID_IGNORED_LAYER2_FRAME_TOTAL_COUNT
+}
+
+func (fv *IgnoredLayer2FrameTotalCount) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *IgnoredLayer2FrameTotalCount) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *IgnoredLayer2FrameTotalCount) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *IgnoredLayer2FrameTotalCount) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *IgnoredLayer2FrameTotalCount) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueInteger is mibObjectValueInteger (434), signed32 quantity.
+type MibObjectValueInteger struct {
+	Val int32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MibObjectValueInteger) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_INTEGER
+}
+
+func (fv *MibObjectValueInteger) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueInteger) AppendTo(dst []uint8) []uint8 {
+	return appendSigned(dst, int64(fv.Val), fv.Len())
+}
+
+func (fv *MibObjectValueInteger) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_SIGNED32); err != nil {
+		return err
+	}
+	fv.Val = int32(decodeSigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_SIGNED32)
+	return nil
+}
+
+func (fv *MibObjectValueInteger) Len() uint16 {
+	return DATA_TYPE_SIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MibObjectValueInteger) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueOctetString is mibObjectValueOctetString (435), octetArray.
+type MibObjectValueOctetString struct {
+	Val []uint8
+}
+
+func (fv *MibObjectValueOctetString) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_OCTET_STRING
+}
+
+func (fv *MibObjectValueOctetString) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueOctetString) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MibObjectValueOctetString) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MibObjectValueOctetString) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MibObjectValueOctetString) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueOID is mibObjectValueOID (436), octetArray.
+type MibObjectValueOID struct {
+	Val []uint8
+}
+
+func (fv *MibObjectValueOID) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_OID
+}
+
+func (fv *MibObjectValueOID) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueOID) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MibObjectValueOID) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MibObjectValueOID) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MibObjectValueOID) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueBits is mibObjectValueBits (437), octetArray flags.
+type MibObjectValueBits struct {
+	Val []uint8
+}
+
+func (fv *MibObjectValueBits) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_BITS
+}
+
+func (fv *MibObjectValueBits) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueBits) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MibObjectValueBits) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MibObjectValueBits) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MibObjectValueBits) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueIPAddress is mibObjectValueIPAddress (438), ipv4Address.
+type MibObjectValueIPAddress struct {
+	Val netip.Addr
+}
+
+func (fv *MibObjectValueIPAddress) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_IP_ADDRESS
+}
+
+func (fv *MibObjectValueIPAddress) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueIPAddress) AppendTo(dst []uint8) []uint8 {
+	return appendIPv4Address(dst, fv.Val)
+}
+
+func (fv *MibObjectValueIPAddress) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, 4); err != nil {
+		return err
+	}
+	fv.Val = netip.AddrFrom4([4]uint8(data))
+	return nil
+}
+
+func (fv *MibObjectValueIPAddress) Len() uint16 {
+	return 4
+}
+
+func (fv *MibObjectValueIPAddress) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueCounter is mibObjectValueCounter (439), unsigned64 snmpCounter.
+type MibObjectValueCounter struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *MibObjectValueCounter) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_COUNTER
+}
+
+func (fv *MibObjectValueCounter) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueCounter) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *MibObjectValueCounter) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *MibObjectValueCounter) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *MibObjectValueCounter) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueGauge is mibObjectValueGauge (440), unsigned32 snmpGauge.
+type MibObjectValueGauge struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MibObjectValueGauge) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_GAUGE
+}
+
+func (fv *MibObjectValueGauge) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueGauge) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MibObjectValueGauge) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MibObjectValueGauge) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MibObjectValueGauge) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueTimeTicks is mibObjectValueTimeTicks (441), unsigned32 quantity.
+type MibObjectValueTimeTicks struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MibObjectValueTimeTicks) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_TIME_TICKS
+}
+
+func (fv *MibObjectValueTimeTicks) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueTimeTicks) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MibObjectValueTimeTicks) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MibObjectValueTimeTicks) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MibObjectValueTimeTicks) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueUnsigned is mibObjectValueUnsigned (442), unsigned32 quantity.
+type MibObjectValueUnsigned struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MibObjectValueUnsigned) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_UNSIGNED
+}
+
+func (fv *MibObjectValueUnsigned) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueUnsigned) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MibObjectValueUnsigned) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MibObjectValueUnsigned) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MibObjectValueUnsigned) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueTable is mibObjectValueTable (443), subTemplateList list.
+type MibObjectValueTable struct {
+	Val SubTemplateList
+}
+
+func (fv *MibObjectValueTable) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_TABLE
+}
+
+func (fv *MibObjectValueTable) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueTable) AppendTo(dst []uint8) []uint8 {
+	return fv.Val.AppendTo(dst)
+}
+
+func (fv *MibObjectValueTable) DecodeFromBytes(data []uint8) error {
+	return fv.Val.DecodeFromBytes(data)
+}
+
+func (fv *MibObjectValueTable) Len() uint16 {
+	return fv.Val.Len()
+}
+
+func (fv *MibObjectValueTable) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectValueRow is mibObjectValueRow (444), subTemplateList list.
+type MibObjectValueRow struct {
+	Val SubTemplateList
+}
+
+func (fv *MibObjectValueRow) ElementID() uint16 {
+	return IEID_MIB_OBJECT_VALUE_ROW
+}
+
+func (fv *MibObjectValueRow) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectValueRow) AppendTo(dst []uint8) []uint8 {
+	return fv.Val.AppendTo(dst)
+}
+
+func (fv *MibObjectValueRow) DecodeFromBytes(data []uint8) error {
+	return fv.Val.DecodeFromBytes(data)
+}
+
+func (fv *MibObjectValueRow) Len() uint16 {
+	return fv.Val.Len()
+}
+
+func (fv *MibObjectValueRow) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectIdentifier is mibObjectIdentifier (445), octetArray.
+type MibObjectIdentifier struct {
+	Val []uint8
+}
+
+func (fv *MibObjectIdentifier) ElementID() uint16 {
+	return IEID_MIB_OBJECT_IDENTIFIER
+}
+
+func (fv *MibObjectIdentifier) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectIdentifier) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MibObjectIdentifier) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MibObjectIdentifier) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MibObjectIdentifier) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibSubIdentifier is mibSubIdentifier (446), unsigned32 identifier.
+type MibSubIdentifier struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MibSubIdentifier) ElementID() uint16 {
+	return IEID_MIB_SUB_IDENTIFIER
+}
+
+func (fv *MibSubIdentifier) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibSubIdentifier) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MibSubIdentifier) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MibSubIdentifier) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MibSubIdentifier) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibIndexIndicator is mibIndexIndicator (447), unsigned64 flags.
+type MibIndexIndicator struct {
+	Val uint64
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 8-octet encodings
+	Length uint16
+}
+
+func (fv *MibIndexIndicator) ElementID() uint16 {
+	return IEID_MIB_INDEX_INDICATOR
+}
+
+func (fv *MibIndexIndicator) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibIndexIndicator) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, fv.Val, fv.Len())
+}
+
+func (fv *MibIndexIndicator) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED64); err != nil {
+		return err
+	}
+	fv.Val = decodeUnsigned(data)
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED64)
+	return nil
+}
+
+func (fv *MibIndexIndicator) Len() uint16 {
+	return DATA_TYPE_UNSIGNED64.reducedLength(fv.Length)
+}
+
+func (fv *MibIndexIndicator) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibCaptureTimeSemantics is mibCaptureTimeSemantics (448), unsigned8 identifier.
+type MibCaptureTimeSemantics struct {
+	Val uint8
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 1-octet encodings
+	Length uint16
+}
+
+func (fv *MibCaptureTimeSemantics) ElementID() uint16 {
+	return IEID_MIB_CAPTURE_TIME_SEMICOLONS
+}
+
+func (fv *MibCaptureTimeSemantics) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibCaptureTimeSemantics) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MibCaptureTimeSemantics) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED8); err != nil {
+		return err
+	}
+	fv.Val = uint8(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED8)
+	return nil
+}
+
+func (fv *MibCaptureTimeSemantics) Len() uint16 {
+	return DATA_TYPE_UNSIGNED8.reducedLength(fv.Length)
+}
+
+func (fv *MibCaptureTimeSemantics) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibContextEngineID is mibContextEngineID (449), octetArray.
+type MibContextEngineID struct {
+	Val []uint8
+}
+
+func (fv *MibContextEngineID) ElementID() uint16 {
+	return IEID_MIB_CONTEXT_ENGINE_ID
+}
+
+func (fv *MibContextEngineID) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibContextEngineID) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MibContextEngineID) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *MibContextEngineID) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MibContextEngineID) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibContextName is mibContextName (450), string.
+type MibContextName struct {
+	Val string
+}
+
+func (fv *MibContextName) ElementID() uint16 {
+	return IEID_MIB_CONTEXT_NAME
+}
+
+func (fv *MibContextName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibContextName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MibContextName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *MibContextName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MibContextName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectName is mibObjectName (451), string.
+type MibObjectName struct {
+	Val string
+}
+
+func (fv *MibObjectName) ElementID() uint16 {
+	return IEID_MIB_OBJECT_NAME
+}
+
+func (fv *MibObjectName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MibObjectName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *MibObjectName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MibObjectName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectDescription is mibObjectDescription (452), string.
+type MibObjectDescription struct {
+	Val string
+}
+
+func (fv *MibObjectDescription) ElementID() uint16 {
+	return IEID_MIB_OBJECT_DESCRIPTION
+}
+
+func (fv *MibObjectDescription) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectDescription) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MibObjectDescription) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *MibObjectDescription) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MibObjectDescription) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibObjectSyntax is mibObjectSyntax (453), string.
+type MibObjectSyntax struct {
+	Val string
+}
+
+func (fv *MibObjectSyntax) ElementID() uint16 {
+	return IEID_MIB_OBJECT_SYNTAX
+}
+
+func (fv *MibObjectSyntax) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibObjectSyntax) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MibObjectSyntax) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *MibObjectSyntax) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MibObjectSyntax) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MibModuleName is mibModuleName (454), string.
+type MibModuleName struct {
+	Val string
+}
+
+func (fv *MibModuleName) ElementID() uint16 {
+	return IEID_MIB_MODULE_NAME
+}
+
+func (fv *MibModuleName) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MibModuleName) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MibModuleName) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *MibModuleName) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MibModuleName) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MobileIMSI is mobileIMSI (455), string.
+type MobileIMSI struct {
+	Val string
+}
+
+func (fv *MobileIMSI) ElementID() uint16 {
+	return IEID_MOBILE_IMSI
+}
+
+func (fv *MobileIMSI) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MobileIMSI) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MobileIMSI) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *MobileIMSI) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MobileIMSI) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MobileMSISDN is mobileMSISDN (456), string.
+type MobileMSISDN struct {
+	Val string
+}
+
+func (fv *MobileMSISDN) ElementID() uint16 {
+	return IEID_MOBILE_MSISDN
+}
+
+func (fv *MobileMSISDN) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MobileMSISDN) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *MobileMSISDN) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *MobileMSISDN) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *MobileMSISDN) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HttpStatusCode is httpStatusCode (457), unsigned16 identifier.
+type HttpStatusCode struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *HttpStatusCode) ElementID() uint16 {
+	return IEID_HTTP_STATUS_CODE
+}
+
+func (fv *HttpStatusCode) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HttpStatusCode) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *HttpStatusCode) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *HttpStatusCode) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *HttpStatusCode) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SourceTransportPortsLimit is sourceTransportPortsLimit (458), unsigned16 quantity in ports.
+type SourceTransportPortsLimit struct {
+	Val uint16
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 2-octet encodings
+	Length uint16
+}
+
+func (fv *SourceTransportPortsLimit) ElementID() uint16 {
+	return IEID_SOURCE_TRANSPORT_PORTS_LIMIT
+}
+
+func (fv *SourceTransportPortsLimit) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SourceTransportPortsLimit) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *SourceTransportPortsLimit) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED16); err != nil {
+		return err
+	}
+	fv.Val = uint16(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED16)
+	return nil
+}
+
+func (fv *SourceTransportPortsLimit) Len() uint16 {
+	return DATA_TYPE_UNSIGNED16.reducedLength(fv.Length)
+}
+
+func (fv *SourceTransportPortsLimit) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HttpRequestMethod is httpRequestMethod (459), string.
+type HttpRequestMethod struct {
+	Val string
+}
+
+func (fv *HttpRequestMethod) ElementID() uint16 {
+	return IEID_HTTP_REQUEST_METHOD
+}
+
+func (fv *HttpRequestMethod) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HttpRequestMethod) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *HttpRequestMethod) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *HttpRequestMethod) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *HttpRequestMethod) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HttpRequestHost is httpRequestHost (460), string.
+type HttpRequestHost struct {
+	Val string
+}
+
+func (fv *HttpRequestHost) ElementID() uint16 {
+	return IEID_HTTP_REQUEST_HOST
+}
+
+func (fv *HttpRequestHost) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HttpRequestHost) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *HttpRequestHost) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *HttpRequestHost) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *HttpRequestHost) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HttpRequestTarget is httpRequestTarget (461), string.
+type HttpRequestTarget struct {
+	Val string
+}
+
+func (fv *HttpRequestTarget) ElementID() uint16 {
+	return IEID_HTTP_REQUEST_TARGET
+}
+
+func (fv *HttpRequestTarget) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HttpRequestTarget) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *HttpRequestTarget) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *HttpRequestTarget) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *HttpRequestTarget) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HttpMessageVersion is httpMessageVersion (462), string.
+type HttpMessageVersion struct {
+	Val string
+}
+
+func (fv *HttpMessageVersion) ElementID() uint16 {
+	return IEID_HTTP_MESSAGE_VERSION
+}
+
+func (fv *HttpMessageVersion) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HttpMessageVersion) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *HttpMessageVersion) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *HttpMessageVersion) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *HttpMessageVersion) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NatInstanceID is natInstanceID (463), unsigned32 identifier.
+type NatInstanceID struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *NatInstanceID) ElementID() uint16 {
+	return IEID_NAT_INSTANCE_ID
+}
+
+func (fv *NatInstanceID) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NatInstanceID) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *NatInstanceID) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *NatInstanceID) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *NatInstanceID) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// InternalAddressRealm is internalAddressRealm (464), octetArray identifier.
+type InternalAddressRealm struct {
+	Val []uint8
+}
+
+func (fv *InternalAddressRealm) ElementID() uint16 {
+	return IEID_INTERNAL_ADDRESS_REALM
+}
+
+func (fv *InternalAddressRealm) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *InternalAddressRealm) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *InternalAddressRealm) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *InternalAddressRealm) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *InternalAddressRealm) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// ExternalAddressRealm is externalAddressRealm (465), octetArray identifier.
+type ExternalAddressRealm struct {
+	Val []uint8
+}
+
+func (fv *ExternalAddressRealm) ElementID() uint16 {
+	return IEID_EXTERNAL_ADDRESS_REALM
+}
+
+func (fv *ExternalAddressRealm) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *ExternalAddressRealm) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *ExternalAddressRealm) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *ExternalAddressRealm) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *ExternalAddressRealm) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NatQuotaExceededEvent is natQuotaExceededEvent (466), unsigned32 identifier.
+type NatQuotaExceededEvent struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *NatQuotaExceededEvent) ElementID() uint16 {
+	return IEID_NAT_QUOTA_EXCEEDED_EVENT
+}
+
+func (fv *NatQuotaExceededEvent) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NatQuotaExceededEvent) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *NatQuotaExceededEvent) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *NatQuotaExceededEvent) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *NatQuotaExceededEvent) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// NatThresholdEvent is natThresholdEvent (467), unsigned32 identifier.
+type NatThresholdEvent struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *NatThresholdEvent) ElementID() uint16 {
+	return IEID_NAT_THRESHOLD_EVENT
+}
+
+func (fv *NatThresholdEvent) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *NatThresholdEvent) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *NatThresholdEvent) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *NatThresholdEvent) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *NatThresholdEvent) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HttpUserAgent is httpUserAgent (468), string.
+type HttpUserAgent struct {
+	Val string
+}
+
+func (fv *HttpUserAgent) ElementID() uint16 {
+	return IEID_HTTP_USER_AGENT
+}
+
+func (fv *HttpUserAgent) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HttpUserAgent) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *HttpUserAgent) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *HttpUserAgent) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *HttpUserAgent) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HttpContentType is httpContentType (469), string.
+type HttpContentType struct {
+	Val string
+}
+
+func (fv *HttpContentType) ElementID() uint16 {
+	return IEID_HTTP_CONTENT_TYPE
+}
+
+func (fv *HttpContentType) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HttpContentType) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *HttpContentType) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *HttpContentType) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *HttpContentType) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// HttpReasonPhrase is httpReasonPhrase (470), string.
+type HttpReasonPhrase struct {
+	Val string
+}
+
+func (fv *HttpReasonPhrase) ElementID() uint16 {
+	return IEID_HTTP_REASON_PHRASE
+}
+
+func (fv *HttpReasonPhrase) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *HttpReasonPhrase) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *HttpReasonPhrase) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = string(value)
+	return nil
+}
+
+func (fv *HttpReasonPhrase) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *HttpReasonPhrase) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaxSessionEntries is maxSessionEntries (471), unsigned32 identifier.
+type MaxSessionEntries struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MaxSessionEntries) ElementID() uint16 {
+	return IEID_MAX_SESSION_ENTRIES
+}
+
+func (fv *MaxSessionEntries) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaxSessionEntries) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MaxSessionEntries) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MaxSessionEntries) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MaxSessionEntries) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaxBIBEntries is maxBIBEntries (472), unsigned32 identifier.
+type MaxBIBEntries struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MaxBIBEntries) ElementID() uint16 {
+	return IEID_MAX_BIB_ENTRIES
+}
+
+func (fv *MaxBIBEntries) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaxBIBEntries) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MaxBIBEntries) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MaxBIBEntries) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MaxBIBEntries) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaxEntriesPerUser is maxEntriesPerUser (473), unsigned32 identifier.
+type MaxEntriesPerUser struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MaxEntriesPerUser) ElementID() uint16 {
+	return IEID_MAX_ENTRIES_PER_USER
+}
+
+func (fv *MaxEntriesPerUser) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaxEntriesPerUser) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MaxEntriesPerUser) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MaxEntriesPerUser) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MaxEntriesPerUser) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaxSubscribers is maxSubscribers (474), unsigned32 identifier.
+type MaxSubscribers struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MaxSubscribers) ElementID() uint16 {
+	return IEID_MAX_SUBSCRIBERS
+}
+
+func (fv *MaxSubscribers) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaxSubscribers) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MaxSubscribers) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MaxSubscribers) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MaxSubscribers) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// MaxFragmentsPendingReassembly is maxFragmentsPendingReassembly (475), unsigned32 identifier.
+type MaxFragmentsPendingReassembly struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *MaxFragmentsPendingReassembly) ElementID() uint16 {
+	return IEID_MAX_FRAGMENTS_PENDING_REASSEMBLY
+}
+
+func (fv *MaxFragmentsPendingReassembly) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *MaxFragmentsPendingReassembly) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *MaxFragmentsPendingReassembly) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *MaxFragmentsPendingReassembly) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *MaxFragmentsPendingReassembly) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// AddressPoolHighThreshold is addressPoolHighThreshold (476), unsigned32 identifier.
+type AddressPoolHighThreshold struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *AddressPoolHighThreshold) ElementID() uint16 {
+	return IEID_ADDRESS_POOL_HIGH_THRESHOLD
+}
+
+func (fv *AddressPoolHighThreshold) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *AddressPoolHighThreshold) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *AddressPoolHighThreshold) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *AddressPoolHighThreshold) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *AddressPoolHighThreshold) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// AddressPoolLowThreshold is addressPoolLowThreshold (477), unsigned32 identifier.
+type AddressPoolLowThreshold struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *AddressPoolLowThreshold) ElementID() uint16 {
+	return IEID_ADDRESS_POOL_LOW_THRESHOLD
+}
+
+func (fv *AddressPoolLowThreshold) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *AddressPoolLowThreshold) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *AddressPoolLowThreshold) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *AddressPoolLowThreshold) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *AddressPoolLowThreshold) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// AddressPortMappingHighThreshold is addressPortMappingHighThreshold (478), unsigned32 identifier.
+type AddressPortMappingHighThreshold struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *AddressPortMappingHighThreshold) ElementID() uint16 {
+	return IEID_ADDRESS_POOL_MAPPING_HIGH_THRESHOLD
+}
+
+func (fv *AddressPortMappingHighThreshold) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *AddressPortMappingHighThreshold) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *AddressPortMappingHighThreshold) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *AddressPortMappingHighThreshold) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *AddressPortMappingHighThreshold) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// AddressPortMappingLowThreshold is addressPortMappingLowThreshold (479), unsigned32 identifier.
+type AddressPortMappingLowThreshold struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *AddressPortMappingLowThreshold) ElementID() uint16 {
+	return IEID_ADDRESS_POOL_MAPPING_LOW_THRESHOLD
+}
+
+func (fv *AddressPortMappingLowThreshold) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *AddressPortMappingLowThreshold) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *AddressPortMappingLowThreshold) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *AddressPortMappingLowThreshold) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *AddressPortMappingLowThreshold) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// AddressPortMappingPerUserHighThreshold is addressPortMappingPerUserHighThreshold (480), unsigned32 identifier.
+type AddressPortMappingPerUserHighThreshold struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *AddressPortMappingPerUserHighThreshold) ElementID() uint16 {
+	return IEID_ADDRESS_POOL_MAPPING_PER_USER_HIGH_THRESHOLD
+}
+
+func (fv *AddressPortMappingPerUserHighThreshold) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *AddressPortMappingPerUserHighThreshold) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *AddressPortMappingPerUserHighThreshold) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *AddressPortMappingPerUserHighThreshold) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *AddressPortMappingPerUserHighThreshold) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// GlobalAddressMappingHighThreshold is globalAddressMappingHighThreshold (481), unsigned32 identifier.
+type GlobalAddressMappingHighThreshold struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *GlobalAddressMappingHighThreshold) ElementID() uint16 {
+	return IEID_GLOBAL_ADDRESS_MAPPING_HIGH_THRESHOLD
+}
+
+func (fv *GlobalAddressMappingHighThreshold) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *GlobalAddressMappingHighThreshold) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *GlobalAddressMappingHighThreshold) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *GlobalAddressMappingHighThreshold) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *GlobalAddressMappingHighThreshold) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// VpnIdentifier is vpnIdentifier (482), octetArray.
+type VpnIdentifier struct {
+	Val []uint8
+}
+
+func (fv *VpnIdentifier) ElementID() uint16 {
+	return IEID_VPN_IDENTIFIER
+}
+
+func (fv *VpnIdentifier) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *VpnIdentifier) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *VpnIdentifier) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *VpnIdentifier) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *VpnIdentifier) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpCommunity is bgpCommunity (483), unsigned32 identifier.
+type BgpCommunity struct {
+	Val uint32
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for 4-octet encodings
+	Length uint16
+}
+
+func (fv *BgpCommunity) ElementID() uint16 {
+	return IEID_BGP_COMMUNITY
+}
+
+func (fv *BgpCommunity) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpCommunity) AppendTo(dst []uint8) []uint8 {
+	return appendUnsigned(dst, uint64(fv.Val), fv.Len())
+}
+
+func (fv *BgpCommunity) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, DATA_TYPE_UNSIGNED32); err != nil {
+		return err
+	}
+	fv.Val = uint32(decodeUnsigned(data))
+	fv.Length = reducedSize(data, DATA_TYPE_UNSIGNED32)
+	return nil
+}
+
+func (fv *BgpCommunity) Len() uint16 {
+	return DATA_TYPE_UNSIGNED32.reducedLength(fv.Length)
+}
+
+func (fv *BgpCommunity) FieldSpecifier() *FieldSpecifier {
+	templateLen := fv.Len()
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpSourceCommunityList is bgpSourceCommunityList (484), basicList list.
+type BgpSourceCommunityList struct {
+	Val BasicList
+}
+
+func (fv *BgpSourceCommunityList) ElementID() uint16 {
+	return IEID_BGP_SOURCE_COMMUNITY_LIST
+}
+
+func (fv *BgpSourceCommunityList) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpSourceCommunityList) AppendTo(dst []uint8) []uint8 {
+	return fv.Val.AppendTo(dst)
+}
+
+func (fv *BgpSourceCommunityList) DecodeFromBytes(data []uint8) error {
+	return fv.Val.DecodeFromBytes(data)
+}
+
+func (fv *BgpSourceCommunityList) Len() uint16 {
+	return fv.Val.Len()
+}
+
+func (fv *BgpSourceCommunityList) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpDestinationCommunityList is bgpDestinationCommunityList (485), basicList list.
+type BgpDestinationCommunityList struct {
+	Val BasicList
+}
+
+func (fv *BgpDestinationCommunityList) ElementID() uint16 {
+	return IEID_BGP_DESTINATION_COMMUNITY_LIST
+}
+
+func (fv *BgpDestinationCommunityList) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpDestinationCommunityList) AppendTo(dst []uint8) []uint8 {
+	return fv.Val.AppendTo(dst)
+}
+
+func (fv *BgpDestinationCommunityList) DecodeFromBytes(data []uint8) error {
+	return fv.Val.DecodeFromBytes(data)
+}
+
+func (fv *BgpDestinationCommunityList) Len() uint16 {
+	return fv.Val.Len()
+}
+
+func (fv *BgpDestinationCommunityList) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpExtendedCommunity is bgpExtendedCommunity (486), octetArray.
+type BgpExtendedCommunity struct {
+	Val []uint8
+}
+
+func (fv *BgpExtendedCommunity) ElementID() uint16 {
+	return IEID_BGP_EXTENDED_COMMUNITY
+}
+
+func (fv *BgpExtendedCommunity) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpExtendedCommunity) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *BgpExtendedCommunity) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *BgpExtendedCommunity) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *BgpExtendedCommunity) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpSourceExtendedCommunityList is bgpSourceExtendedCommunityList (487), basicList list.
+type BgpSourceExtendedCommunityList struct {
+	Val BasicList
+}
+
+func (fv *BgpSourceExtendedCommunityList) ElementID() uint16 {
+	return IEID_BGP_SOURCE_EXTENDED_COMMUNITY_LIST
+}
+
+func (fv *BgpSourceExtendedCommunityList) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpSourceExtendedCommunityList) AppendTo(dst []uint8) []uint8 {
+	return fv.Val.AppendTo(dst)
+}
+
+func (fv *BgpSourceExtendedCommunityList) DecodeFromBytes(data []uint8) error {
+	return fv.Val.DecodeFromBytes(data)
+}
+
+func (fv *BgpSourceExtendedCommunityList) Len() uint16 {
+	return fv.Val.Len()
+}
+
+func (fv *BgpSourceExtendedCommunityList) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpDestinationExtendedCommunityList is bgpDestinationExtendedCommunityList (488), basicList list.
+type BgpDestinationExtendedCommunityList struct {
+	Val BasicList
+}
+
+func (fv *BgpDestinationExtendedCommunityList) ElementID() uint16 {
+	return IEID_BGP_DESTINATION_EXTENDED_COMMUNITY_LIST
+}
+
+func (fv *BgpDestinationExtendedCommunityList) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpDestinationExtendedCommunityList) AppendTo(dst []uint8) []uint8 {
+	return fv.Val.AppendTo(dst)
+}
+
+func (fv *BgpDestinationExtendedCommunityList) DecodeFromBytes(data []uint8) error {
+	return fv.Val.DecodeFromBytes(data)
+}
+
+func (fv *BgpDestinationExtendedCommunityList) Len() uint16 {
+	return fv.Val.Len()
+}
+
+func (fv *BgpDestinationExtendedCommunityList) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpLargeCommunity is bgpLargeCommunity (489), octetArray.
+type BgpLargeCommunity struct {
+	Val []uint8
+}
+
+func (fv *BgpLargeCommunity) ElementID() uint16 {
+	return IEID_BGP_LARGE_COMMUNITY
+}
+
+func (fv *BgpLargeCommunity) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpLargeCommunity) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *BgpLargeCommunity) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *BgpLargeCommunity) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *BgpLargeCommunity) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpSourceLargeCommunityList is bgpSourceLargeCommunityList (490), basicList list.
+type BgpSourceLargeCommunityList struct {
+	Val BasicList
+}
+
+func (fv *BgpSourceLargeCommunityList) ElementID() uint16 {
+	return IEID_BGP_SOURCE_LARGE_COMMUNITY_LIST
+}
+
+func (fv *BgpSourceLargeCommunityList) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpSourceLargeCommunityList) AppendTo(dst []uint8) []uint8 {
+	return fv.Val.AppendTo(dst)
+}
+
+func (fv *BgpSourceLargeCommunityList) DecodeFromBytes(data []uint8) error {
+	return fv.Val.DecodeFromBytes(data)
+}
+
+func (fv *BgpSourceLargeCommunityList) Len() uint16 {
+	return fv.Val.Len()
+}
+
+func (fv *BgpSourceLargeCommunityList) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// BgpDestinationLargeCommunityList is bgpDestinationLargeCommunityList (491), basicList list.
+type BgpDestinationLargeCommunityList struct {
+	Val BasicList
+}
+
+func (fv *BgpDestinationLargeCommunityList) ElementID() uint16 {
+	return IEID_BGP_DESTINATION_LARGE_COMMUNITY_LIST
+}
+
+func (fv *BgpDestinationLargeCommunityList) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BgpDestinationLargeCommunityList) AppendTo(dst []uint8) []uint8 {
+	return fv.Val.AppendTo(dst)
+}
+
+func (fv *BgpDestinationLargeCommunityList) DecodeFromBytes(data []uint8) error {
+	return fv.Val.DecodeFromBytes(data)
+}
+
+func (fv *BgpDestinationLargeCommunityList) Len() uint16 {
+	return fv.Val.Len()
+}
+
+func (fv *BgpDestinationLargeCommunityList) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+// SrhIPv6Section is srhIPv6Section (499), octetArray.
+type SrhIPv6Section struct {
+	Val []uint8
+}
+
+func (fv *SrhIPv6Section) ElementID() uint16 {
+	return IEID_SRH_IPV6_SECTION
+}
+
+func (fv *SrhIPv6Section) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SrhIPv6Section) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *SrhIPv6Section) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = value
+	return nil
+}
+
+func (fv *SrhIPv6Section) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+
+func (fv *SrhIPv6Section) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+
+var ianaFieldValueTypes = map[uint16]func() FieldValue{
+	IEID_OCTET_DELTA_COUNT:                            func() FieldValue { return &OctetDeltaCount{} },
+	IEID_DELTA_FLOW_COUNT:                             func() FieldValue { return &DeltaFlowCount{} },
+	IEID_PROTOCOL_IDENTIFIER:                          func() FieldValue { return &ProtocolIdentifier{} },
+	IEID_IP_CLASS_OF_SERVICE:                          func() FieldValue { return &IpClassOfService{} },
+	IEID_TCP_CONTROL_BITS:                             func() FieldValue { return &TcpControlBits{} },
+	IEID_SOURCE_TRANSPORT_PORT:                        func() FieldValue { return &SourceTransportPort{} },
+	IEID_SOURCE_IPV4_ADDRESS:                          func() FieldValue { return &SourceIPv4Address{} },
+	IEID_SOURCE_IPV4_PREFIX_LENGTH:                    func() FieldValue { return &SourceIPv4PrefixLength{} },
+	IEID_INGRESS_INTERFACE:                            func() FieldValue { return &IngressInterface{} },
+	IEID_DESTINATION_TRANSPORT_PORT:                   func() FieldValue { return &DestinationTransportPort{} },
+	IEID_DESTINATION_IPV4_ADDRESS:                     func() FieldValue { return &DestinationIPv4Address{} },
+	IEID_DEATINATION_IPV4_PREFIX_LENGTH:               func() FieldValue { return &DestinationIPv4PrefixLength{} },
+	IEID_EGRESS_INTERFACE:                             func() FieldValue { return &EgressInterface{} },
+	IEID_IP_NEXT_HOP_IPV4_ADDRESS:                     func() FieldValue { return &IpNextHopIPv4Address{} },
+	IEID_BGP_SOURCE_AS_NUMBER:                         func() FieldValue { return &BgpSourceAsNumber{} },
+	IEID_BGP_DESTINATION_AS_NUMBER:                    func() FieldValue { return &BgpDestinationAsNumber{} },
+	IEID_BGP_NEXT_HOP_IPV4_ADDRESS:                    func() FieldValue { return &BgpNextHopIPv4Address{} },
+	IEID_POST_MCAST_PACKET_DELTA_COUNT:                func() FieldValue { return &PostMCastPacketDeltaCount{} },
+	IEID_POST_MCAST_OCTET_DELTA_COUNT:                 func() FieldValue { return &PostMCastOctetDeltaCount{} },
+	IEID_FLOW_END_SYS_UP_TIME:                         func() FieldValue { return &FlowEndSysUpTime{} },
+	IEID_FLOW_START_SYS_UP_TIME:                       func() FieldValue { return &FlowStartSysUpTime{} },
+	IEID_POST_OCTET_DELTA_COUNT:                       func() FieldValue { return &PostOctetDeltaCount{} },
+	IEID_POST_PACKET_DELTA_COUNT:                      func() FieldValue { return &PostPacketDeltaCount{} },
+	IEID_MINIMUM_IP_TOTAL_LENGTH:                      func() FieldValue { return &MinimumIpTotalLength{} },
+	IEID_MAXIMUM_IP_TOTAL_LENGTH:                      func() FieldValue { return &MaximumIpTotalLength{} },
+	IEID_SOURCE_IPV6_ADDRESS:                          func() FieldValue { return &SourceIPv6Address{} },
+	IEID_DESTINATION_IPV6_ADDRESS:                     func() FieldValue { return &DestinationIPv6Address{} },
+	IEID_SOURCE_IPV6_PREFIX_LENGTH:                    func() FieldValue { return &SourceIPv6PrefixLength{} },
+	IEID_DESTINATION_IPV6_PREFIX_LENGTH:               func() FieldValue { return &DestinationIPv6PrefixLength{} },
+	IEID_FLOW_LABEL_IPV6:                              func() FieldValue { return &FlowLabelIPv6{} },
+	IEID_ICMP_TYPE_CODE_IPV4:                          func() FieldValue { return &IcmpTypeCodeIPv4{} },
+	IEID_IGMP_TYPE:                                    func() FieldValue { return &IgmpType{} },
+	IEID_SAMPLING_INTERVAL:                            func() FieldValue { return &SamplingInterval{} },
+	IEID_SAMPLING_ALGORITHM:                           func() FieldValue { return &SamplingAlgorithm{} },
+	IEID_FLOW_ACTIVE_TIMEOUT:                          func() FieldValue { return &FlowActiveTimeout{} },
+	IEID_FLOW_INACTIVE_TIMEOUT:                        func() FieldValue { return &FlowIdleTimeout{} },
+	IEID_ENGINE_TYPE:                                  func() FieldValue { return &EngineType{} },
+	IEID_ENGINE_ID:                                    func() FieldValue { return &EngineId{} },
+	IEID_IPV4_ROUTER_SC:                               func() FieldValue { return &Ipv4RouterSc{} },
+	IEID_SOURCE_IPV4_PREFIX:                           func() FieldValue { return &SourceIPv4Prefix{} },
+	IEID_DESTINATION_IPV4_PREFIX:                      func() FieldValue { return &DestinationIPv4Prefix{} },
+	IEID_MPLS_TOP_LABEL_TYPE:                          func() FieldValue { return &MplsTopLabelType{} },
+	IEID_MPLS_TOP_LABEL_IPV4_ADDRESS:                  func() FieldValue { return &MplsTopLabelIPv4Address{} },
+	IEID_SAMPLER_ID:                                   func() FieldValue { return &SamplerId{} },
+	IEID_SAMPLER_MODE:                                 func() FieldValue { return &SamplerMode{} },
+	IEID_SAMPLER_RANDOM_INTERVAL:                      func() FieldValue { return &SamplerRandomInterval{} },
+	IEID_CLASS_ID:                                     func() FieldValue { return &ClassId{} },
+	IEID_MINIMUM_TTL:                                  func() FieldValue { return &MinimumTTL{} },
+	IEID_MAXIMUM_TTL:                                  func() FieldValue { return &MaximumTTL{} },
+	IEID_FRAGMENT_IDENTIFICATION:                      func() FieldValue { return &FragmentIdentification{} },
+	IEID_POST_IP_CLASS_OF_SERVICE:                     func() FieldValue { return &PostIpClassOfService{} },
+	IEID_SOURCE_MAC_ADDRESS:                           func() FieldValue { return &SourceMacAddress{} },
+	IEID_POST_DESTINATION_MAC_ADDRESS:                 func() FieldValue { return &PostDestinationMacAddress{} },
+	IEID_VLAN_ID:                                      func() FieldValue { return &VlanId{} },
+	IEID_POST_VLAN_ID:                                 func() FieldValue { return &PostVlanId{} },
+	IEID_IP_VERSION:                                   func() FieldValue { return &IpVersion{} },
+	IEID_FLOW_DIRECTION:                               func() FieldValue { return &FlowDirection{} },
+	IEID_IP_NEXT_HOP_IPV6_ADDRESS:                     func() FieldValue { return &IpNextHopIPv6Address{} },
+	IEID_BGP_NEXT_HOP_IPV6_ADDRESS:                    func() FieldValue { return &BgpNextHopIPv6Address{} },
+	IEID_IPV6_EXTENSION_HEADERS:                       func() FieldValue { return &Ipv6ExtensionHeaders{} },
+	IEID_MPLS_TOP_LABEL_STACK_SECTION:                 func() FieldValue { return &MplsTopLabelStackSection{} },
+	IEID_MPLS_LABEL_STACK_SECTION2:                    func() FieldValue { return &MplsLabelStackSection2{} },
+	IEID_MPLS_LABEL_STACK_SECTION3:                    func() FieldValue { return &MplsLabelStackSection3{} },
+	IEID_MPLS_LABEL_STACK_SECTION4:                    func() FieldValue { return &MplsLabelStackSection4{} },
+	IEID_MPLS_LABEL_STACK_SECTION5:                    func() FieldValue { return &MplsLabelStackSection5{} },
+	IEID_MPLS_LABEL_STACK_SECTION6:                    func() FieldValue { return &MplsLabelStackSection6{} },
+	IEID_MPLS_LABEL_STACK_SECTION7:                    func() FieldValue { return &MplsLabelStackSection7{} },
+	IEID_MPLS_LABEL_STACK_SECTION8:                    func() FieldValue { return &MplsLabelStackSection8{} },
+	IEID_MPLS_LABEL_STACK_SECTION9:                    func() FieldValue { return &MplsLabelStackSection9{} },
+	IEID_MPLS_LABEL_STACK_SECTION10:                   func() FieldValue { return &MplsLabelStackSection10{} },
+	IEID_DESTINATION_MAC_ADDRESS:                      func() FieldValue { return &DestinationMacAddress{} },
+	IEID_POST_SOURCE_MAC_ADDRESS:                      func() FieldValue { return &PostSourceMacAddress{} },
+	IEID_INTERFACE_NAME:                               func() FieldValue { return &InterfaceName{} },
+	IEID_INTERFACE_DESCRIPTION:                        func() FieldValue { return &InterfaceDescription{} },
+	IEID_SAMPLER_NAME:                                 func() FieldValue { return &SamplerName{} },
+	IEID_OCTET_TOTAL_COUNT:                            func() FieldValue { return &OctetTotalCount{} },
+	IEID_FLAGS_AND_SAMPLING_ID:                        func() FieldValue { return &FlagsAndSamplerId{} },
+	IEID_FRAGMENT_OFFSET:                              func() FieldValue { return &FragmentOffset{} },
+	IEID_FOREARDING_STATUS:                            func() FieldValue { return &ForwardingStatus{} },
+	IEID_MPLS_VPN_ROUTE_DISTINGUISHER:                 func() FieldValue { return &MplsVpnRouteDistinguisher{} },
+	IEID_MPLS_TOP_LABEL_PREFIX_LENGTH:                 func() FieldValue { return &MplsTopLabelPrefixLength{} },
+	IEID_SRC_TRAFFIC_INDEX:                            func() FieldValue { return &SrcTrafficIndex{} },
+	IEID_DST_TRAFFIC_INDEX:                            func() FieldValue { return &DstTrafficIndex{} },
+	IEID_APPLICATION_DESCRIPTION:                      func() FieldValue { return &ApplicationDescription{} },
+	IEID_APPLICATION_ID:                               func() FieldValue { return &ApplicationId{} },
+	IEID_APPLICATION_NAME:                             func() FieldValue { return &ApplicationName{} },
+	IEID_POST_IP_DIFF_SERV_CODE_POINT:                 func() FieldValue { return &PostIpDiffServCodePoint{} },
+	IEID_MULTICAST_REPLICATION_FACTOR:                 func() FieldValue { return &MulticastReplicationFactor{} },
+	IEID_CLASS_NAME:                                   func() FieldValue { return &ClassName{} },
+	IEID_CLASSIFICATION_ENGINE_ID:                     func() FieldValue { return &ClassificationEngineId{} },
+	IEID_LAYER2_PACKET_SECTION_OFFSET:                 func() FieldValue { return &Layer2packetSectionOffset{} },
+	IEID_LAYER2_PACKET_SECTION_SIZE:                   func() FieldValue { return &Layer2packetSectionSize{} },
+	IEID_LAYER2_PACKET_SECTION_DATA:                   func() FieldValue { return &Layer2packetSectionData{} },
+	IEID_BGP_NEXT_ADJACENT_AS_NUMBER:                  func() FieldValue { return &BgpNextAdjacentAsNumber{} },
+	IEID_BGP_PREV_ADJACENT_AS_NUMBER:                  func() FieldValue { return &BgpPrevAdjacentAsNumber{} },
+	IEID_EXPORTER_IPV4_ADDRESS:                        func() FieldValue { return &ExporterIPv4Address{} },
+	IEID_EXPORTER_IPV6_ADDRESS:                        func() FieldValue { return &ExporterIPv6Address{} },
+	IEID_DROPPED_OCTET_DELTA_COUNT:                    func() FieldValue { return &DroppedOctetDeltaCount{} },
+	IEID_DROPPED_PACKET_DELTA_COUNT:                   func() FieldValue { return &DroppedPacketDeltaCount{} },
+	IEID_DROPPED_OCTET_TOTAL_COUNT:                    func() FieldValue { return &DroppedOctetTotalCount{} },
+	IEID_DROPPED_PACKET_TOTAL_COUNT:                   func() FieldValue { return &DroppedPacketTotalCount{} },
+	IEID_FLOW_END_REASON:                              func() FieldValue { return &FlowEndReason{} },
+	IEID_COMMON_PROPERTIES_ID:                         func() FieldValue { return &CommonPropertiesId{} },
+	IEID_OBSERVATION_POINT_ID:                         func() FieldValue { return &ObservationPointId{} },
+	IEID_ICMP_TYPE_CODE_IPV6:                          func() FieldValue { return &IcmpTypeCodeIPv6{} },
+	IEID_MPLS_TOP_LABEL_IPV6_ADDRESS:                  func() FieldValue { return &MplsTopLabelIPv6Address{} },
+	IEID_LINE_CARD_ID:                                 func() FieldValue { return &LineCardId{} },
+	IEID_PORT_ID:                                      func() FieldValue { return &PortId{} },
+	IEID_METERING_PROCESS_ID:                          func() FieldValue { return &MeteringProcessId{} },
+	IEID_EXPORTING_PROCESS_ID:                         func() FieldValue { return &ExportingProcessId{} },
+	IEID_TEMPLATE_ID:                                  func() FieldValue { return &TemplateId{} },
+	IEID_WLAN_CHANNEL_ID:                              func() FieldValue { return &WlanChannelId{} },
+	IEID_WLAN_SSID:                                    func() FieldValue { return &WlanSSID{} },
+	IEID_FLOW_ID:                                      func() FieldValue { return &FlowId{} },
+	IEID_FLOW_START_SECONDS:                           func() FieldValue { return &FlowStartSeconds{} },
+	IEID_FLOW_END_SECONDS:                             func() FieldValue { return &FlowEndSeconds{} },
+	IEID_FLOW_START_MICROSECONDS:                      func() FieldValue { return &FlowStartMicroseconds{} },
+	IEID_FLOW_END_MICROSECONDS:                        func() FieldValue { return &FlowEndMicroseconds{} },
+	IEID_FLOW_START_NANOSECONDS:                       func() FieldValue { return &FlowStartNanoseconds{} },
+	IEID_FLOW_END_NANOSECONDS:                         func() FieldValue { return &FlowEndNanoseconds{} },
+	IEID_FLOW_START_DELTA_MICROSECONDS:                func() FieldValue { return &FlowStartDeltaMicroseconds{} },
+	IEID_FLOW_END_DELTA_MICROSECONDS:                  func() FieldValue { return &FlowEndDeltaMicroseconds{} },
+	IEID_SYSTEM_INIT_TIME_MILLISECONDS:                func() FieldValue { return &SystemInitTimeMilliseconds{} },
+	IEID_FLOW_DURATION_MILLISECONDS:                   func() FieldValue { return &FlowDurationMilliseconds{} },
+	IEID_FLOW_DURATION_MICROSECONDS:                   func() FieldValue { return &FlowDurationMicroseconds{} },
+	IEID_OBSERVED_FLOW_TOTAL_COUNT:                    func() FieldValue { return &ObservedFlowTotalCount{} },
+	IEID_IGNORED_OCTET_TOTAL_COUNT:                    func() FieldValue { return &IgnoredOctetTotalCount{} },
+	IEID_NOT_SENT_PACKET_TOTAL_COUNT:                  func() FieldValue { return &NotSentPacketTotalCount{} },
+	IEID_NOT_SENT_OCTET_TOTAL_COUNT:                   func() FieldValue { return &NotSentOctetTotalCount{} },
+	IEID_DESTINATION_IPV6_PREFIX:                      func() FieldValue { return &DestinationIPv6Prefix{} },
+	IEID_SOURCE_IPV6_PREFIX:                           func() FieldValue { return &SourceIPv6Prefix{} },
+	IEID_POST_OCTET_TOTAL_COUNT:                       func() FieldValue { return &PostOctetTotalCount{} },
+	IEID_POST_PACKET_TOTAL_COUNT:                      func() FieldValue { return &PostPacketTotalCount{} },
+	IEID_FLOW_KEY_INDICATOR:                           func() FieldValue { return &FlowKeyIndicator{} },
+	IEID_POST_MCAST_PACKET_TOTAL_COUNT:                func() FieldValue { return &PostMCastPacketTotalCount{} },
+	IEID_POST_MCAST_OCTET_TOTAL_COUNT:                 func() FieldValue { return &PostMCastOctetTotalCount{} },
+	IEID_ICMP_TYPE_IPV4:                               func() FieldValue { return &IcmpTypeIPv4{} },
+	IEID_ICMP_CODE_IPV4:                               func() FieldValue { return &IcmpCodeIPv4{} },
+	IEID_ICMP_TYPE_IPV6:                               func() FieldValue { return &IcmpTypeIPv6{} },
+	IEID_ICMP_CODE_IPV6:                               func() FieldValue { return &IcmpCodeIPv6{} },
+	IEID_UDP_SOURCE_PORT:                              func() FieldValue { return &UdpSourcePort{} },
+	IEID_UDP_DESTINATION_PORT:                         func() FieldValue { return &UdpDestinationPort{} },
+	IEID_TCP_SOURCE_PORT:                              func() FieldValue { return &TcpSourcePort{} },
+	IEID_TCP_DESTINATION_PORT:                         func() FieldValue { return &TcpDestinationPort{} },
+	IEID_TCP_SEQUENCE_NUMBER:                          func() FieldValue { return &TcpSequenceNumber{} },
+	IEID_TCP_ACKNOWLEDGEMENT_NUMBER:                   func() FieldValue { return &TcpAcknowledgementNumber{} },
+	IEID_TCP_WINDOW_SIZE:                              func() FieldValue { return &TcpWindowSize{} },
+	IEID_TCP_URGENT_POINTER:                           func() FieldValue { return &TcpUrgentPointer{} },
+	IEID_TCP_HEADER_LENGTH:                            func() FieldValue { return &TcpHeaderLength{} },
+	IEID_IP_HEADER_LENGTH:                             func() FieldValue { return &IpHeaderLength{} },
+	IEID_TOTAL_LENGTH_IPV4:                            func() FieldValue { return &TotalLengthIPv4{} },
+	IEID_PAYLOAD_LENGTH_IPV6:                          func() FieldValue { return &PayloadLengthIPv6{} },
+	IEID_IP_TTL:                                       func() FieldValue { return &IpTTL{} },
+	IEID_NEXT_HEADER_IPV6:                             func() FieldValue { return &NextHeaderIPv6{} },
+	IEID_MPLS_PAYLOAD_LENGTH:                          func() FieldValue { return &MplsPayloadLength{} },
+	IEID_IP_DIFF_SERV_CODE_POINT:                      func() FieldValue { return &IpDiffServCodePoint{} },
+	IEID_IP_PRECEDENCE:                                func() FieldValue { return &IpPrecedence{} },
+	IEID_FRAGMENT_FLAGS:                               func() FieldValue { return &FragmentFlags{} },
+	IEID_OCTET_DELTA_SUM_OF_SQUARES:                   func() FieldValue { return &OctetDeltaSumOfSquares{} },
+	IEID_OCTET_TOTAL_SUM_OF_SQUARES:                   func() FieldValue { return &OctetTotalSumOfSquares{} },
+	IEID_MPLS_TOP_LABEL_TTL:                           func() FieldValue { return &MplsTopLabelTTL{} },
+	IEID_MPLS_LABEL_STACK_LENGTH:                      func() FieldValue { return &MplsLabelStackLength{} },
+	IEID_MPLS_LABEL_STACK_DEPTH:                       func() FieldValue { return &MplsLabelStackDepth{} },
+	IEID_MPLS_TOP_LABEL_EXP:                           func() FieldValue { return &MplsTopLabelExp{} },
+	IEID_IP_PAYLOAD_LENGTH:                            func() FieldValue { return &IpPayloadLength{} },
+	IEID_UDP_MESSAGE_LENGTH:                           func() FieldValue { return &UdpMessageLength{} },
+	IEID_IS_MULTICAST:                                 func() FieldValue { return &IsMulticast{} },
+	IEID_IPV4_IHL:                                     func() FieldValue { return &Ipv4IHL{} },
+	IEID_IPV4_OPTIONS:                                 func() FieldValue { return &Ipv4Options{} },
+	IEID_TCP_OPTIONS:                                  func() FieldValue { return &TcpOptions{} },
+	IEID_PADDING_OCTETS:                               func() FieldValue { return &PaddingOctets{} },
+	IEID_COLLECTOR_IPV4_ADDRESS:                       func() FieldValue { return &CollectorIPv4Address{} },
+	IEID_COLLECTOR_IPV6_ADDRESS:                       func() FieldValue { return &CollectorIPv6Address{} },
+	IEID_EXPORT_INTERFACE:                             func() FieldValue { return &ExportInterface{} },
+	IEID_EXPORT_PROTOCOL_VERSION:                      func() FieldValue { return &ExportProtocolVersion{} },
+	IEID_EXPORT_TRANSPORT_PROTOCOL:                    func() FieldValue { return &ExportTransportProtocol{} },
+	IEID_COLLECTOR_TRANSPORT_PORT:                     func() FieldValue { return &CollectorTransportPort{} },
+	IEID_EXPORTER_TRANSPORT_PORT:                      func() FieldValue { return &ExporterTransportPort{} },
+	IEID_TCP_SYN_TOTAL_COUNT:                          func() FieldValue { return &TcpSynTotalCount{} },
+	IEID_TCP_FIN_TOTAL_COUNT:                          func() FieldValue { return &TcpFinTotalCount{} },
+	IEID_TCP_RST_TOTAL_COUNT:                          func() FieldValue { return &TcpRstTotalCount{} },
+	IEID_TCP_PSH_TOTAL_COUNT:                          func() FieldValue { return &TcpPshTotalCount{} },
+	IEID_TCP_ACK_TOTAL_COUNT:                          func() FieldValue { return &TcpAckTotalCount{} },
+	IEID_TCP_URG_TOTAL_COUNT:                          func() FieldValue { return &TcpUrgTotalCount{} },
+	IEID_IP_TOTAL_LENGTH:                              func() FieldValue { return &IpTotalLength{} },
+	IEID_POST_NAT_SOURCE_IPV4_ADDRESS:                 func() FieldValue { return &PostNATSourceIPv4Address{} },
+	IEID_POST_NAT_DESTINATION_IPV4_ADDRESS:            func() FieldValue { return &PostNATDestinationIPv4Address{} },
+	IEID_POST_NAPT_SOURCE_TRANSPORT_PORT:              func() FieldValue { return &PostNAPTSourceTransportPort{} },
+	IEID_POST_NAPT_DESTINATION_TRANSPORT_PORT:         func() FieldValue { return &PostNAPTDestinationTransportPort{} },
+	IEID_NAT_ORIGINATING_ADDRESS_REALM:                func() FieldValue { return &NatOriginatingAddressRealm{} },
+	IEID_NAT_EVENT:                                    func() FieldValue { return &NatEvent{} },
+	IEID_INITIATOR_OCTETS:                             func() FieldValue { return &InitiatorOctets{} },
+	IEID_RESPONDER_OCTETS:                             func() FieldValue { return &ResponderOctets{} },
+	IEID_FIREWALL_EVENT:                               func() FieldValue { return &FirewallEvent{} },
+	IEID_INGRESS_VRFID:                                func() FieldValue { return &IngressVRFID{} },
+	IEID_EGRESS_VRFID:                                 func() FieldValue { return &EgressVRFID{} },
+	IEID_VRF_NAME:                                     func() FieldValue { return &VRFname{} },
+	IEID_POST_MPLS_TOP_LABEL_EXP:                      func() FieldValue { return &PostMplsTopLabelExp{} },
+	IEID_TCP_WINDOW_SCALE:                             func() FieldValue { return &TcpWindowScale{} },
+	IEID_BIFLOW_DIRECTION:                             func() FieldValue { return &BiflowDirection{} },
+	IEID_ETHERNET_HEADER_LENGTH:                       func() FieldValue { return &EthernetHeaderLength{} },
+	IEID_ETHERNET_PAYLOAD_LENGTH:                      func() FieldValue { return &EthernetPayloadLength{} },
+	IEID_ETHERNET_TOTAL_LENGTH:                        func() FieldValue { return &EthernetTotalLength{} },
+	IEID_DOT1Q_VLAN_ID:                                func() FieldValue { return &Dot1qVlanId{} },
+	IEID_DOT1Q_PRIORITY:                               func() FieldValue { return &Dot1qPriority{} },
+	IEID_DOT1Q_CUSTOMER_VLAN_ID:                       func() FieldValue { return &Dot1qCustomerVlanId{} },
+	IEID_DOT1Q_CUSTOMER_PRIORITY:                      func() FieldValue { return &Dot1qCustomerPriority{} },
+	IEID_METRO_EVC_ID:                                 func() FieldValue { return &MetroEvcId{} },
+	IEID_METRO_EVC_TYPE:                               func() FieldValue { return &MetroEvcType{} },
+	IEID_PSEUDO_WIRE_ID:                               func() FieldValue { return &PseudoWireId{} },
+	IEID_PSEUDO_WIRE_TYPE:                             func() FieldValue { return &PseudoWireType{} },
+	IEID_PSEUDO_WIRE_CONTROL_WORD:                     func() FieldValue { return &PseudoWireControlWord{} },
+	IEID_INGRESS_PHYSICAL_INTERFACE:                   func() FieldValue { return &IngressPhysicalInterface{} },
+	IEID_EGRESS_PHYSICAL_INTERFACE:                    func() FieldValue { return &EgressPhysicalInterface{} },
+	IEID_POST_DOT1Q_VLAN_ID:                           func() FieldValue { return &PostDot1qVlanId{} },
+	IEID_POST_DOT1Q_CUSTOMER_VLAN_ID:                  func() FieldValue { return &PostDot1qCustomerVlanId{} },
+	IEID_ETHERNET_TYPE:                                func() FieldValue { return &EthernetType{} },
+	IEID_POST_IP_PRECEDENCE:                           func() FieldValue { return &PostIpPrecedence{} },
+	IEID_COLLECTION_TIME_MILLISECONDS:                 func() FieldValue { return &CollectionTimeMilliseconds{} },
+	IEID_EXPORT_SCTP_STREAM_ID:                        func() FieldValue { return &ExportSctpStreamId{} },
+	IEID_MAX_EXPORT_SECONDS:                           func() FieldValue { return &MaxExportSeconds{} },
+	IEID_MAX_FLOW_END_SECONDS:                         func() FieldValue { return &MaxFlowEndSeconds{} },
+	IEID_MESSAGE_MD5_CHECKSUM:                         func() FieldValue { return &MessageMD5Checksum{} },
+	IEID_MESSAGE_SCOPE:                                func() FieldValue { return &MessageScope{} },
+	IEID_MIN_EXPORT_SECONDS:                           func() FieldValue { return &MinExportSeconds{} },
+	IEID_MIN_FLOW_START_SECONDS:                       func() FieldValue { return &MinFlowStartSeconds{} },
+	IEID_OPAQUE_OCTETS:                                func() FieldValue { return &OpaqueOctets{} },
+	IEID_SESSION_SCOPE:                                func() FieldValue { return &SessionScope{} },
+	IEID_MAX_FLOW_END_MICROSECONDS:                    func() FieldValue { return &MaxFlowEndMicroseconds{} },
+	IEID_MAX_FLOW_END_MILLISECONDS:                    func() FieldValue { return &MaxFlowEndMilliseconds{} },
+	IEID_MAX_FLOW_END_NANOSECONDS:                     func() FieldValue { return &MaxFlowEndNanoseconds{} },
+	IEID_MIN_FLOW_START_MICROSECONDS:                  func() FieldValue { return &MinFlowStartMicroseconds{} },
+	IEID_MIN_FLOW_START_MILLISECONDS:                  func() FieldValue { return &MinFlowStartMilliseconds{} },
+	IEID_MIN_FLOW_START_NANOSECONDS:                   func() FieldValue { return &MinFlowStartNanoseconds{} },
+	IEID_COLLECTOR_CERTIFICATE:                        func() FieldValue { return &CollectorCertificate{} },
+	IEID_EXPORTER_CERTIFICATE:                         func() FieldValue { return &ExporterCertificate{} },
+	IEID_DATA_RECORDS_RELIABILITY:                     func() FieldValue { return &DataRecordsReliability{} },
+	IEID_OBSERVATION_POINT_TYPE:                       func() FieldValue { return &ObservationPointType{} },
+	IEID_NEW_CONNECTION_DELTA_COUNT:                   func() FieldValue { return &NewConnectionDeltaCount{} },
+	IEID_CONNECTION_SUM_DURATION_SECONDS:              func() FieldValue { return &ConnectionSumDurationSeconds{} },
+	IEID_CONNECTION_TRANSACTION_ID:                    func() FieldValue { return &ConnectionTransactionId{} },
+	IEID_POST_NAT_SOURCE_IPV6_ADDRESS:                 func() FieldValue { return &PostNATSourceIPv6Address{} },
+	IEID_POST_NAT_DESTINATION_IPV6_ADDRESS:            func() FieldValue { return &PostNATDestinationIPv6Address{} },
+	IEID_NAT_POOL_ID:                                  func() FieldValue { return &NatPoolId{} },
+	IEID_NAT_POOL_NAME:                                func() FieldValue { return &NatPoolName{} },
+	IEID_ANONYMIZATION_FLAGS:                          func() FieldValue { return &AnonymizationFlags{} },
+	IEID_ANONYMIZATION_TECHNIQUE:                      func() FieldValue { return &AnonymizationTechnique{} },
+	IEID_INFORMATION_ELEMENT_INDEX:                    func() FieldValue { return &InformationElementIndex{} },
+	IEID_P2P_TECHNOLOGY:                               func() FieldValue { return &P2pTechnology{} },
+	IEID_TUNNEL_TECHNOLOGY:                            func() FieldValue { return &TunnelTechnology{} },
+	IEID_ENCRYPTED_TECHNOLOGY:                         func() FieldValue { return &EncryptedTechnology{} },
+	IEID_BGP_VALIDITY_STATE:                           func() FieldValue { return &BgpValidityState{} },
+	IEID_IPSEC_SPI:                                    func() FieldValue { return &IPSecSPI{} },
+	IEID_GRE_KEY:                                      func() FieldValue { return &GreKey{} },
+	IEID_NAT_TYPE:                                     func() FieldValue { return &NatType{} },
+	IEID_INITIATOR_PACKETS:                            func() FieldValue { return &InitiatorPackets{} },
+	IEID_RESPONDER_PACKETS:                            func() FieldValue { return &ResponderPackets{} },
+	IEID_OBSERVATION_DOMAIN_NAME:                      func() FieldValue { return &ObservationDomainName{} },
+	IEID_SELECTION_SEQUENCE_ID:                        func() FieldValue { return &SelectionSequenceId{} },
+	IEID_SELECTOR_ID:                                  func() FieldValue { return &SelectorId{} },
+	IEID_INFORMATION_ELEMENT_ID:                       func() FieldValue { return &InformationElementId{} },
+	IEID_SELECTOR_ALGORITHM:                           func() FieldValue { return &SelectorAlgorithm{} },
+	IEID_SAMPLING_PACKET_INTERVAL:                     func() FieldValue { return &SamplingPacketInterval{} },
+	IEID_SAMPLING_PACKET_SPACE:                        func() FieldValue { return &SamplingPacketSpace{} },
+	IEID_SAMPLING_TIME_INTERVAL:                       func() FieldValue { return &SamplingTimeInterval{} },
+	IEID_SAMPLING_TIME_SPACE:                          func() FieldValue { return &SamplingTimeSpace{} },
+	IEID_SAMPLING_SIZE:                                func() FieldValue { return &SamplingSize{} },
+	IEID_SAMPLING_POPULATION:                          func() FieldValue { return &SamplingPopulation{} },
+	IEID_SAMPLING_PROBABILITY:                         func() FieldValue { return &SamplingProbability{} },
+	IEID_DATA_LINK_FRAME_SIZE:                         func() FieldValue { return &DataLinkFrameSize{} },
+	IEID_IP_HEADER_PACKET_SECTION:                     func() FieldValue { return &IpHeaderPacketSection{} },
+	IEID_IP_PAYLOAD_PACKET_SECTION:                    func() FieldValue { return &IpPayloadPacketSection{} },
+	IEID_DATA_LINK_FRAME_SECTION:                      func() FieldValue { return &DataLinkFrameSection{} },
+	IEID_MPLS_LABEL_STACK_SECTION:                     func() FieldValue { return &MplsLabelStackSection{} },
+	IEID_MPLS_PAYLOAD_PACKET_SECTION:                  func() FieldValue { return &MplsPayloadPacketSection{} },
+	IEID_SELECTOR_ID_TOTAL_PKTS_OBSERVED:              func() FieldValue { return &SelectorIdTotalPktsObserved{} },
+	IEID_SELECTOR_ID_TOTAL_PKTS_SELECTED:              func() FieldValue { return &SelectorIdTotalPktsSelected{} },
+	IEID_ABSOLUTE_ERROR:                               func() FieldValue { return &AbsoluteError{} },
+	IEID_RELATIVE_ERROR:                               func() FieldValue { return &RelativeError{} },
+	IEID_OBSERVATION_TIME_SECONDS:                     func() FieldValue { return &ObservationTimeSeconds{} },
+	IEID_OBSERVATION_TIME_MILLI_SECONDS:               func() FieldValue { return &ObservationTimeMilliseconds{} },
+	IEID_OBSERVATION_TIME_MICRO_SECONDS:               func() FieldValue { return &ObservationTimeMicroseconds{} },
+	IEID_OBSERVATION_TIME_NANO_SECONDS:                func() FieldValue { return &ObservationTimeNanoseconds{} },
+	IEID_DIGEST_HASH_VALUE:                            func() FieldValue { return &DigestHashValue{} },
+	IEID_HASH_IP_PAYLOAD_OFFSET:                       func() FieldValue { return &HashIPPayloadOffset{} },
+	IEID_HASH_IP_PAYLOAD_SIZE:                         func() FieldValue { return &HashIPPayloadSize{} },
+	IEID_HASH_OUTPUT_RANGE_MIN:                        func() FieldValue { return &HashOutputRangeMin{} },
+	IEID_HASH_OUTPUT_RANGE_MAX:                        func() FieldValue { return &HashOutputRangeMax{} },
+	IEID_HASH_SELECTED_RANGE_MIN:                      func() FieldValue { return &HashSelectedRangeMin{} },
+	IEID_HASH_SELECTED_RANGE_MAX:                      func() FieldValue { return &HashSelectedRangeMax{} },
+	IEID_HASH_DIGEST_OUTPUT:                           func() FieldValue { return &HashDigestOutput{} },
+	IEID_HASH_INITIALISATION_VALUE:                    func() FieldValue { return &HashInitialiserValue{} },
+	IEID_SELECTOR_NAME:                                func() FieldValue { return &SelectorName{} },
+	IEID_UPPER_CI_LIMIT:                               func() FieldValue { return &UpperCILimit{} },
+	IEID_LOWER_CI_LIMIT:                               func() FieldValue { return &LowerCILimit{} },
+	IEID_CONFIDENCE_LEVEL:                             func() FieldValue { return &ConfidenceLevel{} },
+	IEID_INFORMATION_ELEMENT_DATA_TYPE:                func() FieldValue { return &InformationElementDataType{} },
+	IEID_INFORMATION_ELEMENT_DESCRIPTION:              func() FieldValue { return &InformationElementDescription{} },
+	IEID_INFORMATION_ELEMENT_NAME:                     func() FieldValue { return &InformationElementName{} },
+	IEID_INFORMATION_ELEMENT_RANGE_BEGIN:              func() FieldValue { return &InformationElementRangeBegin{} },
+	IEID_INFORMATION_ELEMENT_RANGE_END:                func() FieldValue { return &InformationElementRangeEnd{} },
+	IEID_INFORMATION_ELEMENT_SEMANTICS:                func() FieldValue { return &InformationElementSemantics{} },
+	IEID_INFORMATION_ELEMENT_UNITS:                    func() FieldValue { return &InformationElementUnits{} },
+	IEID_PRIVATE_ENTERPRISE_NUMBER:                    func() FieldValue { return &PrivateEnterpriseNumber{} },
+	IEID_VIRTUAL_STATION_INTERFACE_ID:                 func() FieldValue { return &VirtualStationInterfaceId{} },
+	IEID_VIRTUAL_STATION_INTERFACE_NAME:               func() FieldValue { return &VirtualStationInterfaceName{} },
+	IEID_VIRTUAL_STATION_UUID:                         func() FieldValue { return &VirtualStationUUID{} },
+	IEID_VIRTUAL_STATION_NAME:                         func() FieldValue { return &VirtualStationName{} },
+	IEID_LAYER2_SEGMENT_ID:                            func() FieldValue { return &Layer2SegmentId{} },
+	IEID_LAYER2_OCTET_DELTA_COUNT:                     func() FieldValue { return &Layer2OctetDeltaCount{} },
+	IEID_LAYER2_OUCET_TOTAL_COUNT:                     func() FieldValue { return &Layer2OctetTotalCount{} },
+	IEID_INGRESS_UNICAST_PACKET_TOTAL_COUNT:           func() FieldValue { return &IngressUnicastPacketTotalCount{} },
+	IEID_INGRESS_MULTICAST_PACKET_TOTAL_COUNT:         func() FieldValue { return &IngressMulticastPacketTotalCount{} },
+	IEID_INGRESS_BROADCAST_PACKET_TOTAL_COUNT:         func() FieldValue { return &IngressBroadcastPacketTotalCount{} },
+	IEID_EGRESS_UNICAST_PACKET_TOTAL_COUNT:            func() FieldValue { return &EgressUnicastPacketTotalCount{} },
+	IEID_EGRESS_BROADCAST_PACKET_TOTAL_COUNT:          func() FieldValue { return &EgressBroadcastPacketTotalCount{} },
+	IEID_MONITORING_INTERVAL_START_MILLI_SECOUNDS:     func() FieldValue { return &MonitoringIntervalStartMilliSeconds{} },
+	IEID_MONITORING_INTERVAL_END_MILLI_SECOUNDS:       func() FieldValue { return &MonitoringIntervalEndMilliSeconds{} },
+	IEID_PORT_RANGE_START:                             func() FieldValue { return &PortRangeStart{} },
+	IEID_PORT_RANGE_END:                               func() FieldValue { return &PortRangeEnd{} },
+	IEID_PORT_RANGE_STEP_SIZE:                         func() FieldValue { return &PortRangeStepSize{} },
+	IEID_PORT_RANGE_NUM_PORTS:                         func() FieldValue { return &PortRangeNumPorts{} },
+	IEID_STA_MAC_ADDRESS:                              func() FieldValue { return &StaMacAddress{} },
+	IEID_STA_IPV4_ADDRESS:                             func() FieldValue { return &StaIPv4Address{} },
+	IEID_WTP_MAC_ADDRESS:                              func() FieldValue { return &WtpMacAddress{} },
+	IEID_INGRESS_INTERFACE_TYPE:                       func() FieldValue { return &IngressInterfaceType{} },
+	IEID_EGRESS_INTERFACE_TYPE:                        func() FieldValue { return &EgressInterfaceType{} },
+	IEID_RTP_SEQUENCE_NUMBER:                          func() FieldValue { return &RtpSequenceNumber{} },
+	IEID_USER_NAME:                                    func() FieldValue { return &UserName{} },
+	IEID_APPLICATION_CATEGORY_NAME:                    func() FieldValue { return &ApplicationCategoryName{} },
+	IEID_APPLICATION_SUB_CATEGORY_NAME:                func() FieldValue { return &ApplicationSubCategoryName{} },
+	IEID_APPLICATION_GROUP_NAME:                       func() FieldValue { return &ApplicationGroupName{} },
+	IEID_ORIGINAL_FLOWS_PRESENT:                       func() FieldValue { return &OriginalFlowsPresent{} },
+	IEID_ORIGINAL_FLOWS_INITIATED:                     func() FieldValue { return &OriginalFlowsInitiated{} },
+	IEID_ORIGINAL_FLOWS_COMPLETED:                     func() FieldValue { return &OriginalFlowsCompleted{} },
+	IEID_DISTINCT_COUNT_OF_SOURCE_IP_ADDRESS:          func() FieldValue { return &DistinctCountOfSourceIPAddress{} },
+	IEID_DISTINCT_COUNT_OF_DESTINATION_IP_ADDRESS:     func() FieldValue { return &DistinctCountOfDestinationIPAddress{} },
+	IEID_DISTINCT_COUNT_OF_SOURCE_IPV4_ADDRESS:        func() FieldValue { return &DistinctCountOfSourceIPv4Address{} },
+	IEID_DISTINCT_COUNT_OF_DESTINATION_IPV4_ADDRESS:   func() FieldValue { return &DistinctCountOfDestinationIPv4Address{} },
+	IEID_DISTINCT_COUNT_OF_SOURCE_IPV6_ADDRESS:        func() FieldValue { return &DistinctCountOfSourceIPv6Address{} },
+	IEID_DISTINCT_COUNT_OF_DESTINATION_IPV6_ADDRESS:   func() FieldValue { return &DistinctCountOfDestinationIPv6Address{} },
+	IEID_VALUE_DISTRIBUTION_METHOD:                    func() FieldValue { return &ValueDistributionMethod{} },
+	IEID_RFC3550_JITTER_MILLISECONDS:                  func() FieldValue { return &Rfc3550JitterMilliseconds{} },
+	IEID_RFC3550_JITTER_MICROSECONDS:                  func() FieldValue { return &Rfc3550JitterMicroseconds{} },
+	IEID_RFC3550_JITTER_NANOSECONDS:                   func() FieldValue { return &Rfc3550JitterNanoseconds{} },
+	IEID_DOT1Q_DEI:                                    func() FieldValue { return &Dot1qDEI{} },
+	IEID_DOT1Q_CUSTOMER_DEI:                           func() FieldValue { return &Dot1qCustomerDEI{} },
+	IEID_FLOW_SELECTOR_ALGORITHM:                      func() FieldValue { return &FlowSelectorAlgorithm{} },
+	IEID_FLOW_SELECTED_OCTET_DELTA_COUNT:              func() FieldValue { return &FlowSelectedOctetDeltaCount{} },
+	IEID_FLOW_SELECTED_PACKET_DELTA_COUNT:             func() FieldValue { return &FlowSelectedPacketDeltaCount{} },
+	IEID_FLOW_SELECTED_FLOW_DELTA_COUNT:               func() FieldValue { return &FlowSelectedFlowDeltaCount{} },
+	IEID_SELECTOR_ID_TOTAL_FLOWS_OBSERVED:             func() FieldValue { return &SelectorIDTotalFlowsObserved{} },
+	IEID_SELECTOR_ID_TOTAL_FLOWS_SELECTED:             func() FieldValue { return &SelectorIDTotalFlowsSelected{} },
+	IEID_SAMPLING_FLOW_INTERVAL:                       func() FieldValue { return &SamplingFlowInterval{} },
+	IEID_SAMPLING_FLOW_SPACING:                        func() FieldValue { return &SamplingFlowSpacing{} },
+	IEID_FLOW_SAMPLING_TIME_INTERVAL:                  func() FieldValue { return &FlowSamplingTimeInterval{} },
+	IEID_FLOW_SAMPLING_TIME_SPACING:                   func() FieldValue { return &FlowSamplingTimeSpacing{} },
+	IEID_HASH_FLOW_DOMAIN:                             func() FieldValue { return &HashFlowDomain{} },
+	IEID_TRANSPORT_OCTET_DELTA_COUNT:                  func() FieldValue { return &TransportOctetDeltaCount{} },
+	IEID_TRANSPORT_PACKET_DELTA_COUNT:                 func() FieldValue { return &TransportPacketDeltaCount{} },
+	IEID_ORIGINAL_EXPORTER_IPV4_ADDRESS:               func() FieldValue { return &OriginalExporterIPv4Address{} },
+	IEID_ORIGINAL_EXPORTER_IPV6_ADDRESS:               func() FieldValue { return &OriginalExporterIPv6Address{} },
+	IEID_ORIGINAL_OBSERVATION_DOMAIN_ID:               func() FieldValue { return &OriginalObservationDomainId{} },
+	IEID_INTERMEDIATE_PROCESS_ID:                      func() FieldValue { return &IntermediateProcessId{} },
+	IEID_IGNORED_DATA_RECORD_TOTAL_COUNT:              func() FieldValue { return &IgnoredDataRecordTotalCount{} },
+	IEID_DATA_LINK_FRAME_TYPE:                         func() FieldValue { return &DataLinkFrameType{} },
+	IEID_SECTION_OFFSET:                               func() FieldValue { return &SectionOffset{} },
+	IEID_SECTION_EXPORTED_OCTETS:                      func() FieldValue { return &SectionExportedOctets{} },
+	IEID_DOT1Q_SERVICE_INSTANCE_TAG:                   func() FieldValue { return &Dot1qServiceInstanceTag{} },
+	IEID_DOT1Q_SERVICE_INSTANCE_ID:                    func() FieldValue { return &Dot1qServiceInstanceId{} },
+	IEID_DOT1Q_SERVICE_INSTANCE_PRIORITY:              func() FieldValue { return &Dot1qServiceInstancePriority{} },
+	IEID_DOT1Q_CUSTOMER_SOURCE_MAC_ADDRESS:            func() FieldValue { return &Dot1qCustomerSourceMacAddress{} },
+	IEID_DOT1Q_CUSTOMER_DESTINATION_MAC_ADDRESS:       func() FieldValue { return &Dot1qCustomerDestinationMacAddress{} },
+	IEID_POST_LAYER2_OCTET_DELTA_COUNT:                func() FieldValue { return &PostLayer2OctetDeltaCount{} },
+	IEID_POST_MCAST_LAYER2_OCTET_DELTA_COUNT:          func() FieldValue { return &PostMCastLayer2OctetDeltaCount{} },
+	IEID_POST_LAYER2_OCTET_TOTAL_COUNT:                func() FieldValue { return &PostLayer2OctetTotalCount{} },
+	IEID_POST_MCAST_LAYER2_OCTET_TOTAL_COUNT:          func() FieldValue { return &PostMCastLayer2OctetTotalCount{} },
+	IEID_MINIMUM_LAYER2_TOTAL_LENGTH:                  func() FieldValue { return &MinimumLayer2TotalLength{} },
+	IEID_MAXIMUM_LAYER2_TOTAL_LENGTH:                  func() FieldValue { return &MaximumLayer2TotalLength{} },
+	IEID_DROPPED_LAYER2_OCTET_DELTA_COUNT:             func() FieldValue { return &DroppedLayer2OctetDeltaCount{} },
+	IEID_DROPPED_LAYER2_OCTET_TOTAL_COUNT:             func() FieldValue { return &DroppedLayer2OctetTotalCount{} },
+	IEID_IGNORED_LAYER2_OCTET_TOTAL_COUNT:             func() FieldValue { return &IgnoredLayer2OctetTotalCount{} },
+	IEID_NOT_SENT_LAYER2_OCTET_TOTAL_COUNT:            func() FieldValue { return &NotSentLayer2OctetTotalCount{} },
+	IEID_LAYER2_OCTET_DELTA_SUM_OF_SQUARES:            func() FieldValue { return &Layer2OctetDeltaSumOfSquares{} },
+	429:                                               func() FieldValue { return &Layer2OctetTotalSumOfSquares{} },
+	IEID_LAYER2_FRAME_DELTA_COUNT:                     func() FieldValue { return &Layer2FrameDeltaCount{} },
+	IEID_LAYER2_FRAME_TOTAL_COUNT:                     func() FieldValue { return &Layer2FrameTotalCount{} },
+	IEID_PSEUDOWIRE_DESTINATION_IPV4_ADDRESS:          func() FieldValue { return &PseudoWireDestinationIPv4Address{} },
+	IEID_IGNORED_LAYER2_FRAME_TOTAL_COUNT:             func() FieldValue { return &IgnoredLayer2FrameTotalCount{} },
+	IEID_MIB_OBJECT_VALUE_INTEGER:                     func() FieldValue { return &MibObjectValueInteger{} },
+	IEID_MIB_OBJECT_VALUE_OCTET_STRING:                func() FieldValue { return &MibObjectValueOctetString{} },
+	IEID_MIB_OBJECT_VALUE_OID:                         func() FieldValue { return &MibObjectValueOID{} },
+	IEID_MIB_OBJECT_VALUE_BITS:                        func() FieldValue { return &MibObjectValueBits{} },
+	IEID_MIB_OBJECT_VALUE_IP_ADDRESS:                  func() FieldValue { return &MibObjectValueIPAddress{} },
+	IEID_MIB_OBJECT_VALUE_COUNTER:                     func() FieldValue { return &MibObjectValueCounter{} },
+	IEID_MIB_OBJECT_VALUE_GAUGE:                       func() FieldValue { return &MibObjectValueGauge{} },
+	IEID_MIB_OBJECT_VALUE_TIME_TICKS:                  func() FieldValue { return &MibObjectValueTimeTicks{} },
+	IEID_MIB_OBJECT_VALUE_UNSIGNED:                    func() FieldValue { return &MibObjectValueUnsigned{} },
+	IEID_MIB_OBJECT_VALUE_TABLE:                       func() FieldValue { return &MibObjectValueTable{} },
+	IEID_MIB_OBJECT_VALUE_ROW:                         func() FieldValue { return &MibObjectValueRow{} },
+	IEID_MIB_OBJECT_IDENTIFIER:                        func() FieldValue { return &MibObjectIdentifier{} },
+	IEID_MIB_SUB_IDENTIFIER:                           func() FieldValue { return &MibSubIdentifier{} },
+	IEID_MIB_INDEX_INDICATOR:                          func() FieldValue { return &MibIndexIndicator{} },
+	IEID_MIB_CAPTURE_TIME_SEMICOLONS:                  func() FieldValue { return &MibCaptureTimeSemantics{} },
+	IEID_MIB_CONTEXT_ENGINE_ID:                        func() FieldValue { return &MibContextEngineID{} },
+	IEID_MIB_CONTEXT_NAME:                             func() FieldValue { return &MibContextName{} },
+	IEID_MIB_OBJECT_NAME:                              func() FieldValue { return &MibObjectName{} },
+	IEID_MIB_OBJECT_DESCRIPTION:                       func() FieldValue { return &MibObjectDescription{} },
+	IEID_MIB_OBJECT_SYNTAX:                            func() FieldValue { return &MibObjectSyntax{} },
+	IEID_MIB_MODULE_NAME:                              func() FieldValue { return &MibModuleName{} },
+	IEID_MOBILE_IMSI:                                  func() FieldValue { return &MobileIMSI{} },
+	IEID_MOBILE_MSISDN:                                func() FieldValue { return &MobileMSISDN{} },
+	IEID_HTTP_STATUS_CODE:                             func() FieldValue { return &HttpStatusCode{} },
+	IEID_SOURCE_TRANSPORT_PORTS_LIMIT:                 func() FieldValue { return &SourceTransportPortsLimit{} },
+	IEID_HTTP_REQUEST_METHOD:                          func() FieldValue { return &HttpRequestMethod{} },
+	IEID_HTTP_REQUEST_HOST:                            func() FieldValue { return &HttpRequestHost{} },
+	IEID_HTTP_REQUEST_TARGET:                          func() FieldValue { return &HttpRequestTarget{} },
+	IEID_HTTP_MESSAGE_VERSION:                         func() FieldValue { return &HttpMessageVersion{} },
+	IEID_NAT_INSTANCE_ID:                              func() FieldValue { return &NatInstanceID{} },
+	IEID_INTERNAL_ADDRESS_REALM:                       func() FieldValue { return &InternalAddressRealm{} },
+	IEID_EXTERNAL_ADDRESS_REALM:                       func() FieldValue { return &ExternalAddressRealm{} },
+	IEID_NAT_QUOTA_EXCEEDED_EVENT:                     func() FieldValue { return &NatQuotaExceededEvent{} },
+	IEID_NAT_THRESHOLD_EVENT:                          func() FieldValue { return &NatThresholdEvent{} },
+	IEID_HTTP_USER_AGENT:                              func() FieldValue { return &HttpUserAgent{} },
+	IEID_HTTP_CONTENT_TYPE:                            func() FieldValue { return &HttpContentType{} },
+	IEID_HTTP_REASON_PHRASE:                           func() FieldValue { return &HttpReasonPhrase{} },
+	IEID_MAX_SESSION_ENTRIES:                          func() FieldValue { return &MaxSessionEntries{} },
+	IEID_MAX_BIB_ENTRIES:                              func() FieldValue { return &MaxBIBEntries{} },
+	IEID_MAX_ENTRIES_PER_USER:                         func() FieldValue { return &MaxEntriesPerUser{} },
+	IEID_MAX_SUBSCRIBERS:                              func() FieldValue { return &MaxSubscribers{} },
+	IEID_MAX_FRAGMENTS_PENDING_REASSEMBLY:             func() FieldValue { return &MaxFragmentsPendingReassembly{} },
+	IEID_ADDRESS_POOL_HIGH_THRESHOLD:                  func() FieldValue { return &AddressPoolHighThreshold{} },
+	IEID_ADDRESS_POOL_LOW_THRESHOLD:                   func() FieldValue { return &AddressPoolLowThreshold{} },
+	IEID_ADDRESS_POOL_MAPPING_HIGH_THRESHOLD:          func() FieldValue { return &AddressPortMappingHighThreshold{} },
+	IEID_ADDRESS_POOL_MAPPING_LOW_THRESHOLD:           func() FieldValue { return &AddressPortMappingLowThreshold{} },
+	IEID_ADDRESS_POOL_MAPPING_PER_USER_HIGH_THRESHOLD: func() FieldValue { return &AddressPortMappingPerUserHighThreshold{} },
+	IEID_GLOBAL_ADDRESS_MAPPING_HIGH_THRESHOLD:        func() FieldValue { return &GlobalAddressMappingHighThreshold{} },
+	IEID_VPN_IDENTIFIER:                               func() FieldValue { return &VpnIdentifier{} },
+	IEID_BGP_COMMUNITY:                                func() FieldValue { return &BgpCommunity{} },
+	IEID_BGP_SOURCE_COMMUNITY_LIST:                    func() FieldValue { return &BgpSourceCommunityList{} },
+	IEID_BGP_DESTINATION_COMMUNITY_LIST:               func() FieldValue { return &BgpDestinationCommunityList{} },
+	IEID_BGP_EXTENDED_COMMUNITY:                       func() FieldValue { return &BgpExtendedCommunity{} },
+	IEID_BGP_SOURCE_EXTENDED_COMMUNITY_LIST:           func() FieldValue { return &BgpSourceExtendedCommunityList{} },
+	IEID_BGP_DESTINATION_EXTENDED_COMMUNITY_LIST:      func() FieldValue { return &BgpDestinationExtendedCommunityList{} },
+	IEID_BGP_LARGE_COMMUNITY:                          func() FieldValue { return &BgpLargeCommunity{} },
+	IEID_BGP_SOURCE_LARGE_COMMUNITY_LIST:              func() FieldValue { return &BgpSourceLargeCommunityList{} },
+	IEID_BGP_DESTINATION_LARGE_COMMUNITY_LIST:         func() FieldValue { return &BgpDestinationLargeCommunityList{} },
+	IEID_SRH_IPV6_SECTION:                             func() FieldValue { return &SrhIPv6Section{} },
+}
+
+var ianaInformationElements = map[uint16]InformationElement{
+	IEID_OCTET_DELTA_COUNT:                            {Name: "octetDeltaCount", ElementID: IEID_OCTET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_PACKET_DELTA_COUNT:                           {Name: "packetDeltaCount", ElementID: IEID_PACKET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "packets", Length: 8},
+	IEID_DELTA_FLOW_COUNT:                             {Name: "deltaFlowCount", ElementID: IEID_DELTA_FLOW_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "flows", Length: 8},
+	IEID_PROTOCOL_IDENTIFIER:                          {Name: "protocolIdentifier", ElementID: IEID_PROTOCOL_IDENTIFIER, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_IP_CLASS_OF_SERVICE:                          {Name: "ipClassOfService", ElementID: IEID_IP_CLASS_OF_SERVICE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_TCP_CONTROL_BITS:                             {Name: "tcpControlBits", ElementID: IEID_TCP_CONTROL_BITS, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 2},
+	IEID_SOURCE_TRANSPORT_PORT:                        {Name: "sourceTransportPort", ElementID: IEID_SOURCE_TRANSPORT_PORT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_SOURCE_IPV4_ADDRESS:                          {Name: "sourceIPv4Address", ElementID: IEID_SOURCE_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_SOURCE_IPV4_PREFIX_LENGTH:                    {Name: "sourceIPv4PrefixLength", ElementID: IEID_SOURCE_IPV4_PREFIX_LENGTH, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "bits", Length: 1},
+	IEID_INGRESS_INTERFACE:                            {Name: "ingressInterface", ElementID: IEID_INGRESS_INTERFACE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_DESTINATION_TRANSPORT_PORT:                   {Name: "destinationTransportPort", ElementID: IEID_DESTINATION_TRANSPORT_PORT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_DESTINATION_IPV4_ADDRESS:                     {Name: "destinationIPv4Address", ElementID: IEID_DESTINATION_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_DEATINATION_IPV4_PREFIX_LENGTH:               {Name: "destinationIPv4PrefixLength", ElementID: IEID_DEATINATION_IPV4_PREFIX_LENGTH, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "bits", Length: 1},
+	IEID_EGRESS_INTERFACE:                             {Name: "egressInterface", ElementID: IEID_EGRESS_INTERFACE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_IP_NEXT_HOP_IPV4_ADDRESS:                     {Name: "ipNextHopIPv4Address", ElementID: IEID_IP_NEXT_HOP_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_BGP_SOURCE_AS_NUMBER:                         {Name: "bgpSourceAsNumber", ElementID: IEID_BGP_SOURCE_AS_NUMBER, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_BGP_DESTINATION_AS_NUMBER:                    {Name: "bgpDestinationAsNumber", ElementID: IEID_BGP_DESTINATION_AS_NUMBER, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_BGP_NEXT_HOP_IPV4_ADDRESS:                    {Name: "bgpNextHopIPv4Address", ElementID: IEID_BGP_NEXT_HOP_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_POST_MCAST_PACKET_DELTA_COUNT:                {Name: "postMCastPacketDeltaCount", ElementID: IEID_POST_MCAST_PACKET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "packets", Length: 8},
+	IEID_POST_MCAST_OCTET_DELTA_COUNT:                 {Name: "postMCastOctetDeltaCount", ElementID: IEID_POST_MCAST_OCTET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_FLOW_END_SYS_UP_TIME:                         {Name: "flowEndSysUpTime", ElementID: IEID_FLOW_END_SYS_UP_TIME, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 4},
+	IEID_FLOW_START_SYS_UP_TIME:                       {Name: "flowStartSysUpTime", ElementID: IEID_FLOW_START_SYS_UP_TIME, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 4},
+	IEID_POST_OCTET_DELTA_COUNT:                       {Name: "postOctetDeltaCount", ElementID: IEID_POST_OCTET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_POST_PACKET_DELTA_COUNT:                      {Name: "postPacketDeltaCount", ElementID: IEID_POST_PACKET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "packets", Length: 8},
+	IEID_MINIMUM_IP_TOTAL_LENGTH:                      {Name: "minimumIpTotalLength", ElementID: IEID_MINIMUM_IP_TOTAL_LENGTH, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 8},
+	IEID_MAXIMUM_IP_TOTAL_LENGTH:                      {Name: "maximumIpTotalLength", ElementID: IEID_MAXIMUM_IP_TOTAL_LENGTH, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 8},
+	IEID_SOURCE_IPV6_ADDRESS:                          {Name: "sourceIPv6Address", ElementID: IEID_SOURCE_IPV6_ADDRESS, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_DESTINATION_IPV6_ADDRESS:                     {Name: "destinationIPv6Address", ElementID: IEID_DESTINATION_IPV6_ADDRESS, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_SOURCE_IPV6_PREFIX_LENGTH:                    {Name: "sourceIPv6PrefixLength", ElementID: IEID_SOURCE_IPV6_PREFIX_LENGTH, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "bits", Length: 1},
+	IEID_DESTINATION_IPV6_PREFIX_LENGTH:               {Name: "destinationIPv6PrefixLength", ElementID: IEID_DESTINATION_IPV6_PREFIX_LENGTH, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "bits", Length: 1},
+	IEID_FLOW_LABEL_IPV6:                              {Name: "flowLabelIPv6", ElementID: IEID_FLOW_LABEL_IPV6, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_ICMP_TYPE_CODE_IPV4:                          {Name: "icmpTypeCodeIPv4", ElementID: IEID_ICMP_TYPE_CODE_IPV4, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_IGMP_TYPE:                                    {Name: "igmpType", ElementID: IEID_IGMP_TYPE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_SAMPLING_INTERVAL:                            {Name: "samplingInterval", ElementID: IEID_SAMPLING_INTERVAL, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "packets", Length: 4, Deprecated: true},
+	IEID_SAMPLING_ALGORITHM:                           {Name: "samplingAlgorithm", ElementID: IEID_SAMPLING_ALGORITHM, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1, Deprecated: true},
+	IEID_FLOW_ACTIVE_TIMEOUT:                          {Name: "flowActiveTimeout", ElementID: IEID_FLOW_ACTIVE_TIMEOUT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "seconds", Length: 2},
+	IEID_FLOW_INACTIVE_TIMEOUT:                        {Name: "flowIdleTimeout", ElementID: IEID_FLOW_INACTIVE_TIMEOUT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "seconds", Length: 2},
+	IEID_ENGINE_TYPE:                                  {Name: "engineType", ElementID: IEID_ENGINE_TYPE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1, Deprecated: true},
+	IEID_ENGINE_ID:                                    {Name: "engineId", ElementID: IEID_ENGINE_ID, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1, Deprecated: true},
+	IEID_EXPORTED_OCTET_TOTAL_COUNT:                   {Name: "exportedOctetTotalCount", ElementID: IEID_EXPORTED_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_EXPORTED_MESSAGE_TOTAL_COUNT:                 {Name: "exportedMessageTotalCount", ElementID: IEID_EXPORTED_MESSAGE_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "messages", Length: 8},
+	IEID_EXPORTED_FLOW_RECORD_TOTAL_COUNT:             {Name: "exportedFlowRecordTotalCount", ElementID: IEID_EXPORTED_FLOW_RECORD_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "flows", Length: 8},
+	IEID_IPV4_ROUTER_SC:                               {Name: "ipv4RouterSc", ElementID: IEID_IPV4_ROUTER_SC, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4, Deprecated: true},
+	IEID_SOURCE_IPV4_PREFIX:                           {Name: "sourceIPv4Prefix", ElementID: IEID_SOURCE_IPV4_PREFIX, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_DESTINATION_IPV4_PREFIX:                      {Name: "destinationIPv4Prefix", ElementID: IEID_DESTINATION_IPV4_PREFIX, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_MPLS_TOP_LABEL_TYPE:                          {Name: "mplsTopLabelType", ElementID: IEID_MPLS_TOP_LABEL_TYPE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_MPLS_TOP_LABEL_IPV4_ADDRESS:                  {Name: "mplsTopLabelIPv4Address", ElementID: IEID_MPLS_TOP_LABEL_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_SAMPLER_ID:                                   {Name: "samplerId", ElementID: IEID_SAMPLER_ID, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1, Deprecated: true},
+	IEID_SAMPLER_MODE:                                 {Name: "samplerMode", ElementID: IEID_SAMPLER_MODE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1, Deprecated: true},
+	IEID_SAMPLER_RANDOM_INTERVAL:                      {Name: "samplerRandomInterval", ElementID: IEID_SAMPLER_RANDOM_INTERVAL, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 4, Deprecated: true},
+	IEID_CLASS_ID:                                     {Name: "classId", ElementID: IEID_CLASS_ID, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1, Deprecated: true},
+	IEID_MINIMUM_TTL:                                  {Name: "minimumTTL", ElementID: IEID_MINIMUM_TTL, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "hops", Length: 1},
+	IEID_MAXIMUM_TTL:                                  {Name: "maximumTTL", ElementID: IEID_MAXIMUM_TTL, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "hops", Length: 1},
+	IEID_FRAGMENT_IDENTIFICATION:                      {Name: "fragmentIdentification", ElementID: IEID_FRAGMENT_IDENTIFICATION, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_POST_IP_CLASS_OF_SERVICE:                     {Name: "postIpClassOfService", ElementID: IEID_POST_IP_CLASS_OF_SERVICE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_SOURCE_MAC_ADDRESS:                           {Name: "sourceMacAddress", ElementID: IEID_SOURCE_MAC_ADDRESS, DataType: DATA_TYPE_MAC_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 6},
+	IEID_POST_DESTINATION_MAC_ADDRESS:                 {Name: "postDestinationMacAddress", ElementID: IEID_POST_DESTINATION_MAC_ADDRESS, DataType: DATA_TYPE_MAC_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 6},
+	IEID_VLAN_ID:                                      {Name: "vlanId", ElementID: IEID_VLAN_ID, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_POST_VLAN_ID:                                 {Name: "postVlanId", ElementID: IEID_POST_VLAN_ID, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_IP_VERSION:                                   {Name: "ipVersion", ElementID: IEID_IP_VERSION, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_FLOW_DIRECTION:                               {Name: "flowDirection", ElementID: IEID_FLOW_DIRECTION, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_IP_NEXT_HOP_IPV6_ADDRESS:                     {Name: "ipNextHopIPv6Address", ElementID: IEID_IP_NEXT_HOP_IPV6_ADDRESS, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_BGP_NEXT_HOP_IPV6_ADDRESS:                    {Name: "bgpNextHopIPv6Address", ElementID: IEID_BGP_NEXT_HOP_IPV6_ADDRESS, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_IPV6_EXTENSION_HEADERS:                       {Name: "ipv6ExtensionHeaders", ElementID: IEID_IPV6_EXTENSION_HEADERS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 4},
+	IEID_MPLS_TOP_LABEL_STACK_SECTION:                 {Name: "mplsTopLabelStackSection", ElementID: IEID_MPLS_TOP_LABEL_STACK_SECTION, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_LABEL_STACK_SECTION2:                    {Name: "mplsLabelStackSection2", ElementID: IEID_MPLS_LABEL_STACK_SECTION2, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_LABEL_STACK_SECTION3:                    {Name: "mplsLabelStackSection3", ElementID: IEID_MPLS_LABEL_STACK_SECTION3, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_LABEL_STACK_SECTION4:                    {Name: "mplsLabelStackSection4", ElementID: IEID_MPLS_LABEL_STACK_SECTION4, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_LABEL_STACK_SECTION5:                    {Name: "mplsLabelStackSection5", ElementID: IEID_MPLS_LABEL_STACK_SECTION5, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_LABEL_STACK_SECTION6:                    {Name: "mplsLabelStackSection6", ElementID: IEID_MPLS_LABEL_STACK_SECTION6, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_LABEL_STACK_SECTION7:                    {Name: "mplsLabelStackSection7", ElementID: IEID_MPLS_LABEL_STACK_SECTION7, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_LABEL_STACK_SECTION8:                    {Name: "mplsLabelStackSection8", ElementID: IEID_MPLS_LABEL_STACK_SECTION8, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_LABEL_STACK_SECTION9:                    {Name: "mplsLabelStackSection9", ElementID: IEID_MPLS_LABEL_STACK_SECTION9, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_LABEL_STACK_SECTION10:                   {Name: "mplsLabelStackSection10", ElementID: IEID_MPLS_LABEL_STACK_SECTION10, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_DESTINATION_MAC_ADDRESS:                      {Name: "destinationMacAddress", ElementID: IEID_DESTINATION_MAC_ADDRESS, DataType: DATA_TYPE_MAC_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 6},
+	IEID_POST_SOURCE_MAC_ADDRESS:                      {Name: "postSourceMacAddress", ElementID: IEID_POST_SOURCE_MAC_ADDRESS, DataType: DATA_TYPE_MAC_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 6},
+	IEID_INTERFACE_NAME:                               {Name: "interfaceName", ElementID: IEID_INTERFACE_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_INTERFACE_DESCRIPTION:                        {Name: "interfaceDescription", ElementID: IEID_INTERFACE_DESCRIPTION, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_SAMPLER_NAME:                                 {Name: "samplerName", ElementID: IEID_SAMPLER_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH, Deprecated: true},
+	IEID_OCTET_TOTAL_COUNT:                            {Name: "octetTotalCount", ElementID: IEID_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_PACKET_TOTAL_COUNT:                           {Name: "packetTotalCount", ElementID: IEID_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_FLAGS_AND_SAMPLING_ID:                        {Name: "flagsAndSamplerId", ElementID: IEID_FLAGS_AND_SAMPLING_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4, Deprecated: true},
+	IEID_FRAGMENT_OFFSET:                              {Name: "fragmentOffset", ElementID: IEID_FRAGMENT_OFFSET, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 2},
+	IEID_FOREARDING_STATUS:                            {Name: "forwardingStatus", ElementID: IEID_FOREARDING_STATUS, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_MPLS_VPN_ROUTE_DISTINGUISHER:                 {Name: "mplsVpnRouteDistinguisher", ElementID: IEID_MPLS_VPN_ROUTE_DISTINGUISHER, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_TOP_LABEL_PREFIX_LENGTH:                 {Name: "mplsTopLabelPrefixLength", ElementID: IEID_MPLS_TOP_LABEL_PREFIX_LENGTH, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "bits", Length: 1},
+	IEID_SRC_TRAFFIC_INDEX:                            {Name: "srcTrafficIndex", ElementID: IEID_SRC_TRAFFIC_INDEX, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_DST_TRAFFIC_INDEX:                            {Name: "dstTrafficIndex", ElementID: IEID_DST_TRAFFIC_INDEX, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_APPLICATION_DESCRIPTION:                      {Name: "applicationDescription", ElementID: IEID_APPLICATION_DESCRIPTION, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_APPLICATION_ID:                               {Name: "applicationId", ElementID: IEID_APPLICATION_ID, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_APPLICATION_NAME:                             {Name: "applicationName", ElementID: IEID_APPLICATION_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_POST_IP_DIFF_SERV_CODE_POINT:                 {Name: "postIpDiffServCodePoint", ElementID: IEID_POST_IP_DIFF_SERV_CODE_POINT, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_MULTICAST_REPLICATION_FACTOR:                 {Name: "multicastReplicationFactor", ElementID: IEID_MULTICAST_REPLICATION_FACTOR, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 4},
+	IEID_CLASS_NAME:                                   {Name: "className", ElementID: IEID_CLASS_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH, Deprecated: true},
+	IEID_CLASSIFICATION_ENGINE_ID:                     {Name: "classificationEngineId", ElementID: IEID_CLASSIFICATION_ENGINE_ID, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_LAYER2_PACKET_SECTION_OFFSET:                 {Name: "layer2packetSectionOffset", ElementID: IEID_LAYER2_PACKET_SECTION_OFFSET, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 2, Deprecated: true},
+	IEID_LAYER2_PACKET_SECTION_SIZE:                   {Name: "layer2packetSectionSize", ElementID: IEID_LAYER2_PACKET_SECTION_SIZE, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 2, Deprecated: true},
+	IEID_LAYER2_PACKET_SECTION_DATA:                   {Name: "layer2packetSectionData", ElementID: IEID_LAYER2_PACKET_SECTION_DATA, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH, Deprecated: true},
+	IEID_BGP_NEXT_ADJACENT_AS_NUMBER:                  {Name: "bgpNextAdjacentAsNumber", ElementID: IEID_BGP_NEXT_ADJACENT_AS_NUMBER, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_BGP_PREV_ADJACENT_AS_NUMBER:                  {Name: "bgpPrevAdjacentAsNumber", ElementID: IEID_BGP_PREV_ADJACENT_AS_NUMBER, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_EXPORTER_IPV4_ADDRESS:                        {Name: "exporterIPv4Address", ElementID: IEID_EXPORTER_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_EXPORTER_IPV6_ADDRESS:                        {Name: "exporterIPv6Address", ElementID: IEID_EXPORTER_IPV6_ADDRESS, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_DROPPED_OCTET_DELTA_COUNT:                    {Name: "droppedOctetDeltaCount", ElementID: IEID_DROPPED_OCTET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_DROPPED_PACKET_DELTA_COUNT:                   {Name: "droppedPacketDeltaCount", ElementID: IEID_DROPPED_PACKET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "packets", Length: 8},
+	IEID_DROPPED_OCTET_TOTAL_COUNT:                    {Name: "droppedOctetTotalCount", ElementID: IEID_DROPPED_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_DROPPED_PACKET_TOTAL_COUNT:                   {Name: "droppedPacketTotalCount", ElementID: IEID_DROPPED_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_FLOW_END_REASON:                              {Name: "flowEndReason", ElementID: IEID_FLOW_END_REASON, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_COMMON_PROPERTIES_ID:                         {Name: "commonPropertiesId", ElementID: IEID_COMMON_PROPERTIES_ID, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 8},
+	IEID_OBSERVATION_POINT_ID:                         {Name: "observationPointId", ElementID: IEID_OBSERVATION_POINT_ID, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 8},
+	IEID_ICMP_TYPE_CODE_IPV6:                          {Name: "icmpTypeCodeIPv6", ElementID: IEID_ICMP_TYPE_CODE_IPV6, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_MPLS_TOP_LABEL_IPV6_ADDRESS:                  {Name: "mplsTopLabelIPv6Address", ElementID: IEID_MPLS_TOP_LABEL_IPV6_ADDRESS, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_LINE_CARD_ID:                                 {Name: "lineCardId", ElementID: IEID_LINE_CARD_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_PORT_ID:                                      {Name: "portId", ElementID: IEID_PORT_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_METERING_PROCESS_ID:                          {Name: "meteringProcessId", ElementID: IEID_METERING_PROCESS_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_EXPORTING_PROCESS_ID:                         {Name: "exportingProcessId", ElementID: IEID_EXPORTING_PROCESS_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_TEMPLATE_ID:                                  {Name: "templateId", ElementID: IEID_TEMPLATE_ID, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_WLAN_CHANNEL_ID:                              {Name: "wlanChannelId", ElementID: IEID_WLAN_CHANNEL_ID, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_WLAN_SSID:                                    {Name: "wlanSSID", ElementID: IEID_WLAN_SSID, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_FLOW_ID:                                      {Name: "flowId", ElementID: IEID_FLOW_ID, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 8},
+	IEID_OBSERVATION_DOMAIN_ID:                        {Name: "observationDomainId", ElementID: IEID_OBSERVATION_DOMAIN_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_FLOW_START_SECONDS:                           {Name: "flowStartSeconds", ElementID: IEID_FLOW_START_SECONDS, DataType: DATA_TYPE_DATE_TIME_SECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "seconds", Length: 4},
+	IEID_FLOW_END_SECONDS:                             {Name: "flowEndSeconds", ElementID: IEID_FLOW_END_SECONDS, DataType: DATA_TYPE_DATE_TIME_SECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "seconds", Length: 4},
+	IEID_FLOW_START_MILLISECONDS:                      {Name: "flowStartMilliseconds", ElementID: IEID_FLOW_START_MILLISECONDS, DataType: DATA_TYPE_DATE_TIME_MILLISECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 8},
+	IEID_FLOW_END_MILLISECONDS:                        {Name: "flowEndMilliseconds", ElementID: IEID_FLOW_END_MILLISECONDS, DataType: DATA_TYPE_DATE_TIME_MILLISECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 8},
+	IEID_FLOW_START_MICROSECONDS:                      {Name: "flowStartMicroseconds", ElementID: IEID_FLOW_START_MICROSECONDS, DataType: DATA_TYPE_DATE_TIME_MICROSECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "microseconds", Length: 8},
+	IEID_FLOW_END_MICROSECONDS:                        {Name: "flowEndMicroseconds", ElementID: IEID_FLOW_END_MICROSECONDS, DataType: DATA_TYPE_DATE_TIME_MICROSECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "microseconds", Length: 8},
+	IEID_FLOW_START_NANOSECONDS:                       {Name: "flowStartNanoseconds", ElementID: IEID_FLOW_START_NANOSECONDS, DataType: DATA_TYPE_DATE_TIME_NANOSECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "nanoseconds", Length: 8},
+	IEID_FLOW_END_NANOSECONDS:                         {Name: "flowEndNanoseconds", ElementID: IEID_FLOW_END_NANOSECONDS, DataType: DATA_TYPE_DATE_TIME_NANOSECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "nanoseconds", Length: 8},
+	IEID_FLOW_START_DELTA_MICROSECONDS:                {Name: "flowStartDeltaMicroseconds", ElementID: IEID_FLOW_START_DELTA_MICROSECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "microseconds", Length: 4},
+	IEID_FLOW_END_DELTA_MICROSECONDS:                  {Name: "flowEndDeltaMicroseconds", ElementID: IEID_FLOW_END_DELTA_MICROSECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "microseconds", Length: 4},
+	IEID_SYSTEM_INIT_TIME_MILLISECONDS:                {Name: "systemInitTimeMilliseconds", ElementID: IEID_SYSTEM_INIT_TIME_MILLISECONDS, DataType: DATA_TYPE_DATE_TIME_MILLISECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 8},
+	IEID_FLOW_DURATION_MILLISECONDS:                   {Name: "flowDurationMilliseconds", ElementID: IEID_FLOW_DURATION_MILLISECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 4},
+	IEID_FLOW_DURATION_MICROSECONDS:                   {Name: "flowDurationMicroseconds", ElementID: IEID_FLOW_DURATION_MICROSECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "microseconds", Length: 4},
+	IEID_OBSERVED_FLOW_TOTAL_COUNT:                    {Name: "observedFlowTotalCount", ElementID: IEID_OBSERVED_FLOW_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "flows", Length: 8},
+	IEID_IGNORED_PACKET_TOTAL_COUNT:                   {Name: "ignoredPacketTotalCount", ElementID: IEID_IGNORED_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_IGNORED_OCTET_TOTAL_COUNT:                    {Name: "ignoredOctetTotalCount", ElementID: IEID_IGNORED_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_NOT_SENT_FLOW_TOTAL_COUNT:                    {Name: "notSentFlowTotalCount", ElementID: IEID_NOT_SENT_FLOW_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "flows", Length: 8},
+	IEID_NOT_SENT_PACKET_TOTAL_COUNT:                  {Name: "notSentPacketTotalCount", ElementID: IEID_NOT_SENT_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_NOT_SENT_OCTET_TOTAL_COUNT:                   {Name: "notSentOctetTotalCount", ElementID: IEID_NOT_SENT_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_DESTINATION_IPV6_PREFIX:                      {Name: "destinationIPv6Prefix", ElementID: IEID_DESTINATION_IPV6_PREFIX, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_SOURCE_IPV6_PREFIX:                           {Name: "sourceIPv6Prefix", ElementID: IEID_SOURCE_IPV6_PREFIX, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_POST_OCTET_TOTAL_COUNT:                       {Name: "postOctetTotalCount", ElementID: IEID_POST_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_POST_PACKET_TOTAL_COUNT:                      {Name: "postPacketTotalCount", ElementID: IEID_POST_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_FLOW_KEY_INDICATOR:                           {Name: "flowKeyIndicator", ElementID: IEID_FLOW_KEY_INDICATOR, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 8},
+	IEID_POST_MCAST_PACKET_TOTAL_COUNT:                {Name: "postMCastPacketTotalCount", ElementID: IEID_POST_MCAST_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_POST_MCAST_OCTET_TOTAL_COUNT:                 {Name: "postMCastOctetTotalCount", ElementID: IEID_POST_MCAST_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_ICMP_TYPE_IPV4:                               {Name: "icmpTypeIPv4", ElementID: IEID_ICMP_TYPE_IPV4, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_ICMP_CODE_IPV4:                               {Name: "icmpCodeIPv4", ElementID: IEID_ICMP_CODE_IPV4, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_ICMP_TYPE_IPV6:                               {Name: "icmpTypeIPv6", ElementID: IEID_ICMP_TYPE_IPV6, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_ICMP_CODE_IPV6:                               {Name: "icmpCodeIPv6", ElementID: IEID_ICMP_CODE_IPV6, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_UDP_SOURCE_PORT:                              {Name: "udpSourcePort", ElementID: IEID_UDP_SOURCE_PORT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_UDP_DESTINATION_PORT:                         {Name: "udpDestinationPort", ElementID: IEID_UDP_DESTINATION_PORT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_TCP_SOURCE_PORT:                              {Name: "tcpSourcePort", ElementID: IEID_TCP_SOURCE_PORT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_TCP_DESTINATION_PORT:                         {Name: "tcpDestinationPort", ElementID: IEID_TCP_DESTINATION_PORT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_TCP_SEQUENCE_NUMBER:                          {Name: "tcpSequenceNumber", ElementID: IEID_TCP_SEQUENCE_NUMBER, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_TCP_ACKNOWLEDGEMENT_NUMBER:                   {Name: "tcpAcknowledgementNumber", ElementID: IEID_TCP_ACKNOWLEDGEMENT_NUMBER, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_TCP_WINDOW_SIZE:                              {Name: "tcpWindowSize", ElementID: IEID_TCP_WINDOW_SIZE, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 2},
+	IEID_TCP_URGENT_POINTER:                           {Name: "tcpUrgentPointer", ElementID: IEID_TCP_URGENT_POINTER, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 2},
+	IEID_TCP_HEADER_LENGTH:                            {Name: "tcpHeaderLength", ElementID: IEID_TCP_HEADER_LENGTH, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 1},
+	IEID_IP_HEADER_LENGTH:                             {Name: "ipHeaderLength", ElementID: IEID_IP_HEADER_LENGTH, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 1},
+	IEID_TOTAL_LENGTH_IPV4:                            {Name: "totalLengthIPv4", ElementID: IEID_TOTAL_LENGTH_IPV4, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 2},
+	IEID_PAYLOAD_LENGTH_IPV6:                          {Name: "payloadLengthIPv6", ElementID: IEID_PAYLOAD_LENGTH_IPV6, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 2},
+	IEID_IP_TTL:                                       {Name: "ipTTL", ElementID: IEID_IP_TTL, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "hops", Length: 1},
+	IEID_NEXT_HEADER_IPV6:                             {Name: "nextHeaderIPv6", ElementID: IEID_NEXT_HEADER_IPV6, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_MPLS_PAYLOAD_LENGTH:                          {Name: "mplsPayloadLength", ElementID: IEID_MPLS_PAYLOAD_LENGTH, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 4},
+	IEID_IP_DIFF_SERV_CODE_POINT:                      {Name: "ipDiffServCodePoint", ElementID: IEID_IP_DIFF_SERV_CODE_POINT, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_IP_PRECEDENCE:                                {Name: "ipPrecedence", ElementID: IEID_IP_PRECEDENCE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_FRAGMENT_FLAGS:                               {Name: "fragmentFlags", ElementID: IEID_FRAGMENT_FLAGS, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 1},
+	IEID_OCTET_DELTA_SUM_OF_SQUARES:                   {Name: "octetDeltaSumOfSquares", ElementID: IEID_OCTET_DELTA_SUM_OF_SQUARES, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 8},
+	IEID_OCTET_TOTAL_SUM_OF_SQUARES:                   {Name: "octetTotalSumOfSquares", ElementID: IEID_OCTET_TOTAL_SUM_OF_SQUARES, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 8},
+	IEID_MPLS_TOP_LABEL_TTL:                           {Name: "mplsTopLabelTTL", ElementID: IEID_MPLS_TOP_LABEL_TTL, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "hops", Length: 1},
+	IEID_MPLS_LABEL_STACK_LENGTH:                      {Name: "mplsLabelStackLength", ElementID: IEID_MPLS_LABEL_STACK_LENGTH, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 4},
+	IEID_MPLS_LABEL_STACK_DEPTH:                       {Name: "mplsLabelStackDepth", ElementID: IEID_MPLS_LABEL_STACK_DEPTH, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "entries", Length: 4},
+	IEID_MPLS_TOP_LABEL_EXP:                           {Name: "mplsTopLabelExp", ElementID: IEID_MPLS_TOP_LABEL_EXP, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 1},
+	IEID_IP_PAYLOAD_LENGTH:                            {Name: "ipPayloadLength", ElementID: IEID_IP_PAYLOAD_LENGTH, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 4},
+	IEID_UDP_MESSAGE_LENGTH:                           {Name: "udpMessageLength", ElementID: IEID_UDP_MESSAGE_LENGTH, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 2},
+	IEID_IS_MULTICAST:                                 {Name: "isMulticast", ElementID: IEID_IS_MULTICAST, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 1},
+	IEID_IPV4_IHL:                                     {Name: "ipv4IHL", ElementID: IEID_IPV4_IHL, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "4-octet words", Length: 1},
+	IEID_IPV4_OPTIONS:                                 {Name: "ipv4Options", ElementID: IEID_IPV4_OPTIONS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 4},
+	IEID_TCP_OPTIONS:                                  {Name: "tcpOptions", ElementID: IEID_TCP_OPTIONS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 8},
+	IEID_PADDING_OCTETS:                               {Name: "paddingOctets", ElementID: IEID_PADDING_OCTETS, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_COLLECTOR_IPV4_ADDRESS:                       {Name: "collectorIPv4Address", ElementID: IEID_COLLECTOR_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_COLLECTOR_IPV6_ADDRESS:                       {Name: "collectorIPv6Address", ElementID: IEID_COLLECTOR_IPV6_ADDRESS, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_EXPORT_INTERFACE:                             {Name: "exportInterface", ElementID: IEID_EXPORT_INTERFACE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_EXPORT_PROTOCOL_VERSION:                      {Name: "exportProtocolVersion", ElementID: IEID_EXPORT_PROTOCOL_VERSION, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_EXPORT_TRANSPORT_PROTOCOL:                    {Name: "exportTransportProtocol", ElementID: IEID_EXPORT_TRANSPORT_PROTOCOL, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_COLLECTOR_TRANSPORT_PORT:                     {Name: "collectorTransportPort", ElementID: IEID_COLLECTOR_TRANSPORT_PORT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_EXPORTER_TRANSPORT_PORT:                      {Name: "exporterTransportPort", ElementID: IEID_EXPORTER_TRANSPORT_PORT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_TCP_SYN_TOTAL_COUNT:                          {Name: "tcpSynTotalCount", ElementID: IEID_TCP_SYN_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_TCP_FIN_TOTAL_COUNT:                          {Name: "tcpFinTotalCount", ElementID: IEID_TCP_FIN_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_TCP_RST_TOTAL_COUNT:                          {Name: "tcpRstTotalCount", ElementID: IEID_TCP_RST_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_TCP_PSH_TOTAL_COUNT:                          {Name: "tcpPshTotalCount", ElementID: IEID_TCP_PSH_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_TCP_ACK_TOTAL_COUNT:                          {Name: "tcpAckTotalCount", ElementID: IEID_TCP_ACK_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_TCP_URG_TOTAL_COUNT:                          {Name: "tcpUrgTotalCount", ElementID: IEID_TCP_URG_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_IP_TOTAL_LENGTH:                              {Name: "ipTotalLength", ElementID: IEID_IP_TOTAL_LENGTH, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 8},
+	IEID_POST_NAT_SOURCE_IPV4_ADDRESS:                 {Name: "postNATSourceIPv4Address", ElementID: IEID_POST_NAT_SOURCE_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_POST_NAT_DESTINATION_IPV4_ADDRESS:            {Name: "postNATDestinationIPv4Address", ElementID: IEID_POST_NAT_DESTINATION_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_POST_NAPT_SOURCE_TRANSPORT_PORT:              {Name: "postNAPTSourceTransportPort", ElementID: IEID_POST_NAPT_SOURCE_TRANSPORT_PORT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_POST_NAPT_DESTINATION_TRANSPORT_PORT:         {Name: "postNAPTDestinationTransportPort", ElementID: IEID_POST_NAPT_DESTINATION_TRANSPORT_PORT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_NAT_ORIGINATING_ADDRESS_REALM:                {Name: "natOriginatingAddressRealm", ElementID: IEID_NAT_ORIGINATING_ADDRESS_REALM, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_NAT_EVENT:                                    {Name: "natEvent", ElementID: IEID_NAT_EVENT, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_INITIATOR_OCTETS:                             {Name: "initiatorOctets", ElementID: IEID_INITIATOR_OCTETS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_RESPONDER_OCTETS:                             {Name: "responderOctets", ElementID: IEID_RESPONDER_OCTETS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_FIREWALL_EVENT:                               {Name: "firewallEvent", ElementID: IEID_FIREWALL_EVENT, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_INGRESS_VRFID:                                {Name: "ingressVRFID", ElementID: IEID_INGRESS_VRFID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_EGRESS_VRFID:                                 {Name: "egressVRFID", ElementID: IEID_EGRESS_VRFID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_VRF_NAME:                                     {Name: "VRFname", ElementID: IEID_VRF_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_POST_MPLS_TOP_LABEL_EXP:                      {Name: "postMplsTopLabelExp", ElementID: IEID_POST_MPLS_TOP_LABEL_EXP, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 1},
+	IEID_TCP_WINDOW_SCALE:                             {Name: "tcpWindowScale", ElementID: IEID_TCP_WINDOW_SCALE, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 2},
+	IEID_BIFLOW_DIRECTION:                             {Name: "biflowDirection", ElementID: IEID_BIFLOW_DIRECTION, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_ETHERNET_HEADER_LENGTH:                       {Name: "ethernetHeaderLength", ElementID: IEID_ETHERNET_HEADER_LENGTH, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "octets", Length: 1},
+	IEID_ETHERNET_PAYLOAD_LENGTH:                      {Name: "ethernetPayloadLength", ElementID: IEID_ETHERNET_PAYLOAD_LENGTH, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "octets", Length: 2},
+	IEID_ETHERNET_TOTAL_LENGTH:                        {Name: "ethernetTotalLength", ElementID: IEID_ETHERNET_TOTAL_LENGTH, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "octets", Length: 2},
+	IEID_DOT1Q_VLAN_ID:                                {Name: "dot1qVlanId", ElementID: IEID_DOT1Q_VLAN_ID, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_DOT1Q_PRIORITY:                               {Name: "dot1qPriority", ElementID: IEID_DOT1Q_PRIORITY, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_DOT1Q_CUSTOMER_VLAN_ID:                       {Name: "dot1qCustomerVlanId", ElementID: IEID_DOT1Q_CUSTOMER_VLAN_ID, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_DOT1Q_CUSTOMER_PRIORITY:                      {Name: "dot1qCustomerPriority", ElementID: IEID_DOT1Q_CUSTOMER_PRIORITY, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_METRO_EVC_ID:                                 {Name: "metroEvcId", ElementID: IEID_METRO_EVC_ID, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_METRO_EVC_TYPE:                               {Name: "metroEvcType", ElementID: IEID_METRO_EVC_TYPE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_PSEUDO_WIRE_ID:                               {Name: "pseudoWireId", ElementID: IEID_PSEUDO_WIRE_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_PSEUDO_WIRE_TYPE:                             {Name: "pseudoWireType", ElementID: IEID_PSEUDO_WIRE_TYPE, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_PSEUDO_WIRE_CONTROL_WORD:                     {Name: "pseudoWireControlWord", ElementID: IEID_PSEUDO_WIRE_CONTROL_WORD, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_INGRESS_PHYSICAL_INTERFACE:                   {Name: "ingressPhysicalInterface", ElementID: IEID_INGRESS_PHYSICAL_INTERFACE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_EGRESS_PHYSICAL_INTERFACE:                    {Name: "egressPhysicalInterface", ElementID: IEID_EGRESS_PHYSICAL_INTERFACE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_POST_DOT1Q_VLAN_ID:                           {Name: "postDot1qVlanId", ElementID: IEID_POST_DOT1Q_VLAN_ID, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_POST_DOT1Q_CUSTOMER_VLAN_ID:                  {Name: "postDot1qCustomerVlanId", ElementID: IEID_POST_DOT1Q_CUSTOMER_VLAN_ID, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_ETHERNET_TYPE:                                {Name: "ethernetType", ElementID: IEID_ETHERNET_TYPE, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_POST_IP_PRECEDENCE:                           {Name: "postIpPrecedence", ElementID: IEID_POST_IP_PRECEDENCE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_COLLECTION_TIME_MILLISECONDS:                 {Name: "collectionTimeMilliseconds", ElementID: IEID_COLLECTION_TIME_MILLISECONDS, DataType: DATA_TYPE_DATE_TIME_MILLISECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 8},
+	IEID_EXPORT_SCTP_STREAM_ID:                        {Name: "exportSctpStreamId", ElementID: IEID_EXPORT_SCTP_STREAM_ID, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_MAX_EXPORT_SECONDS:                           {Name: "maxExportSeconds", ElementID: IEID_MAX_EXPORT_SECONDS, DataType: DATA_TYPE_DATE_TIME_SECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "seconds", Length: 4},
+	IEID_MAX_FLOW_END_SECONDS:                         {Name: "maxFlowEndSeconds", ElementID: IEID_MAX_FLOW_END_SECONDS, DataType: DATA_TYPE_DATE_TIME_SECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "seconds", Length: 4},
+	IEID_MESSAGE_MD5_CHECKSUM:                         {Name: "messageMD5Checksum", ElementID: IEID_MESSAGE_MD5_CHECKSUM, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MESSAGE_SCOPE:                                {Name: "messageScope", ElementID: IEID_MESSAGE_SCOPE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_MIN_EXPORT_SECONDS:                           {Name: "minExportSeconds", ElementID: IEID_MIN_EXPORT_SECONDS, DataType: DATA_TYPE_DATE_TIME_SECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "seconds", Length: 4},
+	IEID_MIN_FLOW_START_SECONDS:                       {Name: "minFlowStartSeconds", ElementID: IEID_MIN_FLOW_START_SECONDS, DataType: DATA_TYPE_DATE_TIME_SECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "seconds", Length: 4},
+	IEID_OPAQUE_OCTETS:                                {Name: "opaqueOctets", ElementID: IEID_OPAQUE_OCTETS, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_SESSION_SCOPE:                                {Name: "sessionScope", ElementID: IEID_SESSION_SCOPE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_MAX_FLOW_END_MICROSECONDS:                    {Name: "maxFlowEndMicroseconds", ElementID: IEID_MAX_FLOW_END_MICROSECONDS, DataType: DATA_TYPE_DATE_TIME_MICROSECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "microseconds", Length: 8},
+	IEID_MAX_FLOW_END_MILLISECONDS:                    {Name: "maxFlowEndMilliseconds", ElementID: IEID_MAX_FLOW_END_MILLISECONDS, DataType: DATA_TYPE_DATE_TIME_MILLISECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 8},
+	IEID_MAX_FLOW_END_NANOSECONDS:                     {Name: "maxFlowEndNanoseconds", ElementID: IEID_MAX_FLOW_END_NANOSECONDS, DataType: DATA_TYPE_DATE_TIME_NANOSECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "nanoseconds", Length: 8},
+	IEID_MIN_FLOW_START_MICROSECONDS:                  {Name: "minFlowStartMicroseconds", ElementID: IEID_MIN_FLOW_START_MICROSECONDS, DataType: DATA_TYPE_DATE_TIME_MICROSECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "microseconds", Length: 8},
+	IEID_MIN_FLOW_START_MILLISECONDS:                  {Name: "minFlowStartMilliseconds", ElementID: IEID_MIN_FLOW_START_MILLISECONDS, DataType: DATA_TYPE_DATE_TIME_MILLISECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 8},
+	IEID_MIN_FLOW_START_NANOSECONDS:                   {Name: "minFlowStartNanoseconds", ElementID: IEID_MIN_FLOW_START_NANOSECONDS, DataType: DATA_TYPE_DATE_TIME_NANOSECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "nanoseconds", Length: 8},
+	IEID_COLLECTOR_CERTIFICATE:                        {Name: "collectorCertificate", ElementID: IEID_COLLECTOR_CERTIFICATE, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_EXPORTER_CERTIFICATE:                         {Name: "exporterCertificate", ElementID: IEID_EXPORTER_CERTIFICATE, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_DATA_RECORDS_RELIABILITY:                     {Name: "dataRecordsReliability", ElementID: IEID_DATA_RECORDS_RELIABILITY, DataType: DATA_TYPE_BOOLEAN, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_OBSERVATION_POINT_TYPE:                       {Name: "observationPointType", ElementID: IEID_OBSERVATION_POINT_TYPE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_NEW_CONNECTION_DELTA_COUNT:                   {Name: "newConnectionDeltaCount", ElementID: IEID_NEW_CONNECTION_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Length: 4},
+	IEID_CONNECTION_SUM_DURATION_SECONDS:              {Name: "connectionSumDurationSeconds", ElementID: IEID_CONNECTION_SUM_DURATION_SECONDS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "seconds", Length: 8},
+	IEID_CONNECTION_TRANSACTION_ID:                    {Name: "connectionTransactionId", ElementID: IEID_CONNECTION_TRANSACTION_ID, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 8},
+	IEID_POST_NAT_SOURCE_IPV6_ADDRESS:                 {Name: "postNATSourceIPv6Address", ElementID: IEID_POST_NAT_SOURCE_IPV6_ADDRESS, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_POST_NAT_DESTINATION_IPV6_ADDRESS:            {Name: "postNATDestinationIPv6Address", ElementID: IEID_POST_NAT_DESTINATION_IPV6_ADDRESS, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_NAT_POOL_ID:                                  {Name: "natPoolId", ElementID: IEID_NAT_POOL_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_NAT_POOL_NAME:                                {Name: "natPoolName", ElementID: IEID_NAT_POOL_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_ANONYMIZATION_FLAGS:                          {Name: "anonymizationFlags", ElementID: IEID_ANONYMIZATION_FLAGS, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 2},
+	IEID_ANONYMIZATION_TECHNIQUE:                      {Name: "anonymizationTechnique", ElementID: IEID_ANONYMIZATION_TECHNIQUE, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_INFORMATION_ELEMENT_INDEX:                    {Name: "informationElementIndex", ElementID: IEID_INFORMATION_ELEMENT_INDEX, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_P2P_TECHNOLOGY:                               {Name: "p2pTechnology", ElementID: IEID_P2P_TECHNOLOGY, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_TUNNEL_TECHNOLOGY:                            {Name: "tunnelTechnology", ElementID: IEID_TUNNEL_TECHNOLOGY, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_ENCRYPTED_TECHNOLOGY:                         {Name: "encryptedTechnology", ElementID: IEID_ENCRYPTED_TECHNOLOGY, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_BASIC_LIST:                                   {Name: "basicList", ElementID: IEID_BASIC_LIST, DataType: DATA_TYPE_BASIC_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_SUB_TEMPLATE_LIST:                            {Name: "subTemplateList", ElementID: IEID_SUB_TEMPLATE_LIST, DataType: DATA_TYPE_SUB_TEMPLATE_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_SUB_TEMPLATE_MULTI_LIST:                      {Name: "subTemplateMultiList", ElementID: IEID_SUB_TEMPLATE_MULTI_LIST, DataType: DATA_TYPE_SUB_TEMPLATE_MULTI_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_BGP_VALIDITY_STATE:                           {Name: "bgpValidityState", ElementID: IEID_BGP_VALIDITY_STATE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_IPSEC_SPI:                                    {Name: "IPSecSPI", ElementID: IEID_IPSEC_SPI, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_GRE_KEY:                                      {Name: "greKey", ElementID: IEID_GRE_KEY, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_NAT_TYPE:                                     {Name: "natType", ElementID: IEID_NAT_TYPE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_INITIATOR_PACKETS:                            {Name: "initiatorPackets", ElementID: IEID_INITIATOR_PACKETS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "packets", Length: 8},
+	IEID_RESPONDER_PACKETS:                            {Name: "responderPackets", ElementID: IEID_RESPONDER_PACKETS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "packets", Length: 8},
+	IEID_OBSERVATION_DOMAIN_NAME:                      {Name: "observationDomainName", ElementID: IEID_OBSERVATION_DOMAIN_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_SELECTION_SEQUENCE_ID:                        {Name: "selectionSequenceId", ElementID: IEID_SELECTION_SEQUENCE_ID, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 8},
+	IEID_SELECTOR_ID:                                  {Name: "selectorId", ElementID: IEID_SELECTOR_ID, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 8},
+	IEID_INFORMATION_ELEMENT_ID:                       {Name: "informationElementId", ElementID: IEID_INFORMATION_ELEMENT_ID, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_SELECTOR_ALGORITHM:                           {Name: "selectorAlgorithm", ElementID: IEID_SELECTOR_ALGORITHM, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_SAMPLING_PACKET_INTERVAL:                     {Name: "samplingPacketInterval", ElementID: IEID_SAMPLING_PACKET_INTERVAL, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "packets", Length: 4},
+	IEID_SAMPLING_PACKET_SPACE:                        {Name: "samplingPacketSpace", ElementID: IEID_SAMPLING_PACKET_SPACE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "packets", Length: 4},
+	IEID_SAMPLING_TIME_INTERVAL:                       {Name: "samplingTimeInterval", ElementID: IEID_SAMPLING_TIME_INTERVAL, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "microseconds", Length: 4},
+	IEID_SAMPLING_TIME_SPACE:                          {Name: "samplingTimeSpace", ElementID: IEID_SAMPLING_TIME_SPACE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "microseconds", Length: 4},
+	IEID_SAMPLING_SIZE:                                {Name: "samplingSize", ElementID: IEID_SAMPLING_SIZE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "packets", Length: 4},
+	IEID_SAMPLING_POPULATION:                          {Name: "samplingPopulation", ElementID: IEID_SAMPLING_POPULATION, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "packets", Length: 4},
+	IEID_SAMPLING_PROBABILITY:                         {Name: "samplingProbability", ElementID: IEID_SAMPLING_PROBABILITY, DataType: DATA_TYPE_FLOAT64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_DATA_LINK_FRAME_SIZE:                         {Name: "dataLinkFrameSize", ElementID: IEID_DATA_LINK_FRAME_SIZE, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 2},
+	IEID_IP_HEADER_PACKET_SECTION:                     {Name: "ipHeaderPacketSection", ElementID: IEID_IP_HEADER_PACKET_SECTION, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_IP_PAYLOAD_PACKET_SECTION:                    {Name: "ipPayloadPacketSection", ElementID: IEID_IP_PAYLOAD_PACKET_SECTION, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_DATA_LINK_FRAME_SECTION:                      {Name: "dataLinkFrameSection", ElementID: IEID_DATA_LINK_FRAME_SECTION, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_LABEL_STACK_SECTION:                     {Name: "mplsLabelStackSection", ElementID: IEID_MPLS_LABEL_STACK_SECTION, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MPLS_PAYLOAD_PACKET_SECTION:                  {Name: "mplsPayloadPacketSection", ElementID: IEID_MPLS_PAYLOAD_PACKET_SECTION, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_SELECTOR_ID_TOTAL_PKTS_OBSERVED:              {Name: "selectorIdTotalPktsObserved", ElementID: IEID_SELECTOR_ID_TOTAL_PKTS_OBSERVED, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_SELECTOR_ID_TOTAL_PKTS_SELECTED:              {Name: "selectorIdTotalPktsSelected", ElementID: IEID_SELECTOR_ID_TOTAL_PKTS_SELECTED, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_ABSOLUTE_ERROR:                               {Name: "absoluteError", ElementID: IEID_ABSOLUTE_ERROR, DataType: DATA_TYPE_FLOAT64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "inferred", Length: 8},
+	IEID_RELATIVE_ERROR:                               {Name: "relativeError", ElementID: IEID_RELATIVE_ERROR, DataType: DATA_TYPE_FLOAT64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_OBSERVATION_TIME_SECONDS:                     {Name: "observationTimeSeconds", ElementID: IEID_OBSERVATION_TIME_SECONDS, DataType: DATA_TYPE_DATE_TIME_SECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "seconds", Length: 4},
+	IEID_OBSERVATION_TIME_MILLI_SECONDS:               {Name: "observationTimeMilliseconds", ElementID: IEID_OBSERVATION_TIME_MILLI_SECONDS, DataType: DATA_TYPE_DATE_TIME_MILLISECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 8},
+	IEID_OBSERVATION_TIME_MICRO_SECONDS:               {Name: "observationTimeMicroseconds", ElementID: IEID_OBSERVATION_TIME_MICRO_SECONDS, DataType: DATA_TYPE_DATE_TIME_MICROSECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "microseconds", Length: 8},
+	IEID_OBSERVATION_TIME_NANO_SECONDS:                {Name: "observationTimeNanoseconds", ElementID: IEID_OBSERVATION_TIME_NANO_SECONDS, DataType: DATA_TYPE_DATE_TIME_NANOSECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "nanoseconds", Length: 8},
+	IEID_DIGEST_HASH_VALUE:                            {Name: "digestHashValue", ElementID: IEID_DIGEST_HASH_VALUE, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_HASH_IP_PAYLOAD_OFFSET:                       {Name: "hashIPPayloadOffset", ElementID: IEID_HASH_IP_PAYLOAD_OFFSET, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_HASH_IP_PAYLOAD_SIZE:                         {Name: "hashIPPayloadSize", ElementID: IEID_HASH_IP_PAYLOAD_SIZE, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_HASH_OUTPUT_RANGE_MIN:                        {Name: "hashOutputRangeMin", ElementID: IEID_HASH_OUTPUT_RANGE_MIN, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_HASH_OUTPUT_RANGE_MAX:                        {Name: "hashOutputRangeMax", ElementID: IEID_HASH_OUTPUT_RANGE_MAX, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_HASH_SELECTED_RANGE_MIN:                      {Name: "hashSelectedRangeMin", ElementID: IEID_HASH_SELECTED_RANGE_MIN, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_HASH_SELECTED_RANGE_MAX:                      {Name: "hashSelectedRangeMax", ElementID: IEID_HASH_SELECTED_RANGE_MAX, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_HASH_DIGEST_OUTPUT:                           {Name: "hashDigestOutput", ElementID: IEID_HASH_DIGEST_OUTPUT, DataType: DATA_TYPE_BOOLEAN, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_HASH_INITIALISATION_VALUE:                    {Name: "hashInitialiserValue", ElementID: IEID_HASH_INITIALISATION_VALUE, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_SELECTOR_NAME:                                {Name: "selectorName", ElementID: IEID_SELECTOR_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_UPPER_CI_LIMIT:                               {Name: "upperCILimit", ElementID: IEID_UPPER_CI_LIMIT, DataType: DATA_TYPE_FLOAT64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_LOWER_CI_LIMIT:                               {Name: "lowerCILimit", ElementID: IEID_LOWER_CI_LIMIT, DataType: DATA_TYPE_FLOAT64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_CONFIDENCE_LEVEL:                             {Name: "confidenceLevel", ElementID: IEID_CONFIDENCE_LEVEL, DataType: DATA_TYPE_FLOAT64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_INFORMATION_ELEMENT_DATA_TYPE:                {Name: "informationElementDataType", ElementID: IEID_INFORMATION_ELEMENT_DATA_TYPE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_INFORMATION_ELEMENT_DESCRIPTION:              {Name: "informationElementDescription", ElementID: IEID_INFORMATION_ELEMENT_DESCRIPTION, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_INFORMATION_ELEMENT_NAME:                     {Name: "informationElementName", ElementID: IEID_INFORMATION_ELEMENT_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_INFORMATION_ELEMENT_RANGE_BEGIN:              {Name: "informationElementRangeBegin", ElementID: IEID_INFORMATION_ELEMENT_RANGE_BEGIN, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_INFORMATION_ELEMENT_RANGE_END:                {Name: "informationElementRangeEnd", ElementID: IEID_INFORMATION_ELEMENT_RANGE_END, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 8},
+	IEID_INFORMATION_ELEMENT_SEMANTICS:                {Name: "informationElementSemantics", ElementID: IEID_INFORMATION_ELEMENT_SEMANTICS, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_INFORMATION_ELEMENT_UNITS:                    {Name: "informationElementUnits", ElementID: IEID_INFORMATION_ELEMENT_UNITS, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 2},
+	IEID_PRIVATE_ENTERPRISE_NUMBER:                    {Name: "privateEnterpriseNumber", ElementID: IEID_PRIVATE_ENTERPRISE_NUMBER, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_VIRTUAL_STATION_INTERFACE_ID:                 {Name: "virtualStationInterfaceId", ElementID: IEID_VIRTUAL_STATION_INTERFACE_ID, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_VIRTUAL_STATION_INTERFACE_NAME:               {Name: "virtualStationInterfaceName", ElementID: IEID_VIRTUAL_STATION_INTERFACE_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_VIRTUAL_STATION_UUID:                         {Name: "virtualStationUUID", ElementID: IEID_VIRTUAL_STATION_UUID, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_VIRTUAL_STATION_NAME:                         {Name: "virtualStationName", ElementID: IEID_VIRTUAL_STATION_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_LAYER2_SEGMENT_ID:                            {Name: "layer2SegmentId", ElementID: IEID_LAYER2_SEGMENT_ID, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 8},
+	IEID_LAYER2_OCTET_DELTA_COUNT:                     {Name: "layer2OctetDeltaCount", ElementID: IEID_LAYER2_OCTET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_LAYER2_OUCET_TOTAL_COUNT:                     {Name: "layer2OctetTotalCount", ElementID: IEID_LAYER2_OUCET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_INGRESS_UNICAST_PACKET_TOTAL_COUNT:           {Name: "ingressUnicastPacketTotalCount", ElementID: IEID_INGRESS_UNICAST_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_INGRESS_MULTICAST_PACKET_TOTAL_COUNT:         {Name: "ingressMulticastPacketTotalCount", ElementID: IEID_INGRESS_MULTICAST_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_INGRESS_BROADCAST_PACKET_TOTAL_COUNT:         {Name: "ingressBroadcastPacketTotalCount", ElementID: IEID_INGRESS_BROADCAST_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_EGRESS_UNICAST_PACKET_TOTAL_COUNT:            {Name: "egressUnicastPacketTotalCount", ElementID: IEID_EGRESS_UNICAST_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_EGRESS_BROADCAST_PACKET_TOTAL_COUNT:          {Name: "egressBroadcastPacketTotalCount", ElementID: IEID_EGRESS_BROADCAST_PACKET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "packets", Length: 8},
+	IEID_MONITORING_INTERVAL_START_MILLI_SECOUNDS:     {Name: "monitoringIntervalStartMilliSeconds", ElementID: IEID_MONITORING_INTERVAL_START_MILLI_SECOUNDS, DataType: DATA_TYPE_DATE_TIME_MILLISECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 8},
+	IEID_MONITORING_INTERVAL_END_MILLI_SECOUNDS:       {Name: "monitoringIntervalEndMilliSeconds", ElementID: IEID_MONITORING_INTERVAL_END_MILLI_SECOUNDS, DataType: DATA_TYPE_DATE_TIME_MILLISECONDS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "milliseconds", Length: 8},
+	IEID_PORT_RANGE_START:                             {Name: "portRangeStart", ElementID: IEID_PORT_RANGE_START, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_PORT_RANGE_END:                               {Name: "portRangeEnd", ElementID: IEID_PORT_RANGE_END, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_PORT_RANGE_STEP_SIZE:                         {Name: "portRangeStepSize", ElementID: IEID_PORT_RANGE_STEP_SIZE, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_PORT_RANGE_NUM_PORTS:                         {Name: "portRangeNumPorts", ElementID: IEID_PORT_RANGE_NUM_PORTS, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_STA_MAC_ADDRESS:                              {Name: "staMacAddress", ElementID: IEID_STA_MAC_ADDRESS, DataType: DATA_TYPE_MAC_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 6},
+	IEID_STA_IPV4_ADDRESS:                             {Name: "staIPv4Address", ElementID: IEID_STA_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_WTP_MAC_ADDRESS:                              {Name: "wtpMacAddress", ElementID: IEID_WTP_MAC_ADDRESS, DataType: DATA_TYPE_MAC_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 6},
+	IEID_INGRESS_INTERFACE_TYPE:                       {Name: "ingressInterfaceType", ElementID: IEID_INGRESS_INTERFACE_TYPE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_EGRESS_INTERFACE_TYPE:                        {Name: "egressInterfaceType", ElementID: IEID_EGRESS_INTERFACE_TYPE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_RTP_SEQUENCE_NUMBER:                          {Name: "rtpSequenceNumber", ElementID: IEID_RTP_SEQUENCE_NUMBER, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 2},
+	IEID_USER_NAME:                                    {Name: "userName", ElementID: IEID_USER_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_APPLICATION_CATEGORY_NAME:                    {Name: "applicationCategoryName", ElementID: IEID_APPLICATION_CATEGORY_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_APPLICATION_SUB_CATEGORY_NAME:                {Name: "applicationSubCategoryName", ElementID: IEID_APPLICATION_SUB_CATEGORY_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_APPLICATION_GROUP_NAME:                       {Name: "applicationGroupName", ElementID: IEID_APPLICATION_GROUP_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_ORIGINAL_FLOWS_PRESENT:                       {Name: "originalFlowsPresent", ElementID: IEID_ORIGINAL_FLOWS_PRESENT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "flows", Length: 8},
+	IEID_ORIGINAL_FLOWS_INITIATED:                     {Name: "originalFlowsInitiated", ElementID: IEID_ORIGINAL_FLOWS_INITIATED, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "flows", Length: 8},
+	IEID_ORIGINAL_FLOWS_COMPLETED:                     {Name: "originalFlowsCompleted", ElementID: IEID_ORIGINAL_FLOWS_COMPLETED, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "flows", Length: 8},
+	IEID_DISTINCT_COUNT_OF_SOURCE_IP_ADDRESS:          {Name: "distinctCountOfSourceIPAddress", ElementID: IEID_DISTINCT_COUNT_OF_SOURCE_IP_ADDRESS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Length: 8},
+	IEID_DISTINCT_COUNT_OF_DESTINATION_IP_ADDRESS:     {Name: "distinctCountOfDestinationIPAddress", ElementID: IEID_DISTINCT_COUNT_OF_DESTINATION_IP_ADDRESS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Length: 8},
+	IEID_DISTINCT_COUNT_OF_SOURCE_IPV4_ADDRESS:        {Name: "distinctCountOfSourceIPv4Address", ElementID: IEID_DISTINCT_COUNT_OF_SOURCE_IPV4_ADDRESS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Length: 4},
+	IEID_DISTINCT_COUNT_OF_DESTINATION_IPV4_ADDRESS:   {Name: "distinctCountOfDestinationIPv4Address", ElementID: IEID_DISTINCT_COUNT_OF_DESTINATION_IPV4_ADDRESS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Length: 4},
+	IEID_DISTINCT_COUNT_OF_SOURCE_IPV6_ADDRESS:        {Name: "distinctCountOfSourceIPv6Address", ElementID: IEID_DISTINCT_COUNT_OF_SOURCE_IPV6_ADDRESS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Length: 8},
+	IEID_DISTINCT_COUNT_OF_DESTINATION_IPV6_ADDRESS:   {Name: "distinctCountOfDestinationIPv6Address", ElementID: IEID_DISTINCT_COUNT_OF_DESTINATION_IPV6_ADDRESS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Length: 8},
+	IEID_VALUE_DISTRIBUTION_METHOD:                    {Name: "valueDistributionMethod", ElementID: IEID_VALUE_DISTRIBUTION_METHOD, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_RFC3550_JITTER_MILLISECONDS:                  {Name: "rfc3550JitterMilliseconds", ElementID: IEID_RFC3550_JITTER_MILLISECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "milliseconds", Length: 4},
+	IEID_RFC3550_JITTER_MICROSECONDS:                  {Name: "rfc3550JitterMicroseconds", ElementID: IEID_RFC3550_JITTER_MICROSECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "microseconds", Length: 4},
+	IEID_RFC3550_JITTER_NANOSECONDS:                   {Name: "rfc3550JitterNanoseconds", ElementID: IEID_RFC3550_JITTER_NANOSECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "nanoseconds", Length: 4},
+	IEID_DOT1Q_DEI:                                    {Name: "dot1qDEI", ElementID: IEID_DOT1Q_DEI, DataType: DATA_TYPE_BOOLEAN, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_DOT1Q_CUSTOMER_DEI:                           {Name: "dot1qCustomerDEI", ElementID: IEID_DOT1Q_CUSTOMER_DEI, DataType: DATA_TYPE_BOOLEAN, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 1},
+	IEID_FLOW_SELECTOR_ALGORITHM:                      {Name: "flowSelectorAlgorithm", ElementID: IEID_FLOW_SELECTOR_ALGORITHM, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_FLOW_SELECTED_OCTET_DELTA_COUNT:              {Name: "flowSelectedOctetDeltaCount", ElementID: IEID_FLOW_SELECTED_OCTET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_FLOW_SELECTED_PACKET_DELTA_COUNT:             {Name: "flowSelectedPacketDeltaCount", ElementID: IEID_FLOW_SELECTED_PACKET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "packets", Length: 8},
+	IEID_FLOW_SELECTED_FLOW_DELTA_COUNT:               {Name: "flowSelectedFlowDeltaCount", ElementID: IEID_FLOW_SELECTED_FLOW_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "flows", Length: 8},
+	IEID_SELECTOR_ID_TOTAL_FLOWS_OBSERVED:             {Name: "selectorIDTotalFlowsObserved", ElementID: IEID_SELECTOR_ID_TOTAL_FLOWS_OBSERVED, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "flows", Length: 8},
+	IEID_SELECTOR_ID_TOTAL_FLOWS_SELECTED:             {Name: "selectorIDTotalFlowsSelected", ElementID: IEID_SELECTOR_ID_TOTAL_FLOWS_SELECTED, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "flows", Length: 8},
+	IEID_SAMPLING_FLOW_INTERVAL:                       {Name: "samplingFlowInterval", ElementID: IEID_SAMPLING_FLOW_INTERVAL, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "flows", Length: 8},
+	IEID_SAMPLING_FLOW_SPACING:                        {Name: "samplingFlowSpacing", ElementID: IEID_SAMPLING_FLOW_SPACING, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "flows", Length: 8},
+	IEID_FLOW_SAMPLING_TIME_INTERVAL:                  {Name: "flowSamplingTimeInterval", ElementID: IEID_FLOW_SAMPLING_TIME_INTERVAL, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "microseconds", Length: 8},
+	IEID_FLOW_SAMPLING_TIME_SPACING:                   {Name: "flowSamplingTimeSpacing", ElementID: IEID_FLOW_SAMPLING_TIME_SPACING, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "microseconds", Length: 8},
+	IEID_HASH_FLOW_DOMAIN:                             {Name: "hashFlowDomain", ElementID: IEID_HASH_FLOW_DOMAIN, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_TRANSPORT_OCTET_DELTA_COUNT:                  {Name: "transportOctetDeltaCount", ElementID: IEID_TRANSPORT_OCTET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_TRANSPORT_PACKET_DELTA_COUNT:                 {Name: "transportPacketDeltaCount", ElementID: IEID_TRANSPORT_PACKET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "packets", Length: 8},
+	IEID_ORIGINAL_EXPORTER_IPV4_ADDRESS:               {Name: "originalExporterIPv4Address", ElementID: IEID_ORIGINAL_EXPORTER_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_ORIGINAL_EXPORTER_IPV6_ADDRESS:               {Name: "originalExporterIPv6Address", ElementID: IEID_ORIGINAL_EXPORTER_IPV6_ADDRESS, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_ORIGINAL_OBSERVATION_DOMAIN_ID:               {Name: "originalObservationDomainId", ElementID: IEID_ORIGINAL_OBSERVATION_DOMAIN_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_INTERMEDIATE_PROCESS_ID:                      {Name: "intermediateProcessId", ElementID: IEID_INTERMEDIATE_PROCESS_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_IGNORED_DATA_RECORD_TOTAL_COUNT:              {Name: "ignoredDataRecordTotalCount", ElementID: IEID_IGNORED_DATA_RECORD_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Length: 8},
+	IEID_DATA_LINK_FRAME_TYPE:                         {Name: "dataLinkFrameType", ElementID: IEID_DATA_LINK_FRAME_TYPE, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 2},
+	IEID_SECTION_OFFSET:                               {Name: "sectionOffset", ElementID: IEID_SECTION_OFFSET, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 2},
+	IEID_SECTION_EXPORTED_OCTETS:                      {Name: "sectionExportedOctets", ElementID: IEID_SECTION_EXPORTED_OCTETS, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 2},
+	IEID_DOT1Q_SERVICE_INSTANCE_TAG:                   {Name: "dot1qServiceInstanceTag", ElementID: IEID_DOT1Q_SERVICE_INSTANCE_TAG, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_DOT1Q_SERVICE_INSTANCE_ID:                    {Name: "dot1qServiceInstanceId", ElementID: IEID_DOT1Q_SERVICE_INSTANCE_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_DOT1Q_SERVICE_INSTANCE_PRIORITY:              {Name: "dot1qServiceInstancePriority", ElementID: IEID_DOT1Q_SERVICE_INSTANCE_PRIORITY, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_DOT1Q_CUSTOMER_SOURCE_MAC_ADDRESS:            {Name: "dot1qCustomerSourceMacAddress", ElementID: IEID_DOT1Q_CUSTOMER_SOURCE_MAC_ADDRESS, DataType: DATA_TYPE_MAC_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 6},
+	IEID_DOT1Q_CUSTOMER_DESTINATION_MAC_ADDRESS:       {Name: "dot1qCustomerDestinationMacAddress", ElementID: IEID_DOT1Q_CUSTOMER_DESTINATION_MAC_ADDRESS, DataType: DATA_TYPE_MAC_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 6},
+	IEID_POST_LAYER2_OCTET_DELTA_COUNT:                {Name: "postLayer2OctetDeltaCount", ElementID: IEID_POST_LAYER2_OCTET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_POST_MCAST_LAYER2_OCTET_DELTA_COUNT:          {Name: "postMCastLayer2OctetDeltaCount", ElementID: IEID_POST_MCAST_LAYER2_OCTET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_POST_LAYER2_OCTET_TOTAL_COUNT:                {Name: "postLayer2OctetTotalCount", ElementID: IEID_POST_LAYER2_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_POST_MCAST_LAYER2_OCTET_TOTAL_COUNT:          {Name: "postMCastLayer2OctetTotalCount", ElementID: IEID_POST_MCAST_LAYER2_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_MINIMUM_LAYER2_TOTAL_LENGTH:                  {Name: "minimumLayer2TotalLength", ElementID: IEID_MINIMUM_LAYER2_TOTAL_LENGTH, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 8},
+	IEID_MAXIMUM_LAYER2_TOTAL_LENGTH:                  {Name: "maximumLayer2TotalLength", ElementID: IEID_MAXIMUM_LAYER2_TOTAL_LENGTH, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Units: "octets", Length: 8},
+	IEID_DROPPED_LAYER2_OCTET_DELTA_COUNT:             {Name: "droppedLayer2OctetDeltaCount", ElementID: IEID_DROPPED_LAYER2_OCTET_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	IEID_DROPPED_LAYER2_OCTET_TOTAL_COUNT:             {Name: "droppedLayer2OctetTotalCount", ElementID: IEID_DROPPED_LAYER2_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_IGNORED_LAYER2_OCTET_TOTAL_COUNT:             {Name: "ignoredLayer2OctetTotalCount", ElementID: IEID_IGNORED_LAYER2_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_NOT_SENT_LAYER2_OCTET_TOTAL_COUNT:            {Name: "notSentLayer2OctetTotalCount", ElementID: IEID_NOT_SENT_LAYER2_OCTET_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_LAYER2_OCTET_DELTA_SUM_OF_SQUARES:            {Name: "layer2OctetDeltaSumOfSquares", ElementID: IEID_LAYER2_OCTET_DELTA_SUM_OF_SQUARES, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "octets", Length: 8},
+	429:                                               {Name: "layer2OctetTotalSumOfSquares", ElementID: 429, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "octets", Length: 8},
+	IEID_LAYER2_FRAME_DELTA_COUNT:                     {Name: "layer2FrameDeltaCount", ElementID: IEID_LAYER2_FRAME_DELTA_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "frames", Length: 8},
+	IEID_LAYER2_FRAME_TOTAL_COUNT:                     {Name: "layer2FrameTotalCount", ElementID: IEID_LAYER2_FRAME_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "frames", Length: 8},
+	IEID_PSEUDOWIRE_DESTINATION_IPV4_ADDRESS:          {Name: "pseudoWireDestinationIPv4Address", ElementID: IEID_PSEUDOWIRE_DESTINATION_IPV4_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_IGNORED_LAYER2_FRAME_TOTAL_COUNT:             {Name: "ignoredLayer2FrameTotalCount", ElementID: IEID_IGNORED_LAYER2_FRAME_TOTAL_COUNT, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_TOTAL_COUNTER, Units: "frames", Length: 8},
+	IEID_MIB_OBJECT_VALUE_INTEGER:                     {Name: "mibObjectValueInteger", ElementID: IEID_MIB_OBJECT_VALUE_INTEGER, DataType: DATA_TYPE_SIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 4},
+	IEID_MIB_OBJECT_VALUE_OCTET_STRING:                {Name: "mibObjectValueOctetString", ElementID: IEID_MIB_OBJECT_VALUE_OCTET_STRING, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MIB_OBJECT_VALUE_OID:                         {Name: "mibObjectValueOID", ElementID: IEID_MIB_OBJECT_VALUE_OID, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MIB_OBJECT_VALUE_BITS:                        {Name: "mibObjectValueBits", ElementID: IEID_MIB_OBJECT_VALUE_BITS, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: VARIABLE_LENGTH},
+	IEID_MIB_OBJECT_VALUE_IP_ADDRESS:                  {Name: "mibObjectValueIPAddress", ElementID: IEID_MIB_OBJECT_VALUE_IP_ADDRESS, DataType: DATA_TYPE_IPV4_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 4},
+	IEID_MIB_OBJECT_VALUE_COUNTER:                     {Name: "mibObjectValueCounter", ElementID: IEID_MIB_OBJECT_VALUE_COUNTER, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_SNMP_COUNTER, Length: 8},
+	IEID_MIB_OBJECT_VALUE_GAUGE:                       {Name: "mibObjectValueGauge", ElementID: IEID_MIB_OBJECT_VALUE_GAUGE, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_SNMP_GAUGE, Length: 4},
+	IEID_MIB_OBJECT_VALUE_TIME_TICKS:                  {Name: "mibObjectValueTimeTicks", ElementID: IEID_MIB_OBJECT_VALUE_TIME_TICKS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 4},
+	IEID_MIB_OBJECT_VALUE_UNSIGNED:                    {Name: "mibObjectValueUnsigned", ElementID: IEID_MIB_OBJECT_VALUE_UNSIGNED, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 4},
+	IEID_MIB_OBJECT_VALUE_TABLE:                       {Name: "mibObjectValueTable", ElementID: IEID_MIB_OBJECT_VALUE_TABLE, DataType: DATA_TYPE_SUB_TEMPLATE_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_MIB_OBJECT_VALUE_ROW:                         {Name: "mibObjectValueRow", ElementID: IEID_MIB_OBJECT_VALUE_ROW, DataType: DATA_TYPE_SUB_TEMPLATE_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_MIB_OBJECT_IDENTIFIER:                        {Name: "mibObjectIdentifier", ElementID: IEID_MIB_OBJECT_IDENTIFIER, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MIB_SUB_IDENTIFIER:                           {Name: "mibSubIdentifier", ElementID: IEID_MIB_SUB_IDENTIFIER, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_MIB_INDEX_INDICATOR:                          {Name: "mibIndexIndicator", ElementID: IEID_MIB_INDEX_INDICATOR, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 8},
+	IEID_MIB_CAPTURE_TIME_SEMICOLONS:                  {Name: "mibCaptureTimeSemantics", ElementID: IEID_MIB_CAPTURE_TIME_SEMICOLONS, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_MIB_CONTEXT_ENGINE_ID:                        {Name: "mibContextEngineID", ElementID: IEID_MIB_CONTEXT_ENGINE_ID, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MIB_CONTEXT_NAME:                             {Name: "mibContextName", ElementID: IEID_MIB_CONTEXT_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MIB_OBJECT_NAME:                              {Name: "mibObjectName", ElementID: IEID_MIB_OBJECT_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MIB_OBJECT_DESCRIPTION:                       {Name: "mibObjectDescription", ElementID: IEID_MIB_OBJECT_DESCRIPTION, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MIB_OBJECT_SYNTAX:                            {Name: "mibObjectSyntax", ElementID: IEID_MIB_OBJECT_SYNTAX, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MIB_MODULE_NAME:                              {Name: "mibModuleName", ElementID: IEID_MIB_MODULE_NAME, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MOBILE_IMSI:                                  {Name: "mobileIMSI", ElementID: IEID_MOBILE_IMSI, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MOBILE_MSISDN:                                {Name: "mobileMSISDN", ElementID: IEID_MOBILE_MSISDN, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_HTTP_STATUS_CODE:                             {Name: "httpStatusCode", ElementID: IEID_HTTP_STATUS_CODE, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_SOURCE_TRANSPORT_PORTS_LIMIT:                 {Name: "sourceTransportPortsLimit", ElementID: IEID_SOURCE_TRANSPORT_PORTS_LIMIT, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "ports", Length: 2},
+	IEID_HTTP_REQUEST_METHOD:                          {Name: "httpRequestMethod", ElementID: IEID_HTTP_REQUEST_METHOD, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_HTTP_REQUEST_HOST:                            {Name: "httpRequestHost", ElementID: IEID_HTTP_REQUEST_HOST, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_HTTP_REQUEST_TARGET:                          {Name: "httpRequestTarget", ElementID: IEID_HTTP_REQUEST_TARGET, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_HTTP_MESSAGE_VERSION:                         {Name: "httpMessageVersion", ElementID: IEID_HTTP_MESSAGE_VERSION, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_NAT_INSTANCE_ID:                              {Name: "natInstanceID", ElementID: IEID_NAT_INSTANCE_ID, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_INTERNAL_ADDRESS_REALM:                       {Name: "internalAddressRealm", ElementID: IEID_INTERNAL_ADDRESS_REALM, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: VARIABLE_LENGTH},
+	IEID_EXTERNAL_ADDRESS_REALM:                       {Name: "externalAddressRealm", ElementID: IEID_EXTERNAL_ADDRESS_REALM, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: VARIABLE_LENGTH},
+	IEID_NAT_QUOTA_EXCEEDED_EVENT:                     {Name: "natQuotaExceededEvent", ElementID: IEID_NAT_QUOTA_EXCEEDED_EVENT, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_NAT_THRESHOLD_EVENT:                          {Name: "natThresholdEvent", ElementID: IEID_NAT_THRESHOLD_EVENT, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_HTTP_USER_AGENT:                              {Name: "httpUserAgent", ElementID: IEID_HTTP_USER_AGENT, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_HTTP_CONTENT_TYPE:                            {Name: "httpContentType", ElementID: IEID_HTTP_CONTENT_TYPE, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_HTTP_REASON_PHRASE:                           {Name: "httpReasonPhrase", ElementID: IEID_HTTP_REASON_PHRASE, DataType: DATA_TYPE_STRING, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_MAX_SESSION_ENTRIES:                          {Name: "maxSessionEntries", ElementID: IEID_MAX_SESSION_ENTRIES, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_MAX_BIB_ENTRIES:                              {Name: "maxBIBEntries", ElementID: IEID_MAX_BIB_ENTRIES, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_MAX_ENTRIES_PER_USER:                         {Name: "maxEntriesPerUser", ElementID: IEID_MAX_ENTRIES_PER_USER, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_MAX_SUBSCRIBERS:                              {Name: "maxSubscribers", ElementID: IEID_MAX_SUBSCRIBERS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_MAX_FRAGMENTS_PENDING_REASSEMBLY:             {Name: "maxFragmentsPendingReassembly", ElementID: IEID_MAX_FRAGMENTS_PENDING_REASSEMBLY, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_ADDRESS_POOL_HIGH_THRESHOLD:                  {Name: "addressPoolHighThreshold", ElementID: IEID_ADDRESS_POOL_HIGH_THRESHOLD, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_ADDRESS_POOL_LOW_THRESHOLD:                   {Name: "addressPoolLowThreshold", ElementID: IEID_ADDRESS_POOL_LOW_THRESHOLD, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_ADDRESS_POOL_MAPPING_HIGH_THRESHOLD:          {Name: "addressPortMappingHighThreshold", ElementID: IEID_ADDRESS_POOL_MAPPING_HIGH_THRESHOLD, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_ADDRESS_POOL_MAPPING_LOW_THRESHOLD:           {Name: "addressPortMappingLowThreshold", ElementID: IEID_ADDRESS_POOL_MAPPING_LOW_THRESHOLD, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_ADDRESS_POOL_MAPPING_PER_USER_HIGH_THRESHOLD: {Name: "addressPortMappingPerUserHighThreshold", ElementID: IEID_ADDRESS_POOL_MAPPING_PER_USER_HIGH_THRESHOLD, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_GLOBAL_ADDRESS_MAPPING_HIGH_THRESHOLD:        {Name: "globalAddressMappingHighThreshold", ElementID: IEID_GLOBAL_ADDRESS_MAPPING_HIGH_THRESHOLD, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_VPN_IDENTIFIER:                               {Name: "vpnIdentifier", ElementID: IEID_VPN_IDENTIFIER, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_BGP_COMMUNITY:                                {Name: "bgpCommunity", ElementID: IEID_BGP_COMMUNITY, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 4},
+	IEID_BGP_SOURCE_COMMUNITY_LIST:                    {Name: "bgpSourceCommunityList", ElementID: IEID_BGP_SOURCE_COMMUNITY_LIST, DataType: DATA_TYPE_BASIC_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_BGP_DESTINATION_COMMUNITY_LIST:               {Name: "bgpDestinationCommunityList", ElementID: IEID_BGP_DESTINATION_COMMUNITY_LIST, DataType: DATA_TYPE_BASIC_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_BGP_EXTENDED_COMMUNITY:                       {Name: "bgpExtendedCommunity", ElementID: IEID_BGP_EXTENDED_COMMUNITY, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_BGP_SOURCE_EXTENDED_COMMUNITY_LIST:           {Name: "bgpSourceExtendedCommunityList", ElementID: IEID_BGP_SOURCE_EXTENDED_COMMUNITY_LIST, DataType: DATA_TYPE_BASIC_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_BGP_DESTINATION_EXTENDED_COMMUNITY_LIST:      {Name: "bgpDestinationExtendedCommunityList", ElementID: IEID_BGP_DESTINATION_EXTENDED_COMMUNITY_LIST, DataType: DATA_TYPE_BASIC_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_BGP_LARGE_COMMUNITY:                          {Name: "bgpLargeCommunity", ElementID: IEID_BGP_LARGE_COMMUNITY, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_BGP_SOURCE_LARGE_COMMUNITY_LIST:              {Name: "bgpSourceLargeCommunityList", ElementID: IEID_BGP_SOURCE_LARGE_COMMUNITY_LIST, DataType: DATA_TYPE_BASIC_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_BGP_DESTINATION_LARGE_COMMUNITY_LIST:         {Name: "bgpDestinationLargeCommunityList", ElementID: IEID_BGP_DESTINATION_LARGE_COMMUNITY_LIST, DataType: DATA_TYPE_BASIC_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_SRH_FLAGS_IPV6:                               {Name: "srhFlagsIPv6", ElementID: IEID_SRH_FLAGS_IPV6, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_FLAGS, Length: 1},
+	IEID_SRH_TAG_IPV6:                                 {Name: "srhTagIPv6", ElementID: IEID_SRH_TAG_IPV6, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+	IEID_SRH_SEGMENT_IPV6:                             {Name: "srhSegmentIPv6", ElementID: IEID_SRH_SEGMENT_IPV6, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_SRH_ACTIVE_SEGMENT_IPV6:                      {Name: "srhActiveSegmentIPv6", ElementID: IEID_SRH_ACTIVE_SEGMENT_IPV6, DataType: DATA_TYPE_IPV6_ADDRESS, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: 16},
+	IEID_SRH_SEGMENT_IPV6_BASIC_LIST:                  {Name: "srhSegmentIPv6BasicList", ElementID: IEID_SRH_SEGMENT_IPV6_BASIC_LIST, DataType: DATA_TYPE_BASIC_LIST, Semantics: DATA_TYPE_SEMANTICS_LIST, Length: VARIABLE_LENGTH},
+	IEID_SRH_SEGMENT_IPV6_LIST_SECTION:                {Name: "srhSegmentIPv6ListSection", ElementID: IEID_SRH_SEGMENT_IPV6_LIST_SECTION, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_SRH_SEGMENT_IPV6_LEFT:                        {Name: "srhSegmentsIPv6Left", ElementID: IEID_SRH_SEGMENT_IPV6_LEFT, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Length: 1},
+	IEID_SRH_IPV6_SECTION:                             {Name: "srhIPv6Section", ElementID: IEID_SRH_IPV6_SECTION, DataType: DATA_TYPE_OCTET_ARRAY, Semantics: DATA_TYPE_SEMANTICS_DEFAULT, Length: VARIABLE_LENGTH},
+	IEID_SRH_IPV6_ACTIVE_SEGMENT_TYPE:                 {Name: "srhIPv6ActiveSegmentType", ElementID: IEID_SRH_IPV6_ACTIVE_SEGMENT_TYPE, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 1},
+	IEID_SRH_SEGMENT_IPV6_LOCATOR_LENGTH:              {Name: "srhSegmentIPv6LocatorLength", ElementID: IEID_SRH_SEGMENT_IPV6_LOCATOR_LENGTH, DataType: DATA_TYPE_UNSIGNED8, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "bits", Length: 1},
+	IEID_SRH_SEGMENT_IPV6_ENDPOINT_BEHAVIOR:           {Name: "srhSegmentIPv6EndpointBehavior", ElementID: IEID_SRH_SEGMENT_IPV6_ENDPOINT_BEHAVIOR, DataType: DATA_TYPE_UNSIGNED16, Semantics: DATA_TYPE_SEMANTICS_IDENTIFIER, Length: 2},
+}
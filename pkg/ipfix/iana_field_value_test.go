@@ -0,0 +1,149 @@
+package ipfix
+
+import (
+	"bytes"
+	"net"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIANAInformationElements(t *testing.T) {
+	for id, ie := range ianaInformationElements {
+		newFieldValue, ok := fieldValueTypes[id]
+		if !ok {
+			newFieldValue, ok = ianaFieldValueTypes[id]
+		}
+		if !ok {
+			t.Errorf("%s has no field value", ie.Name)
+			continue
+		}
+
+		fv := newFieldValue()
+		fs := fv.FieldSpecifier()
+		if fv.ElementID() != id || fs.InformationElementID != id {
+			t.Errorf("%s: got element id %d want %d", ie.Name, fv.ElementID(), id)
+		}
+		if fs.FieldLength != ie.Length {
+			t.Errorf("%s: got field length %d want %d", ie.Name, fs.FieldLength, ie.Length)
+		}
+
+		data := fv.Serialize()
+		if int(fv.Len()) != len(data) {
+			t.Errorf("%s: got Len %d want %d", ie.Name, fv.Len(), len(data))
+		}
+		got := DecodeFieldValue(data, *fs)
+		if reflect.TypeOf(got) != reflect.TypeOf(fv) {
+			t.Errorf("%s: got %T want %T", ie.Name, got, fv)
+		}
+	}
+}
+
+func TestIANAFieldValues(t *testing.T) {
+	now := time.Date(2023, 10, 25, 12, 0, 0, 123456789, time.UTC)
+	for _, tc := range []struct {
+		fv   FieldValue
+		want FieldValue // decoded field value if it differs from fv
+	}{
+		{fv: &OctetDeltaCount{Val: 1 << 40}},
+		{fv: &MibObjectValueInteger{Val: -2}},
+		{fv: &SamplingProbability{Val: 0.25}},
+		{fv: &DataRecordsReliability{Val: true}},
+		{fv: &DataRecordsReliability{Val: false}},
+		{fv: &SourceMacAddress{Val: net.HardwareAddr{0x00, 0x00, 0x5e, 0x00, 0x53, 0x01}}},
+		{fv: &InterfaceName{Val: "ens192"}},
+		{fv: &SourceIPv4Address{Val: netip.MustParseAddr("192.0.2.1")}},
+		{fv: &SourceIPv6Address{Val: netip.MustParseAddr("2001:db8::1")}},
+		{
+			fv:   &FlowStartSeconds{Val: now},
+			want: &FlowStartSeconds{Val: now.Truncate(time.Second)},
+		},
+		{
+			fv:   &FlowStartMicroseconds{Val: now},
+			want: &FlowStartMicroseconds{Val: now.Round(time.Microsecond)},
+		},
+		{fv: &FlowStartNanoseconds{Val: now}},
+		{fv: &FlowStartNanoseconds{}},
+		{fv: &OctetDeltaCount{Val: 1500, Length: 2}},
+		{fv: &MibObjectValueInteger{Val: -2, Length: 1}},
+		{fv: &MibObjectValueInteger{Val: -129, Length: 2}},
+		{fv: &SamplingProbability{Val: 0.25, Length: 4}},
+		{fv: &BgpSourceCommunityList{Val: BasicList{
+			Semantic: SEMANTIC_ALL_OF,
+			Values:   []FieldValue{&BgpCommunity{Val: 1}, &BgpCommunity{Val: 2}},
+		}}},
+	} {
+		if tc.want == nil {
+			tc.want = tc.fv
+		}
+		data := tc.fv.Serialize()
+		if int(tc.fv.Len()) != len(data) {
+			t.Errorf("%T: got Len %d want %d", tc.fv, tc.fv.Len(), len(data))
+		}
+
+		got := DecodeFieldValue(data, *tc.fv.FieldSpecifier())
+		if !bytes.Equal(got.Serialize(), data) {
+			t.Errorf("%T: got %x want %x", tc.fv, got.Serialize(), data)
+		}
+		if !equalFieldValue(got, tc.want) {
+			t.Errorf("got %+v want %+v", got, tc.want)
+		}
+	}
+}
+
+func TestReducedSizeEncoding(t *testing.T) {
+	for _, tc := range []struct {
+		fv   FieldValue
+		want []uint8
+	}{
+		{fv: &OctetDeltaCount{Val: 1500}, want: []uint8{0, 0, 0, 0, 0, 0, 0x05, 0xdc}},
+		{fv: &OctetDeltaCount{Val: 1500, Length: 2}, want: []uint8{0x05, 0xdc}},
+		{fv: &OctetDeltaCount{Val: 1500, Length: 3}, want: []uint8{0, 0x05, 0xdc}},
+		{fv: &SourceTransportPort{Val: 80, Length: 1}, want: []uint8{80}},
+		{fv: &MibObjectValueInteger{Val: -2, Length: 1}, want: []uint8{0xfe}},
+		{fv: &MibObjectValueInteger{Val: -2, Length: 2}, want: []uint8{0xff, 0xfe}},
+		{fv: &SamplingProbability{Val: 0.25, Length: 4}, want: []uint8{0x3e, 0x80, 0, 0}},
+		// Invalid lengths fall back to the default length
+		{fv: &OctetDeltaCount{Val: 1500, Length: 9}, want: []uint8{0, 0, 0, 0, 0, 0, 0x05, 0xdc}},
+		{fv: &SamplingProbability{Val: 0.25, Length: 2}, want: []uint8{0x3f, 0xd0, 0, 0, 0, 0, 0, 0}},
+	} {
+		got := tc.fv.Serialize()
+		if !bytes.Equal(got, tc.want) {
+			t.Errorf("%+v: got %x want %x", tc.fv, got, tc.want)
+		}
+		if fs := tc.fv.FieldSpecifier(); int(fs.FieldLength) != len(tc.want) {
+			t.Errorf("%+v: got field length %d want %d", tc.fv, fs.FieldLength, len(tc.want))
+		}
+	}
+
+	// Only integers and float64 may be of reduced size
+	for _, tc := range []struct {
+		fv     FieldValue
+		length int
+	}{
+		{fv: &OctetDeltaCount{}, length: 9},
+		{fv: &SamplingProbability{}, length: 2},
+		{fv: &SourceIPv4Address{}, length: 2},
+		{fv: &FlowStartMilliseconds{}, length: 4},
+	} {
+		if err := tc.fv.DecodeFromBytes(make([]uint8, tc.length)); err == nil {
+			t.Errorf("%T: decoded %d octets", tc.fv, tc.length)
+		}
+	}
+}
+
+// equalFieldValue compares field values, whose times may differ in location
+// or microsecond rounding.
+func equalFieldValue(a, b FieldValue) bool {
+	va := reflect.ValueOf(a).Elem().Field(0).Interface()
+	vb := reflect.ValueOf(b).Elem().Field(0).Interface()
+	if ta, ok := va.(time.Time); ok {
+		d := ta.Sub(vb.(time.Time))
+		return d > -time.Microsecond && d < time.Microsecond
+	}
+	if _, ok := va.(BasicList); ok {
+		return bytes.Equal(a.Serialize(), b.Serialize())
+	}
+	return reflect.DeepEqual(va, vb)
+}
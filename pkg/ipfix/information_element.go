@@ -0,0 +1,180 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package ipfix
+
+import "fmt"
+
+//go:generate go run ../../tools/iegen -i ipfix-information-elements.csv -o iana_field_value.go
+
+// DataType is the abstract data type of an Information Element (RFC7012 3.1),
+// numbered as in the IANA registry.
+type DataType uint8
+
+const (
+	DATA_TYPE_OCTET_ARRAY             DataType = 0
+	DATA_TYPE_UNSIGNED8               DataType = 1
+	DATA_TYPE_UNSIGNED16              DataType = 2
+	DATA_TYPE_UNSIGNED32              DataType = 3
+	DATA_TYPE_UNSIGNED64              DataType = 4
+	DATA_TYPE_SIGNED8                 DataType = 5
+	DATA_TYPE_SIGNED16                DataType = 6
+	DATA_TYPE_SIGNED32                DataType = 7
+	DATA_TYPE_SIGNED64                DataType = 8
+	DATA_TYPE_FLOAT32                 DataType = 9
+	DATA_TYPE_FLOAT64                 DataType = 10
+	DATA_TYPE_BOOLEAN                 DataType = 11
+	DATA_TYPE_MAC_ADDRESS             DataType = 12
+	DATA_TYPE_STRING                  DataType = 13
+	DATA_TYPE_DATE_TIME_SECONDS       DataType = 14
+	DATA_TYPE_DATE_TIME_MILLISECONDS  DataType = 15
+	DATA_TYPE_DATE_TIME_MICROSECONDS  DataType = 16
+	DATA_TYPE_DATE_TIME_NANOSECONDS   DataType = 17
+	DATA_TYPE_IPV4_ADDRESS            DataType = 18
+	DATA_TYPE_IPV6_ADDRESS            DataType = 19
+	DATA_TYPE_BASIC_LIST              DataType = 20 // RFC6313
+	DATA_TYPE_SUB_TEMPLATE_LIST       DataType = 21 // RFC6313
+	DATA_TYPE_SUB_TEMPLATE_MULTI_LIST DataType = 22 // RFC6313
+)
+
+var dataTypeNames = []string{
+	"octetArray", "unsigned8", "unsigned16", "unsigned32", "unsigned64",
+	"signed8", "signed16", "signed32", "signed64", "float32", "float64",
+	"boolean", "macAddress", "string", "dateTimeSeconds", "dateTimeMilliseconds",
+	"dateTimeMicroseconds", "dateTimeNanoseconds", "ipv4Address", "ipv6Address",
+	"basicList", "subTemplateList", "subTemplateMultiList",
+}
+
+func (t DataType) String() string {
+	if int(t) < len(dataTypeNames) {
+		return dataTypeNames[t]
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+// ParseDataType returns the abstract data type of name, e.g. "unsigned32".
+func ParseDataType(name string) (DataType, error) {
+	for i, n := range dataTypeNames {
+		if n == name {
+			return DataType(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown data type: %s", name)
+}
+
+// DefaultLength returns the field length of the data type without
+// reduced-size encoding, or VARIABLE_LENGTH.
+func (t DataType) DefaultLength() uint16 {
+	switch t {
+	case DATA_TYPE_UNSIGNED8, DATA_TYPE_SIGNED8, DATA_TYPE_BOOLEAN:
+		return 1
+	case DATA_TYPE_UNSIGNED16, DATA_TYPE_SIGNED16:
+		return 2
+	case DATA_TYPE_UNSIGNED32, DATA_TYPE_SIGNED32, DATA_TYPE_FLOAT32, DATA_TYPE_DATE_TIME_SECONDS, DATA_TYPE_IPV4_ADDRESS:
+		return 4
+	case DATA_TYPE_MAC_ADDRESS:
+		return 6
+	case DATA_TYPE_UNSIGNED64, DATA_TYPE_SIGNED64, DATA_TYPE_FLOAT64,
+		DATA_TYPE_DATE_TIME_MILLISECONDS, DATA_TYPE_DATE_TIME_MICROSECONDS, DATA_TYPE_DATE_TIME_NANOSECONDS:
+		return 8
+	case DATA_TYPE_IPV6_ADDRESS:
+		return 16
+	}
+	return VARIABLE_LENGTH
+}
+
+// ValidLength returns whether length is a valid field length of the data
+// type, including reduced-size encoding (RFC7011 6.2).
+func (t DataType) ValidLength(length uint16) bool {
+	switch t {
+	case DATA_TYPE_UNSIGNED8, DATA_TYPE_UNSIGNED16, DATA_TYPE_UNSIGNED32, DATA_TYPE_UNSIGNED64,
+		DATA_TYPE_SIGNED8, DATA_TYPE_SIGNED16, DATA_TYPE_SIGNED32, DATA_TYPE_SIGNED64:
+		return length >= 1 && length <= t.DefaultLength()
+	case DATA_TYPE_FLOAT64:
+		return length == 4 || length == 8
+	case DATA_TYPE_OCTET_ARRAY, DATA_TYPE_STRING,
+		DATA_TYPE_BASIC_LIST, DATA_TYPE_SUB_TEMPLATE_LIST, DATA_TYPE_SUB_TEMPLATE_MULTI_LIST:
+		return true
+	}
+	return length == t.DefaultLength()
+}
+
+// reducedLength returns length if it is a valid reduced size of the data
+// type, or else the default length.
+func (t DataType) reducedLength(length uint16) uint16 {
+	if length != 0 && t.ValidLength(length) {
+		return length
+	}
+	return t.DefaultLength()
+}
+
+// DataTypeSemantics is the semantics of the abstract data type of an
+// Information Element (RFC7012 3.2), numbered as in the IANA registry.
+type DataTypeSemantics uint8
+
+const (
+	DATA_TYPE_SEMANTICS_DEFAULT       DataTypeSemantics = 0
+	DATA_TYPE_SEMANTICS_QUANTITY      DataTypeSemantics = 1
+	DATA_TYPE_SEMANTICS_TOTAL_COUNTER DataTypeSemantics = 2
+	DATA_TYPE_SEMANTICS_DELTA_COUNTER DataTypeSemantics = 3
+	DATA_TYPE_SEMANTICS_IDENTIFIER    DataTypeSemantics = 4
+	DATA_TYPE_SEMANTICS_FLAGS         DataTypeSemantics = 5
+	DATA_TYPE_SEMANTICS_LIST          DataTypeSemantics = 6 // RFC6313
+	DATA_TYPE_SEMANTICS_SNMP_COUNTER  DataTypeSemantics = 7 // RFC8038
+	DATA_TYPE_SEMANTICS_SNMP_GAUGE    DataTypeSemantics = 8 // RFC8038
+)
+
+var dataTypeSemanticsNames = []string{
+	"default", "quantity", "totalCounter", "deltaCounter", "identifier",
+	"flags", "list", "snmpCounter", "snmpGauge",
+}
+
+func (s DataTypeSemantics) String() string {
+	if int(s) < len(dataTypeSemanticsNames) {
+		return dataTypeSemanticsNames[s]
+	}
+	return fmt.Sprintf("%d", s)
+}
+
+// ParseDataTypeSemantics returns the data type semantics of name, e.g.
+// "deltaCounter". An empty name is DATA_TYPE_SEMANTICS_DEFAULT.
+func ParseDataTypeSemantics(name string) (DataTypeSemantics, error) {
+	if name == "" {
+		return DATA_TYPE_SEMANTICS_DEFAULT, nil
+	}
+	for i, n := range dataTypeSemanticsNames {
+		if n == name {
+			return DataTypeSemantics(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown data type semantics: %s", name)
+}
+
+// InformationElement describes an Information Element (RFC7012 2.).
+type InformationElement struct {
+	Name             string
+	ElementID        uint16
+	EnterpriseNumber uint32 // zero for the IANA Information Elements
+	DataType         DataType
+	Semantics        DataTypeSemantics
+	Units            string
+	// Default field length, which is VARIABLE_LENGTH for the variable-length
+	// data types
+	Length     uint16
+	Deprecated bool
+}
+
+// FieldSpecifier returns the Field Specifier of the Information Element with
+// its default length.
+func (ie InformationElement) FieldSpecifier() *FieldSpecifier {
+	return NewFieldSpecifier(ie.EnterpriseNumber != 0, ie.ElementID, ie.Length, ie.EnterpriseNumber)
+}
+
+// IANAInformationElement returns the Information Element of the IANA
+// registry with elementID.
+func IANAInformationElement(elementID uint16) (InformationElement, bool) {
+	ie, ok := ianaInformationElements[elementID]
+	return ie, ok
+}
@@ -12,44 +12,38 @@ import (
 
 const (
 	IPFIX_VERSION uint16 = 10
+
+	MAX_MESSAGE_LENGTH = 65535 // RFC7011 3.1, the Length field is 16 bits
 )
 
 type Message struct { // RFC7011 3.
 	Version             uint16
+	ExportTime          uint32 // seconds since the UNIX epoch; the current time is used if zero
 	SequenceNumber      uint32
 	ObservationDomainID uint32
 	Sets                []Set
 }
 
 func (m *Message) Serialize() []uint8 {
-	buf := make([]uint8, 0)
-	version := make([]uint8, 2)
-	binary.BigEndian.PutUint16(version, m.Version)
-	buf = append(buf, version...)
-
-	length := make([]uint8, 2)
-	binary.BigEndian.PutUint16(length, uint16(m.Len()))
-	buf = append(buf, length...)
-
-	exportTime := make([]uint8, 4)
-	binary.BigEndian.PutUint32(exportTime, uint32(time.Now().Unix()))
-	buf = append(buf, exportTime...)
-
-	sequenceNumber := make([]uint8, 4)
-	binary.BigEndian.PutUint32(sequenceNumber, m.SequenceNumber)
-	buf = append(buf, sequenceNumber...)
-
-	observationDomainID := make([]uint8, 4)
-	binary.BigEndian.PutUint32(observationDomainID, m.ObservationDomainID)
-	buf = append(buf, observationDomainID...)
+	return m.AppendTo(make([]uint8, 0, m.Len()))
+}
 
-	sets := make([]uint8, 0)
-	for _, s := range m.Sets {
-		sets = append(sets, s.Serialize()...)
+// AppendTo appends the encoded Message to dst, which is not allocated if it
+// has the capacity of Len.
+func (m *Message) AppendTo(dst []uint8) []uint8 {
+	dst = binary.BigEndian.AppendUint16(dst, m.Version)
+	dst = binary.BigEndian.AppendUint16(dst, m.Len())
+	if m.ExportTime != 0 {
+		dst = binary.BigEndian.AppendUint32(dst, m.ExportTime)
+	} else {
+		dst = binary.BigEndian.AppendUint32(dst, uint32(time.Now().Unix()))
 	}
-	buf = append(buf, sets...)
-
-	return buf
+	dst = binary.BigEndian.AppendUint32(dst, m.SequenceNumber)
+	dst = binary.BigEndian.AppendUint32(dst, m.ObservationDomainID)
+	for i := range m.Sets {
+		dst = m.Sets[i].AppendTo(dst)
+	}
+	return dst
 }
 
 func (m *Message) Len() uint16 {
@@ -72,8 +66,9 @@ func NewMessage(seq uint32, obsID uint32, sets []Set) *Message {
 }
 
 const (
-	TEMPLATE_SETS_ID         uint16 = 2 // RFC7011 3.3.2
-	OPTIONS_TEMPLATE_SETS_ID uint16 = 3 // RFC7011 3.3.2
+	TEMPLATE_SETS_ID         uint16 = 2   // RFC7011 3.3.2
+	OPTIONS_TEMPLATE_SETS_ID uint16 = 3   // RFC7011 3.3.2
+	MIN_DATA_SETS_ID         uint16 = 256 // RFC7011 3.3.2
 )
 
 type Set struct { // RFC7011 3.3.1
@@ -82,27 +77,21 @@ type Set struct { // RFC7011 3.3.1
 }
 
 func (s *Set) Serialize() []uint8 {
-	buf := make([]uint8, 0)
-	setID := make([]uint8, 2)
-	binary.BigEndian.PutUint16(setID, s.SetID)
-	buf = append(buf, setID...)
-
-	length := make([]uint8, 2)
-	binary.BigEndian.PutUint16(length, s.Len())
-	buf = append(buf, length...)
+	return s.AppendTo(make([]uint8, 0, s.Len()))
+}
 
-	records := make([]uint8, 0)
+// AppendTo appends the encoded Set with its padding to dst.
+func (s *Set) AppendTo(dst []uint8) []uint8 {
+	start := len(dst)
+	dst = binary.BigEndian.AppendUint16(dst, s.SetID)
+	dst = binary.BigEndian.AppendUint16(dst, s.Len())
 	for _, r := range s.Records {
-		records = append(records, r.Serialize()...)
+		dst = AppendRecord(dst, r)
 	}
-	buf = append(buf, records...)
-
-	if len(buf)%4 != 0 {
-		pad := make([]uint8, 4-len(buf)%4)
-		buf = append(buf, pad...)
+	for (len(dst)-start)%4 != 0 {
+		dst = append(dst, 0)
 	}
-
-	return buf
+	return dst
 }
 
 func (s *Set) Len() uint16 {
@@ -127,6 +116,8 @@ func NewSet(setID uint16, records []Record) *Set {
 	}
 }
 
+const VARIABLE_LENGTH uint16 = 0xffff // RFC7011 7.
+
 type FieldSpecifier struct { // RFC7011 3.2
 	E                    bool
 	InformationElementID uint16
@@ -135,23 +126,21 @@ type FieldSpecifier struct { // RFC7011 3.2
 }
 
 func (s *FieldSpecifier) Serialize() []uint8 {
-	buf := make([]uint8, 0, 8)
-	informationElementId := make([]uint8, 2)
-	binary.BigEndian.PutUint16(informationElementId, s.InformationElementID)
+	return s.AppendTo(make([]uint8, 0, s.Len()))
+}
+
+// AppendTo appends the encoded Field Specifier to dst.
+func (s *FieldSpecifier) AppendTo(dst []uint8) []uint8 {
+	informationElementId := s.InformationElementID
 	if s.E {
-		informationElementId[0] = informationElementId[0] | 1<<7
+		informationElementId |= 1 << 15
 	}
-	buf = append(buf, informationElementId...)
-
-	length := make([]uint8, 2)
-	binary.BigEndian.PutUint16(length, s.FieldLength)
-	buf = append(buf, length...)
+	dst = binary.BigEndian.AppendUint16(dst, informationElementId)
+	dst = binary.BigEndian.AppendUint16(dst, s.FieldLength)
 	if s.E {
-		enterpriseNumber := make([]uint8, 4)
-		binary.BigEndian.PutUint32(enterpriseNumber, s.EnterpriseNumber)
-		buf = append(buf, enterpriseNumber...)
+		dst = binary.BigEndian.AppendUint32(dst, s.EnterpriseNumber)
 	}
-	return buf
+	return dst
 }
 
 func (s *FieldSpecifier) Len() uint16 {
@@ -179,28 +168,44 @@ type Record interface { // RFC7011 3.4
 	Len() uint16
 }
 
+// Appender is implemented by the Records and FieldValues that append their
+// encoding to a buffer instead of allocating one as Serialize does.
+type Appender interface {
+	AppendTo(dst []uint8) []uint8
+}
+
+// AppendRecord appends the encoded Record to dst.
+func AppendRecord(dst []uint8, r Record) []uint8 {
+	if a, ok := r.(Appender); ok {
+		return a.AppendTo(dst)
+	}
+	return append(dst, r.Serialize()...)
+}
+
+// AppendFieldValue appends the encoded FieldValue to dst.
+func AppendFieldValue(dst []uint8, fv FieldValue) []uint8 {
+	if a, ok := fv.(Appender); ok {
+		return a.AppendTo(dst)
+	}
+	return append(dst, fv.Serialize()...)
+}
+
 type TemplateRecord struct { // RFC7011 3.4.1
 	TemplateID      uint16
 	FieldSpecifiers []FieldSpecifier
 }
 
 func (r *TemplateRecord) Serialize() []uint8 {
-	buf := make([]uint8, 0)
-	templateID := make([]uint8, 2)
-	binary.BigEndian.PutUint16(templateID, r.TemplateID)
-	buf = append(buf, templateID...)
-
-	fieldCount := make([]uint8, 2)
-	binary.BigEndian.PutUint16(fieldCount, uint16(len(r.FieldSpecifiers)))
-	buf = append(buf, fieldCount...)
+	return r.AppendTo(make([]uint8, 0, r.Len()))
+}
 
-	fieldSpecifiers := make([]uint8, 0)
-	for _, fs := range r.FieldSpecifiers {
-		fieldSpecifiers = append(fieldSpecifiers, fs.Serialize()...)
+func (r *TemplateRecord) AppendTo(dst []uint8) []uint8 {
+	dst = binary.BigEndian.AppendUint16(dst, r.TemplateID)
+	dst = binary.BigEndian.AppendUint16(dst, uint16(len(r.FieldSpecifiers)))
+	for i := range r.FieldSpecifiers {
+		dst = r.FieldSpecifiers[i].AppendTo(dst)
 	}
-	buf = append(buf, fieldSpecifiers...)
-
-	return buf
+	return dst
 }
 
 func (r *TemplateRecord) Len() uint16 {
@@ -228,26 +233,17 @@ type OptionsTemplateRecord struct { // RFC7011 3.4.2.2
 }
 
 func (r *OptionsTemplateRecord) Serialize() []uint8 {
-	buf := make([]uint8, 0)
-	templateID := make([]uint8, 2)
-	binary.BigEndian.PutUint16(templateID, r.TemplateID)
-	buf = append(buf, templateID...)
-
-	fieldCount := make([]uint8, 2)
-	binary.BigEndian.PutUint16(fieldCount, uint16(len(r.FieldSpecifiers)))
-	buf = append(buf, fieldCount...)
-
-	scopeFieldCount := make([]uint8, 2)
-	binary.BigEndian.PutUint16(fieldCount, r.ScopeFieldCount)
-	buf = append(buf, scopeFieldCount...)
+	return r.AppendTo(make([]uint8, 0, r.Len()))
+}
 
-	fieldSpecifiers := make([]uint8, 0)
-	for _, fs := range r.FieldSpecifiers {
-		fieldSpecifiers = append(fieldSpecifiers, fs.Serialize()...)
+func (r *OptionsTemplateRecord) AppendTo(dst []uint8) []uint8 {
+	dst = binary.BigEndian.AppendUint16(dst, r.TemplateID)
+	dst = binary.BigEndian.AppendUint16(dst, uint16(len(r.FieldSpecifiers)))
+	dst = binary.BigEndian.AppendUint16(dst, r.ScopeFieldCount)
+	for i := range r.FieldSpecifiers {
+		dst = r.FieldSpecifiers[i].AppendTo(dst)
 	}
-	buf = append(buf, fieldSpecifiers...)
-
-	return buf
+	return dst
 }
 
 func (r *OptionsTemplateRecord) Len() uint16 {
@@ -274,14 +270,14 @@ type DataRecord struct { // RFC7011 3.4.3
 }
 
 func (r *DataRecord) Serialize() []uint8 {
-	buf := make([]uint8, 0)
+	return r.AppendTo(make([]uint8, 0, r.Len()))
+}
 
+func (r *DataRecord) AppendTo(dst []uint8) []uint8 {
 	for _, v := range r.FieldValues {
-		buf = append(buf, v.Serialize()...)
+		dst = AppendFieldValue(dst, v)
 	}
-
-	return buf
-
+	return dst
 }
 
 func (r *DataRecord) Len() uint16 {
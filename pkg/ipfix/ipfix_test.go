@@ -0,0 +1,99 @@
+package ipfix
+
+import (
+	"bytes"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+// benchmarkMessage returns a Message of the data records exported by the
+// meter, with their template.
+func benchmarkMessage(records int) *Message {
+	fvs := []FieldValue{
+		&PacketDeltaCount{Val: 100},
+		&SRHActiveSegmentIPv6{Val: netip.MustParseAddr("2001:db8::1")},
+		&SRHSegmentsIPv6Left{Val: 2},
+		&SRHFlagsIPv6{Val: 0},
+		&SRHTagIPv6{Val: 0},
+		&SRHSegmentIPv6BasicList{
+			SegmentList: []SRHSegmentIPv6{
+				{Val: netip.MustParseAddr("2001:db8::1")},
+				{Val: netip.MustParseAddr("2001:db8::2")},
+				{Val: netip.MustParseAddr("2001:db8::3")},
+			},
+		},
+		&PathDelayMeanDeltaMicroseconds{Val: 10},
+		&PathDelayMinDeltaMicroseconds{Val: 5},
+		&PathDelayMaxDeltaMicroseconds{Val: 20},
+		&PathDelaySumDeltaMicroseconds{Val: 1000},
+		&FlowStartMilliseconds{Val: time.Date(2023, 10, 25, 12, 0, 0, 0, time.UTC)},
+		&OctetDeltaCount{Val: 1500, Length: 4},
+	}
+	var fss []FieldSpecifier
+	for _, fv := range fvs {
+		fss = append(fss, *fv.FieldSpecifier())
+	}
+
+	var dataRecords []Record
+	for i := 0; i < records; i++ {
+		dataRecords = append(dataRecords, &DataRecord{FieldValues: fvs})
+	}
+	m := NewMessage(1, 61166, []Set{
+		*NewSet(TEMPLATE_SETS_ID, []Record{NewTemplateRecord(256, fss)}),
+		*NewSet(256, dataRecords),
+	})
+	m.ExportTime = 0x6538d5f6
+	return m
+}
+
+func TestMessageAppendTo(t *testing.T) {
+	for _, m := range []*Message{testMessage(), benchmarkMessage(10)} {
+		want := m.Serialize()
+		if len(want) != int(m.Len()) {
+			t.Errorf("got %d bytes want %d", len(want), m.Len())
+		}
+
+		prefix := []uint8{0xff, 0xff}
+		got := m.AppendTo(prefix)
+		if !bytes.Equal(got[:2], prefix) || !bytes.Equal(got[2:], want) {
+			t.Errorf("got %x want %x%x", got, prefix, want)
+		}
+
+		buf := make([]uint8, 0, m.Len())
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = m.AppendTo(buf[:0])
+		})
+		if allocs != 0 {
+			t.Errorf("got %v allocations want 0", allocs)
+		}
+	}
+}
+
+func BenchmarkMessageSerialize(b *testing.B) {
+	m := benchmarkMessage(10)
+	b.ReportAllocs()
+	b.SetBytes(int64(m.Len()))
+	for b.Loop() {
+		m.Serialize()
+	}
+}
+
+func BenchmarkMessageAppendTo(b *testing.B) {
+	m := benchmarkMessage(10)
+	buf := make([]uint8, 0, m.Len())
+	b.ReportAllocs()
+	b.SetBytes(int64(m.Len()))
+	for b.Loop() {
+		buf = m.AppendTo(buf[:0])
+	}
+}
+
+func BenchmarkDataRecordAppendTo(b *testing.B) {
+	r := benchmarkMessage(1).Sets[1].Records[0]
+	buf := make([]uint8, 0, r.Len())
+	b.ReportAllocs()
+	for b.Loop() {
+		buf = AppendRecord(buf[:0], r)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package ipfix
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Information Elements exported by fluvia that are not in the IANA registry.
+// The on-path telemetry IEs are not yet allocated by IANA and may be
+// exported under the NTT Communications enterprise number.
+var fluviaInformationElements = []InformationElement{
+	{Name: "pathDelayMeanDeltaMicroseconds", ElementID: IEID_PATH_DELAY_MEAN_DALTA_MICROSECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "microseconds", Length: 4},
+	{Name: "pathDelayMeanDeltaNanoseconds", ElementID: IEID_PATH_DELAY_MEAN_DALTA_NANOSECONDS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "nanoseconds", Length: 8},
+	{Name: "pathDelayMinDeltaMicroseconds", ElementID: IEID_PATH_DELAY_MIN_DALTA_MICROSECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "microseconds", Length: 4},
+	{Name: "pathDelayMinDeltaNanoseconds", ElementID: IEID_PATH_DELAY_MIN_DALTA_NANOSECONDS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "nanoseconds", Length: 8},
+	{Name: "pathDelayMaxDeltaMicroseconds", ElementID: IEID_PATH_DELAY_MAX_DALTA_MICROSECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "microseconds", Length: 4},
+	{Name: "pathDelayMaxDeltaNanoseconds", ElementID: IEID_PATH_DELAY_MAX_DALTA_NANOSECONDS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_QUANTITY, Units: "nanoseconds", Length: 8},
+	{Name: "pathDelaySumDeltaMicroseconds", ElementID: IEID_PATH_DELAY_SUM_DALTA_MICROSECONDS, DataType: DATA_TYPE_UNSIGNED32, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "microseconds", Length: 4},
+	{Name: "pathDelaySumDeltaNanoseconds", ElementID: IEID_PATH_DELAY_SUM_DALTA_NANOSECONDS, DataType: DATA_TYPE_UNSIGNED64, Semantics: DATA_TYPE_SEMANTICS_DELTA_COUNTER, Units: "nanoseconds", Length: 8},
+}
+
+type registryKey struct {
+	enterpriseNumber uint32
+	elementID        uint16
+}
+
+// Registry maps the names of Information Elements to their definitions and
+// back. Names are unique within an enterprise number; when several
+// enterprises use the same name, LookupName returns the IANA Information
+// Element or else the first one added.
+type Registry struct {
+	elements map[registryKey]InformationElement
+	names    map[string]InformationElement
+}
+
+// NewRegistry returns a Registry of the IANA Information Elements and those
+// exported by fluvia.
+func NewRegistry() *Registry {
+	r := &Registry{
+		elements: make(map[registryKey]InformationElement),
+		names:    make(map[string]InformationElement),
+	}
+
+	ids := make([]int, 0, len(ianaInformationElements))
+	for id := range ianaInformationElements {
+		ids = append(ids, int(id))
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		r.add(ianaInformationElements[uint16(id)])
+	}
+	for _, ie := range fluviaInformationElements {
+		r.add(ie)
+		enterprise := ie
+		enterprise.EnterpriseNumber = ENTERPRISE_NUMBER_NTTCOM
+		r.add(enterprise)
+	}
+	return r
+}
+
+// Add adds an Information Element, which must not conflict with another one
+// with the same ID or name in its enterprise.
+func (r *Registry) Add(ie InformationElement) error {
+	if ie.Name == "" {
+		return fmt.Errorf("information element %d.%d has no name", ie.EnterpriseNumber, ie.ElementID)
+	}
+	if ie.ElementID&0x8000 != 0 {
+		return fmt.Errorf("invalid element id of %s: %d", ie.Name, ie.ElementID)
+	}
+	if old, ok := r.Lookup(ie.EnterpriseNumber, ie.ElementID); ok && old.Name != ie.Name {
+		return fmt.Errorf("element id %d.%d of %s is used by %s", ie.EnterpriseNumber, ie.ElementID, ie.Name, old.Name)
+	}
+	for _, old := range r.elements {
+		if old.Name == ie.Name && old.EnterpriseNumber == ie.EnterpriseNumber && old.ElementID != ie.ElementID {
+			return fmt.Errorf("name %s of %d.%d is used by %d.%d", ie.Name, ie.EnterpriseNumber, ie.ElementID, old.EnterpriseNumber, old.ElementID)
+		}
+	}
+	r.add(ie)
+	return nil
+}
+
+func (r *Registry) add(ie InformationElement) {
+	r.elements[registryKey{ie.EnterpriseNumber, ie.ElementID}] = ie
+	if old, ok := r.names[ie.Name]; !ok || (old.EnterpriseNumber != 0 && ie.EnterpriseNumber == 0) ||
+		(old.EnterpriseNumber == ie.EnterpriseNumber && old.ElementID == ie.ElementID) {
+		r.names[ie.Name] = ie
+	}
+}
+
+// Lookup returns the Information Element of an ID, whose enterpriseNumber is
+// zero for the IANA Information Elements.
+func (r *Registry) Lookup(enterpriseNumber uint32, elementID uint16) (InformationElement, bool) {
+	ie, ok := r.elements[registryKey{enterpriseNumber, elementID}]
+	return ie, ok
+}
+
+// LookupName returns the Information Element of a name, e.g. "srhActiveSegmentIPv6".
+func (r *Registry) LookupName(name string) (InformationElement, bool) {
+	ie, ok := r.names[name]
+	return ie, ok
+}
+
+// LookupFieldSpecifier returns the Information Element of a Field Specifier.
+func (r *Registry) LookupFieldSpecifier(fs FieldSpecifier) (InformationElement, bool) {
+	return r.Lookup(fs.EnterpriseNumber, fs.InformationElementID)
+}
+
+// registryFile is the YAML file of enterprise-specific Information Elements,
+// e.g.
+//
+//	information-elements:
+//	  - name: nodeId
+//	    element-id: 1
+//	    enterprise-number: 29319
+//	    data-type: unsigned32
+//	    semantics: identifier
+type registryFile struct {
+	InformationElements []struct {
+		Name             string `yaml:"name"`
+		ElementID        uint16 `yaml:"element-id"`
+		EnterpriseNumber uint32 `yaml:"enterprise-number"`
+		DataType         string `yaml:"data-type"`
+		Semantics        string `yaml:"semantics"`
+		Units            string `yaml:"units"`
+		// The default length of the data type is used if zero, or else it
+		// may be a reduced size (RFC7011 6.2)
+		Length uint16 `yaml:"length"`
+	} `yaml:"information-elements"`
+}
+
+// LoadYAML adds the Information Elements defined in YAML. Nothing is added
+// if any of them is invalid.
+func (r *Registry) LoadYAML(rd io.Reader) error {
+	var f registryFile
+	if err := yaml.NewDecoder(rd).Decode(&f); err != nil && err != io.EOF {
+		return err
+	}
+
+	var ies []InformationElement
+	for _, e := range f.InformationElements {
+		dataType, err := ParseDataType(e.DataType)
+		if err != nil {
+			return fmt.Errorf("%s: %w", e.Name, err)
+		}
+		semantics, err := ParseDataTypeSemantics(e.Semantics)
+		if err != nil {
+			return fmt.Errorf("%s: %w", e.Name, err)
+		}
+		ie := InformationElement{
+			Name:             e.Name,
+			ElementID:        e.ElementID,
+			EnterpriseNumber: e.EnterpriseNumber,
+			DataType:         dataType,
+			Semantics:        semantics,
+			Units:            e.Units,
+			Length:           e.Length,
+		}
+		if ie.Length == 0 {
+			ie.Length = dataType.DefaultLength()
+		}
+		if !dataType.ValidLength(ie.Length) {
+			return fmt.Errorf("%s: invalid length of %s: %d", e.Name, dataType, ie.Length)
+		}
+		ies = append(ies, ie)
+	}
+
+	// Check all before adding any
+	check := &Registry{elements: maps.Clone(r.elements), names: maps.Clone(r.names)}
+	for _, ie := range ies {
+		if err := check.Add(ie); err != nil {
+			return err
+		}
+	}
+	*r = *check
+	return nil
+}
+
+// LoadFile adds the Information Elements defined in a YAML file.
+func (r *Registry) LoadFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Printf("failed to close registry file %s: %v", name, err)
+		}
+	}()
+
+	if err := r.LoadYAML(f); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package ipfix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+
+	ie, ok := r.LookupName("srhActiveSegmentIPv6")
+	if !ok {
+		t.Fatal("srhActiveSegmentIPv6 not found")
+	}
+	if ie.ElementID != IEID_SRH_ACTIVE_SEGMENT_IPV6 || ie.EnterpriseNumber != 0 ||
+		ie.DataType != DATA_TYPE_IPV6_ADDRESS || ie.Length != 16 {
+		t.Errorf("got %+v", ie)
+	}
+	if got, ok := r.Lookup(0, IEID_SRH_ACTIVE_SEGMENT_IPV6); !ok || got.Name != "srhActiveSegmentIPv6" {
+		t.Errorf("got %+v", got)
+	}
+
+	ie, ok = r.LookupName("octetDeltaCount")
+	if !ok || ie.Semantics != DATA_TYPE_SEMANTICS_DELTA_COUNTER || ie.Units != "octets" {
+		t.Errorf("got %+v", ie)
+	}
+	fs := ie.FieldSpecifier()
+	if fs.E || fs.InformationElementID != 1 || fs.FieldLength != 8 {
+		t.Errorf("got %+v", fs)
+	}
+
+	// The IEs exported by fluvia are also under its enterprise number
+	ie, ok = r.Lookup(ENTERPRISE_NUMBER_NTTCOM, IEID_PATH_DELAY_MEAN_DALTA_MICROSECONDS)
+	if !ok || ie.Name != "pathDelayMeanDeltaMicroseconds" {
+		t.Errorf("got %+v", ie)
+	}
+	if ie, ok := r.LookupName("pathDelayMeanDeltaMicroseconds"); !ok || ie.EnterpriseNumber != 0 {
+		t.Errorf("got %+v", ie)
+	}
+
+	if _, ok := r.LookupName("unknown"); ok {
+		t.Error("unknown found")
+	}
+}
+
+func TestRegistryLoadYAML(t *testing.T) {
+	r := NewRegistry()
+	err := r.LoadYAML(strings.NewReader(`
+information-elements:
+  - name: nodeId
+    element-id: 1
+    enterprise-number: 29319
+    data-type: unsigned32
+    semantics: identifier
+  - name: nodeName
+    element-id: 2
+    enterprise-number: 29319
+    data-type: string
+    units: none
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ie, ok := r.LookupName("nodeId")
+	if !ok {
+		t.Fatal("nodeId not found")
+	}
+	want := InformationElement{
+		Name:             "nodeId",
+		ElementID:        1,
+		EnterpriseNumber: 29319,
+		DataType:         DATA_TYPE_UNSIGNED32,
+		Semantics:        DATA_TYPE_SEMANTICS_IDENTIFIER,
+		Length:           4,
+	}
+	if ie != want {
+		t.Errorf("got %+v want %+v", ie, want)
+	}
+	fs := ie.FieldSpecifier()
+	if !fs.E || fs.EnterpriseNumber != 29319 {
+		t.Errorf("got %+v", fs)
+	}
+
+	ie, ok = r.Lookup(29319, 2)
+	if !ok || ie.Name != "nodeName" || ie.Length != VARIABLE_LENGTH {
+		t.Errorf("got %+v", ie)
+	}
+	// The IANA IE of the same ID is unchanged
+	if ie, _ := r.Lookup(0, 1); ie.Name != "octetDeltaCount" {
+		t.Errorf("got %+v", ie)
+	}
+}
+
+func TestRegistryLoadYAMLError(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		yaml string
+	}{
+		{
+			name: "unknown data type",
+			yaml: `
+information-elements:
+  - name: nodeId
+    element-id: 1
+    enterprise-number: 29319
+    data-type: unsigned128
+`,
+		},
+		{
+			name: "invalid length",
+			yaml: `
+information-elements:
+  - name: nodeId
+    element-id: 1
+    enterprise-number: 29319
+    data-type: ipv4Address
+    length: 2
+`,
+		},
+		{
+			name: "conflicting element id",
+			yaml: `
+information-elements:
+  - name: nodeId
+    element-id: 1
+    data-type: unsigned32
+`,
+		},
+		{
+			name: "conflicting name",
+			yaml: `
+information-elements:
+  - name: nodeId
+    element-id: 1
+    enterprise-number: 29319
+    data-type: unsigned32
+  - name: nodeId
+    element-id: 2
+    enterprise-number: 29319
+    data-type: unsigned32
+`,
+		},
+	} {
+		r := NewRegistry()
+		if err := r.LoadYAML(strings.NewReader(tc.yaml)); err == nil {
+			t.Errorf("%s: got no error", tc.name)
+		}
+		// Nothing is added on error
+		if _, ok := r.LookupName("nodeId"); ok {
+			t.Errorf("%s: nodeId added", tc.name)
+		}
+	}
+}
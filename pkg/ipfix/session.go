@@ -0,0 +1,194 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package ipfix
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+const DEFAULT_TEMPLATE_LIFETIME = 30 * time.Minute // RFC7011 8.4
+
+type templateKey struct {
+	observationDomainID uint32
+	templateID          uint16
+}
+
+type templateEntry struct {
+	record   Record // *TemplateRecord or *OptionsTemplateRecord
+	fss      []FieldSpecifier
+	received time.Time
+}
+
+// Session keeps the Templates and Options Templates received on a single
+// Transport Session (RFC7011 8.) for each Observation Domain, and uses them
+// to decode the Data Sets of the following Messages.
+type Session struct {
+	mu               sync.Mutex
+	templateLifetime time.Duration
+	templates        map[templateKey]*templateEntry
+	now              func() time.Time
+}
+
+// NewSession returns a Session whose Templates expire templateLifetime after
+// they were last received (RFC7011 8.4), as required for UDP.
+// A templateLifetime of zero is meant for reliable transports such as TCP,
+// where Templates never expire and must be withdrawn before being redefined.
+func NewSession(templateLifetime time.Duration) *Session {
+	return &Session{
+		templateLifetime: templateLifetime,
+		templates:        make(map[templateKey]*templateEntry),
+		now:              time.Now,
+	}
+}
+
+// DecodeMessage decodes an IPFIX Message, updates the Templates of the Session
+// from its (Options) Template Sets, and decodes each Data Set with the
+// corresponding Template into DataRecords. A Data Set whose Template is not
+// known (or has expired) is kept as a RawRecord, and so are the records of
+// structured data (RFC6313) whose Template is not known.
+func (s *Session) DecodeMessage(data []uint8) (*Message, error) {
+	m, err := DecodeMessage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range m.Sets {
+		set := &m.Sets[i]
+		switch {
+		case set.SetID == TEMPLATE_SETS_ID || set.SetID == OPTIONS_TEMPLATE_SETS_ID:
+			for _, r := range set.Records {
+				if err := s.updateTemplate(m.ObservationDomainID, set.SetID, r); err != nil {
+					return nil, err
+				}
+			}
+		case set.SetID >= MIN_DATA_SETS_ID:
+			e, ok := s.lookup(templateKey{m.ObservationDomainID, set.SetID})
+			if !ok {
+				continue
+			}
+			raw := set.Records[0].(*RawRecord)
+			records, err := DecodeDataRecords(raw.Value, e.fss)
+			if err != nil {
+				return nil, fmt.Errorf("data set %d: %w", set.SetID, err)
+			}
+			// Structured data refers to Templates of the same Observation Domain
+			err = resolveRecords(records, func(templateID uint16) ([]FieldSpecifier, bool) {
+				e, ok := s.lookup(templateKey{m.ObservationDomainID, templateID})
+				if !ok {
+					return nil, false
+				}
+				return e.fss, true
+			})
+			if err != nil {
+				return nil, fmt.Errorf("data set %d: %w", set.SetID, err)
+			}
+			set.Records = records
+		}
+	}
+
+	return m, nil
+}
+
+// Template returns the Template (*TemplateRecord) or Options Template
+// (*OptionsTemplateRecord) with templateID in the Observation Domain.
+func (s *Session) Template(observationDomainID uint32, templateID uint16) (Record, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.lookup(templateKey{observationDomainID, templateID})
+	if !ok {
+		return nil, false
+	}
+	return e.record, true
+}
+
+// lookup returns the template entry for key, removing it if it has expired.
+func (s *Session) lookup(key templateKey) (*templateEntry, bool) {
+	e, ok := s.templates[key]
+	if !ok {
+		return nil, false
+	}
+	if s.templateLifetime > 0 && s.now().Sub(e.received) > s.templateLifetime {
+		delete(s.templates, key)
+		return nil, false
+	}
+	return e, true
+}
+
+func (s *Session) updateTemplate(observationDomainID uint32, setID uint16, r Record) error {
+	var (
+		templateID uint16
+		fss        []FieldSpecifier
+	)
+	switch r := r.(type) {
+	case *TemplateRecord:
+		templateID, fss = r.TemplateID, r.FieldSpecifiers
+	case *OptionsTemplateRecord:
+		templateID, fss = r.TemplateID, r.FieldSpecifiers
+	default:
+		return fmt.Errorf("unexpected record in set %d: %T", setID, r)
+	}
+
+	// RFC7011 8.1. Template Withdrawal
+	if len(fss) == 0 {
+		if templateID == setID {
+			s.withdrawAll(observationDomainID, setID)
+		} else {
+			delete(s.templates, templateKey{observationDomainID, templateID})
+		}
+		return nil
+	}
+
+	if templateID < MIN_DATA_SETS_ID {
+		return fmt.Errorf("invalid template id: %d", templateID)
+	}
+
+	key := templateKey{observationDomainID, templateID}
+	if e, ok := s.lookup(key); ok && s.templateLifetime == 0 && !sameTemplate(e.record, r) {
+		// RFC7011 8. Redefining a Template without withdrawing it is only
+		// allowed over UDP (RFC7011 8.4)
+		return fmt.Errorf("template %d in observation domain %d is redefined without withdrawal", templateID, observationDomainID)
+	}
+
+	s.templates[key] = &templateEntry{
+		record:   r,
+		fss:      fss,
+		received: s.now(),
+	}
+	return nil
+}
+
+// withdrawAll withdraws all Templates (setID is TEMPLATE_SETS_ID) or all
+// Options Templates (setID is OPTIONS_TEMPLATE_SETS_ID) of the Observation Domain.
+func (s *Session) withdrawAll(observationDomainID uint32, setID uint16) {
+	for key, e := range s.templates {
+		if key.observationDomainID != observationDomainID {
+			continue
+		}
+		_, isOptions := e.record.(*OptionsTemplateRecord)
+		if isOptions == (setID == OPTIONS_TEMPLATE_SETS_ID) {
+			delete(s.templates, key)
+		}
+	}
+}
+
+func sameTemplate(a, b Record) bool {
+	switch a := a.(type) {
+	case *TemplateRecord:
+		b, ok := b.(*TemplateRecord)
+		return ok && slices.Equal(a.FieldSpecifiers, b.FieldSpecifiers)
+	case *OptionsTemplateRecord:
+		b, ok := b.(*OptionsTemplateRecord)
+		return ok && a.ScopeFieldCount == b.ScopeFieldCount && slices.Equal(a.FieldSpecifiers, b.FieldSpecifiers)
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package ipfix
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSessionDecodeMessage(t *testing.T) {
+	s := NewSession(0)
+
+	m, err := s.DecodeMessage(testMessage().Serialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := m.Sets[1].Records
+	if len(records) != 2 {
+		t.Fatalf("got %d data records want %d", len(records), 2)
+	}
+
+	fvs := records[0].(*DataRecord).FieldValues
+	if fv, ok := fvs[0].(*PacketDeltaCount); !ok || fv.Val != 100 {
+		t.Errorf("got %#v want &PacketDeltaCount{Val: 100}", fvs[0])
+	}
+	if fv, ok := fvs[4].(*SRHSegmentIPv6ListSection); !ok || len(fv.SegmentList) != 3 {
+		t.Errorf("got %#v want SRHSegmentIPv6ListSection with 3 segments", fvs[4])
+	}
+	if fv, ok := fvs[5].(*UndefinedFieldValue); !ok || fv.EnterpriseNumber != ENTERPRISE_NUMBER_NTTCOM {
+		t.Errorf("got %#v want enterprise UndefinedFieldValue", fvs[5])
+	}
+}
+
+func TestSessionUnknownTemplate(t *testing.T) {
+	s := NewSession(0)
+
+	m := testMessage()
+	m.Sets = m.Sets[1:]
+
+	decoded, err := s.DecodeMessage(m.Serialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := decoded.Sets[0].Records[0].(*RawRecord); !ok {
+		t.Errorf("got %T want *RawRecord", decoded.Sets[0].Records[0])
+	}
+}
+
+func TestSessionTemplateRedefinition(t *testing.T) {
+	m := testMessage()
+	redefined := NewMessage(2, m.ObservationDomainID, []Set{
+		*NewSet(TEMPLATE_SETS_ID, []Record{
+			NewTemplateRecord(256, []FieldSpecifier{*NewFieldSpecifier(false, IEID_PACKET_DELTA_COUNT, 8, 0)}),
+		}),
+	})
+
+	tcp := NewSession(0)
+	if _, err := tcp.DecodeMessage(m.Serialize()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tcp.DecodeMessage(redefined.Serialize()); err == nil {
+		t.Errorf("redefinition without withdrawal should fail on a reliable transport")
+	}
+
+	udp := NewSession(DEFAULT_TEMPLATE_LIFETIME)
+	if _, err := udp.DecodeMessage(m.Serialize()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := udp.DecodeMessage(redefined.Serialize()); err != nil {
+		t.Fatal(err)
+	}
+	r, ok := udp.Template(m.ObservationDomainID, 256)
+	if !ok || len(r.(*TemplateRecord).FieldSpecifiers) != 1 {
+		t.Errorf("got %+v want redefined template", r)
+	}
+}
+
+func TestSessionTemplateWithdrawal(t *testing.T) {
+	m := testMessage()
+	withdrawal := NewMessage(2, m.ObservationDomainID, []Set{
+		*NewSet(TEMPLATE_SETS_ID, []Record{NewTemplateRecord(TEMPLATE_SETS_ID, nil)}),
+	})
+
+	s := NewSession(0)
+	for _, msg := range []*Message{m, withdrawal} {
+		if _, err := s.DecodeMessage(msg.Serialize()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := s.Template(m.ObservationDomainID, 256); ok {
+		t.Errorf("template 256 should be withdrawn")
+	}
+
+	// The Template ID can be reused after the withdrawal
+	if _, err := s.DecodeMessage(m.Serialize()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSessionTemplateLifetime(t *testing.T) {
+	now := time.Now()
+	s := NewSession(time.Minute)
+	s.now = func() time.Time { return now }
+
+	if _, err := s.DecodeMessage(testMessage().Serialize()); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.Template(61166, 256); !ok {
+		t.Fatalf("template 256 should be defined")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := s.Template(61166, 256); ok {
+		t.Errorf("template 256 should be expired")
+	}
+}
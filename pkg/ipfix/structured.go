@@ -0,0 +1,328 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package ipfix
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Structured data (RFC6313)
+
+const ( // Semantics of the lists
+	SEMANTIC_NONE_OF        uint8 = 0x00
+	SEMANTIC_EXACTLY_ONE_OF uint8 = 0x01
+	SEMANTIC_ONE_OR_MORE_OF uint8 = 0x02
+	SEMANTIC_ALL_OF         uint8 = 0x03
+	SEMANTIC_ORDERED        uint8 = 0x04
+	SEMANTIC_UNDEFINED      uint8 = 0xff
+)
+
+// Template ID and length of a subTemplateMultiList entry
+const SUB_TEMPLATE_MULTI_ENTRY uint16 = 4
+
+// BasicList is a list of zero or more values of the same Information
+// Element (RFC6313 basicList).
+type BasicList struct {
+	ElemID   uint16 // Information Element of the list, IEID_BASIC_LIST if zero
+	Semantic uint8
+	// Field Specifier of the list elements, taken from the first value if
+	// its Information Element ID is zero
+	Element FieldSpecifier
+	Values  []FieldValue
+}
+
+func (fv *BasicList) ElementID() uint16 {
+	if fv.ElemID == 0 {
+		return IEID_BASIC_LIST
+	}
+	return fv.ElemID
+}
+
+func (fv *BasicList) element() FieldSpecifier {
+	if fv.Element.InformationElementID == 0 && len(fv.Values) > 0 {
+		return *fv.Values[0].FieldSpecifier()
+	}
+	return fv.Element
+}
+
+func (fv *BasicList) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *BasicList) AppendTo(dst []uint8) []uint8 {
+	element := fv.element()
+	dst = appendVariableLengthPrefix(dst, fv.valueLen(element))
+	dst = append(dst, fv.Semantic)
+	dst = element.AppendTo(dst)
+	for _, v := range fv.Values {
+		dst = AppendFieldValue(dst, v)
+	}
+	return dst
+}
+
+func (fv *BasicList) valueLen(element FieldSpecifier) int {
+	l := 1 + int(element.Len())
+	for _, v := range fv.Values {
+		l += int(v.Len())
+	}
+	return l
+}
+
+func (fv *BasicList) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	if len(value) < 1 {
+		return fmt.Errorf("invalid basicList encoding")
+	}
+	fv.Semantic = value[0]
+
+	element, n, err := DecodeFieldSpecifier(value[1:])
+	if err != nil {
+		return err
+	}
+	fv.Element = *element
+
+	fv.Values = []FieldValue{}
+	for p := 1 + n; p < len(value); {
+		n, err := fieldLength(value[p:], *element)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("basicList element %d has no length", element.InformationElementID)
+		}
+		fv.Values = append(fv.Values, DecodeFieldValue(value[p:p+n], *element))
+		p += n
+	}
+	return nil
+}
+
+func (fv *BasicList) Len() uint16 {
+	return VariableLengthLen(fv.valueLen(fv.element()))
+}
+
+func (fv *BasicList) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+// SubTemplateList is a list of zero or more Data Records described by the
+// same Template (RFC6313 subTemplateList). The Template is defined in a
+// Template Set like any other, and client.Exporter assigns TemplateID on export.
+//
+// Records are *DataRecord. A decoded list holds a single *RawRecord until
+// its Template is resolved by a Session.
+type SubTemplateList struct {
+	ElemID     uint16 // Information Element of the list, IEID_SUB_TEMPLATE_LIST if zero
+	Semantic   uint8
+	TemplateID uint16
+	Records    []Record
+}
+
+func (fv *SubTemplateList) ElementID() uint16 {
+	if fv.ElemID == 0 {
+		return IEID_SUB_TEMPLATE_LIST
+	}
+	return fv.ElemID
+}
+
+func (fv *SubTemplateList) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SubTemplateList) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, 3+recordsLen(fv.Records))
+	dst = append(dst, fv.Semantic)
+	dst = binary.BigEndian.AppendUint16(dst, fv.TemplateID)
+	for _, r := range fv.Records {
+		dst = AppendRecord(dst, r)
+	}
+	return dst
+}
+
+func (fv *SubTemplateList) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	if len(value) < 3 {
+		return fmt.Errorf("invalid subTemplateList encoding")
+	}
+	fv.Semantic = value[0]
+	fv.TemplateID = binary.BigEndian.Uint16(value[1:3])
+	fv.Records = rawRecords(value[3:])
+	return nil
+}
+
+func (fv *SubTemplateList) Len() uint16 {
+	return VariableLengthLen(3 + recordsLen(fv.Records))
+}
+
+func (fv *SubTemplateList) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+// SubTemplateMultiListEntry is the Data Records of one Template in a
+// SubTemplateMultiList.
+type SubTemplateMultiListEntry struct {
+	TemplateID uint16
+	Records    []Record
+}
+
+// SubTemplateMultiList is a list of Data Records described by different
+// Templates (RFC6313 subTemplateMultiList). Entries are handled like
+// SubTemplateList.
+type SubTemplateMultiList struct {
+	ElemID   uint16 // Information Element of the list, IEID_SUB_TEMPLATE_MULTI_LIST if zero
+	Semantic uint8
+	Entries  []SubTemplateMultiListEntry
+}
+
+func (fv *SubTemplateMultiList) ElementID() uint16 {
+	if fv.ElemID == 0 {
+		return IEID_SUB_TEMPLATE_MULTI_LIST
+	}
+	return fv.ElemID
+}
+
+func (fv *SubTemplateMultiList) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+
+func (fv *SubTemplateMultiList) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, fv.valueLen())
+	dst = append(dst, fv.Semantic)
+	for _, e := range fv.Entries {
+		dst = binary.BigEndian.AppendUint16(dst, e.TemplateID)
+		dst = binary.BigEndian.AppendUint16(dst, uint16(int(SUB_TEMPLATE_MULTI_ENTRY)+recordsLen(e.Records)))
+		for _, r := range e.Records {
+			dst = AppendRecord(dst, r)
+		}
+	}
+	return dst
+}
+
+func (fv *SubTemplateMultiList) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	if len(value) < 1 {
+		return fmt.Errorf("invalid subTemplateMultiList encoding")
+	}
+	fv.Semantic = value[0]
+
+	fv.Entries = []SubTemplateMultiListEntry{}
+	for p := 1; p < len(value); {
+		if len(value)-p < int(SUB_TEMPLATE_MULTI_ENTRY) {
+			return fmt.Errorf("subTemplateMultiList entry has no header: %w", ErrTruncated)
+		}
+		templateID := binary.BigEndian.Uint16(value[p : p+2])
+		length := int(binary.BigEndian.Uint16(value[p+2 : p+4]))
+		if length < int(SUB_TEMPLATE_MULTI_ENTRY) || len(value)-p < length {
+			return fmt.Errorf("invalid subTemplateMultiList entry length: %d", length)
+		}
+		fv.Entries = append(fv.Entries, SubTemplateMultiListEntry{
+			TemplateID: templateID,
+			Records:    rawRecords(value[p+int(SUB_TEMPLATE_MULTI_ENTRY) : p+length]),
+		})
+		p += length
+	}
+	return nil
+}
+
+func (fv *SubTemplateMultiList) valueLen() int {
+	l := 1
+	for _, e := range fv.Entries {
+		l += int(SUB_TEMPLATE_MULTI_ENTRY) + recordsLen(e.Records)
+	}
+	return l
+}
+
+func (fv *SubTemplateMultiList) Len() uint16 {
+	return VariableLengthLen(fv.valueLen())
+}
+
+func (fv *SubTemplateMultiList) FieldSpecifier() *FieldSpecifier {
+	templateLen := VARIABLE_LENGTH
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, ENTERPRISE_NUMBER_NTTCOM)
+	return fs
+}
+
+func rawRecords(data []uint8) []Record {
+	if len(data) == 0 {
+		return []Record{}
+	}
+	return []Record{&RawRecord{Value: data}}
+}
+
+func recordsLen(records []Record) int {
+	l := 0
+	for _, r := range records {
+		l += int(r.Len())
+	}
+	return l
+}
+
+// resolveRecords decodes the Data Records of the structured data in
+// records, which are still RawRecords, with the Templates returned by
+// lookup. Lists of unknown Templates are kept as they are.
+func resolveRecords(records []Record, lookup func(templateID uint16) ([]FieldSpecifier, bool)) error {
+	for _, r := range records {
+		dr, ok := r.(*DataRecord)
+		if !ok {
+			continue
+		}
+		for _, fv := range dr.FieldValues {
+			var err error
+			switch fv := fv.(type) {
+			case *SubTemplateList:
+				fv.Records, err = resolveList(fv.TemplateID, fv.Records, lookup)
+			case *SubTemplateMultiList:
+				for i := range fv.Entries {
+					e := &fv.Entries[i]
+					if e.Records, err = resolveList(e.TemplateID, e.Records, lookup); err != nil {
+						break
+					}
+				}
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resolveList(templateID uint16, records []Record, lookup func(templateID uint16) ([]FieldSpecifier, bool)) ([]Record, error) {
+	if len(records) != 1 {
+		return records, nil
+	}
+	raw, ok := records[0].(*RawRecord)
+	if !ok {
+		return records, nil
+	}
+	fss, ok := lookup(templateID)
+	if !ok {
+		return records, nil
+	}
+
+	decoded, err := DecodeDataRecords(raw.Value, fss)
+	if err != nil {
+		return nil, fmt.Errorf("sub template %d: %w", templateID, err)
+	}
+	// Lists may be nested
+	if err := resolveRecords(decoded, lookup); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
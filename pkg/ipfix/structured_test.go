@@ -0,0 +1,156 @@
+package ipfix
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBasicList(t *testing.T) {
+	for _, semantic := range []uint8{
+		SEMANTIC_NONE_OF,
+		SEMANTIC_EXACTLY_ONE_OF,
+		SEMANTIC_ONE_OR_MORE_OF,
+		SEMANTIC_ALL_OF,
+		SEMANTIC_ORDERED,
+		SEMANTIC_UNDEFINED,
+	} {
+		fv := &BasicList{
+			Semantic: semantic,
+			Values:   []FieldValue{&PacketDeltaCount{Val: 1}, &PacketDeltaCount{Val: 2}},
+		}
+		data := fv.Serialize()
+		if int(fv.Len()) != len(data) {
+			t.Errorf("semantic %d: got Len %d want %d", semantic, fv.Len(), len(data))
+		}
+
+		got, ok := DecodeFieldValue(data, *fv.FieldSpecifier()).(*BasicList)
+		if !ok {
+			t.Fatalf("semantic %d: basicList is not decoded", semantic)
+		}
+		if got.Semantic != semantic {
+			t.Errorf("got semantic %d want %d", got.Semantic, semantic)
+		}
+		if got.Element.InformationElementID != IEID_PACKET_DELTA_COUNT || len(got.Values) != 2 {
+			t.Fatalf("semantic %d: got %+v want 2 packetDeltaCount", semantic, got)
+		}
+		if v := got.Values[1].(*PacketDeltaCount).Val; v != 2 {
+			t.Errorf("got value %d want %d", v, 2)
+		}
+	}
+
+	// An empty list keeps the element
+	fv := &BasicList{Element: *(&PacketDeltaCount{}).FieldSpecifier()}
+	got := DecodeFieldValue(fv.Serialize(), *fv.FieldSpecifier()).(*BasicList)
+	if got.Element.InformationElementID != IEID_PACKET_DELTA_COUNT || len(got.Values) != 0 {
+		t.Errorf("got %+v want empty list of packetDeltaCount", got)
+	}
+}
+
+// testStructuredMessage returns a Message whose data record holds nested
+// per-hop records: a subTemplateList of hops, each with a subTemplateList
+// of segments, and a subTemplateMultiList.
+func testStructuredMessage() *Message {
+	segment := NewTemplateRecord(256, []FieldSpecifier{*(&SRHTagIPv6{}).FieldSpecifier()})
+	hop := NewTemplateRecord(257, []FieldSpecifier{
+		*(&SRHFlagsIPv6{}).FieldSpecifier(),
+		*(&SubTemplateList{}).FieldSpecifier(),
+	})
+	flow := NewTemplateRecord(258, []FieldSpecifier{
+		*(&PacketDeltaCount{}).FieldSpecifier(),
+		*(&SubTemplateList{}).FieldSpecifier(),
+		*(&SubTemplateMultiList{}).FieldSpecifier(),
+	})
+
+	hopRecord := func(flags uint8, tags ...uint16) Record {
+		var segments []Record
+		for _, tag := range tags {
+			segments = append(segments, &DataRecord{FieldValues: []FieldValue{&SRHTagIPv6{Val: tag}}})
+		}
+		return &DataRecord{FieldValues: []FieldValue{
+			&SRHFlagsIPv6{Val: flags},
+			&SubTemplateList{Semantic: SEMANTIC_ORDERED, TemplateID: 256, Records: segments},
+		}}
+	}
+	data := &DataRecord{FieldValues: []FieldValue{
+		&PacketDeltaCount{Val: 10},
+		&SubTemplateList{Semantic: SEMANTIC_ORDERED, TemplateID: 257, Records: []Record{
+			hopRecord(1, 100, 101),
+			hopRecord(2),
+		}},
+		&SubTemplateMultiList{Semantic: SEMANTIC_ALL_OF, Entries: []SubTemplateMultiListEntry{
+			{TemplateID: 256, Records: []Record{&DataRecord{FieldValues: []FieldValue{&SRHTagIPv6{Val: 200}}}}},
+			{TemplateID: 257, Records: []Record{hopRecord(3, 300)}},
+		}},
+	}}
+
+	return NewMessage(1, 1, []Set{
+		*NewSet(TEMPLATE_SETS_ID, []Record{segment, hop, flow}),
+		*NewSet(258, []Record{data}),
+	})
+}
+
+func TestSessionStructuredData(t *testing.T) {
+	m := testStructuredMessage()
+	data := m.Serialize()
+	if int(m.Len()) != len(data) {
+		t.Errorf("got Len %d want %d", m.Len(), len(data))
+	}
+
+	s := NewSession(0)
+	decoded, err := s.DecodeMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decoded.Serialize(), data) {
+		t.Errorf("got %x want %x", decoded.Serialize(), data)
+	}
+
+	fvs := decoded.Sets[1].Records[0].(*DataRecord).FieldValues
+	hops, ok := fvs[1].(*SubTemplateList)
+	if !ok || hops.Semantic != SEMANTIC_ORDERED || hops.TemplateID != 257 || len(hops.Records) != 2 {
+		t.Fatalf("got %#v want subTemplateList of 2 hops", fvs[1])
+	}
+	hop := hops.Records[0].(*DataRecord).FieldValues
+	if v := hop[0].(*SRHFlagsIPv6).Val; v != 1 {
+		t.Errorf("got flags %d want %d", v, 1)
+	}
+	segments := hop[1].(*SubTemplateList).Records
+	if len(segments) != 2 {
+		t.Fatalf("got %d segments want %d", len(segments), 2)
+	}
+	if v := segments[1].(*DataRecord).FieldValues[0].(*SRHTagIPv6).Val; v != 101 {
+		t.Errorf("got tag %d want %d", v, 101)
+	}
+	if l := hops.Records[1].(*DataRecord).FieldValues[1].(*SubTemplateList); len(l.Records) != 0 {
+		t.Errorf("got %d segments want an empty list", len(l.Records))
+	}
+
+	multi, ok := fvs[2].(*SubTemplateMultiList)
+	if !ok || multi.Semantic != SEMANTIC_ALL_OF || len(multi.Entries) != 2 {
+		t.Fatalf("got %#v want subTemplateMultiList of 2 entries", fvs[2])
+	}
+	if v := multi.Entries[0].Records[0].(*DataRecord).FieldValues[0].(*SRHTagIPv6).Val; v != 200 {
+		t.Errorf("got tag %d want %d", v, 200)
+	}
+	if v := multi.Entries[1].Records[0].(*DataRecord).FieldValues[0].(*SRHFlagsIPv6).Val; v != 3 {
+		t.Errorf("got flags %d want %d", v, 3)
+	}
+}
+
+func TestSessionStructuredDataUnknownTemplate(t *testing.T) {
+	m := testStructuredMessage()
+	// The template of the segments is missing
+	m.Sets[0].Records = m.Sets[0].Records[1:]
+
+	decoded, err := NewSession(0).DecodeMessage(m.Serialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	multi := decoded.Sets[1].Records[0].(*DataRecord).FieldValues[2].(*SubTemplateMultiList)
+	if _, ok := multi.Entries[0].Records[0].(*RawRecord); !ok {
+		t.Errorf("got %T want *RawRecord", multi.Entries[0].Records[0])
+	}
+	if _, ok := multi.Entries[1].Records[0].(*DataRecord); !ok {
+		t.Errorf("got %T want *DataRecord", multi.Entries[1].Records[0])
+	}
+}
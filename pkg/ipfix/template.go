@@ -0,0 +1,168 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package ipfix
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Registry used by TemplateBuilders unless another one is given
+var defaultRegistry = sync.OnceValue(NewRegistry)
+
+// Template is a validated Template or Options Template (RFC7011 3.4), which
+// checks that its Data Records are encoded as the Field Specifiers declare.
+type Template struct {
+	TemplateID      uint16
+	ScopeFieldCount uint16 // non-zero for an Options Template
+	FieldSpecifiers []FieldSpecifier
+}
+
+// Record returns the Template Record or the Options Template Record of t.
+func (t *Template) Record() Record {
+	if t.ScopeFieldCount > 0 {
+		return NewOptionTemplateRecord(t.TemplateID, t.ScopeFieldCount, t.FieldSpecifiers)
+	}
+	return NewTemplateRecord(t.TemplateID, t.FieldSpecifiers)
+}
+
+// Buffers of the field values encoded by Validate
+var validateBuffers = sync.Pool{
+	New: func() any {
+		buf := make([]uint8, 0, 256)
+		return &buf
+	},
+}
+
+// Validate checks that each field value of r is the Information Element of
+// its Field Specifier, and that it encodes as many bytes as both its Len and
+// the field length declare. A record that does not would corrupt the rest
+// of the Data Set.
+func (t *Template) Validate(r *DataRecord) error {
+	if len(r.FieldValues) != len(t.FieldSpecifiers) {
+		return fmt.Errorf("template %d: got %d field values want %d", t.TemplateID, len(r.FieldValues), len(t.FieldSpecifiers))
+	}
+
+	buf := validateBuffers.Get().(*[]uint8)
+	defer validateBuffers.Put(buf)
+	for i, fv := range r.FieldValues {
+		fs := &t.FieldSpecifiers[i]
+		if got := fv.FieldSpecifier(); got.InformationElementID != fs.InformationElementID ||
+			got.E != fs.E || got.EnterpriseNumber != fs.EnterpriseNumber {
+			return fmt.Errorf("template %d field %d: got element %d.%d want %d.%d", t.TemplateID, i,
+				got.EnterpriseNumber, got.InformationElementID, fs.EnterpriseNumber, fs.InformationElementID)
+		}
+
+		*buf = AppendFieldValue((*buf)[:0], fv)
+		n := len(*buf)
+		if n != int(fv.Len()) {
+			return fmt.Errorf("template %d field %d: %T encodes %d bytes but its length is %d", t.TemplateID, i, fv, n, fv.Len())
+		}
+		if fs.FieldLength != VARIABLE_LENGTH {
+			if n != int(fs.FieldLength) {
+				return fmt.Errorf("template %d field %d: %T encodes %d bytes but the field length is %d", t.TemplateID, i, fv, n, fs.FieldLength)
+			}
+			continue
+		}
+		if _, m, err := DecodeVariableLength(*buf); err != nil || m != n {
+			return fmt.Errorf("template %d field %d: %T has an invalid variable-length encoding", t.TemplateID, i, fv)
+		}
+	}
+	return nil
+}
+
+// TemplateBuilder builds a Template field by field, checking the field
+// lengths against the data types of the Information Elements known by its
+// Registry. Errors are reported by Build.
+type TemplateBuilder struct {
+	templateID      uint16
+	scopeFieldCount uint16
+	fieldSpecifiers []FieldSpecifier
+	registry        *Registry
+	err             error // first error of the fields
+}
+
+// NewTemplateBuilder returns a TemplateBuilder of the Template with
+// templateID, knowing the IANA Information Elements and those of fluvia.
+func NewTemplateBuilder(templateID uint16) *TemplateBuilder {
+	return &TemplateBuilder{templateID: templateID}
+}
+
+// WithRegistry replaces the Registry of the Information Elements, e.g. with
+// one knowing enterprise-specific Information Elements.
+func (b *TemplateBuilder) WithRegistry(r *Registry) *TemplateBuilder {
+	b.registry = r
+	return b
+}
+
+func (b *TemplateBuilder) informationElements() *Registry {
+	if b.registry == nil {
+		return defaultRegistry()
+	}
+	return b.registry
+}
+
+func (b *TemplateBuilder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// AddField adds a field of the Template.
+func (b *TemplateBuilder) AddField(fs FieldSpecifier) *TemplateBuilder {
+	if fs.FieldLength == 0 {
+		b.setErr(fmt.Errorf("field %d: element %d has no length", len(b.fieldSpecifiers), fs.InformationElementID))
+	} else if ie, ok := b.informationElements().LookupFieldSpecifier(fs); ok && !ie.DataType.ValidLength(fs.FieldLength) {
+		b.setErr(fmt.Errorf("field %d: invalid length of %s (%s): %d", len(b.fieldSpecifiers), ie.Name, ie.DataType, fs.FieldLength))
+	}
+	b.fieldSpecifiers = append(b.fieldSpecifiers, fs)
+	return b
+}
+
+// AddScopeField adds a scope field, which makes the Template an Options
+// Template. Scope fields precede the other fields (RFC7011 3.4.2.2).
+func (b *TemplateBuilder) AddScopeField(fs FieldSpecifier) *TemplateBuilder {
+	if int(b.scopeFieldCount) != len(b.fieldSpecifiers) {
+		b.setErr(fmt.Errorf("field %d: scope field %d follows a non-scope field", len(b.fieldSpecifiers), fs.InformationElementID))
+	}
+	b.AddField(fs)
+	b.scopeFieldCount++
+	return b
+}
+
+// AddFieldName adds a field of the Information Element with name, and of
+// length or its default length if zero.
+func (b *TemplateBuilder) AddFieldName(name string, length uint16) *TemplateBuilder {
+	ie, ok := b.informationElements().LookupName(name)
+	if !ok {
+		b.setErr(fmt.Errorf("field %d: unknown information element: %s", len(b.fieldSpecifiers), name))
+		b.fieldSpecifiers = append(b.fieldSpecifiers, FieldSpecifier{})
+		return b
+	}
+	fs := *ie.FieldSpecifier()
+	if length != 0 {
+		fs.FieldLength = length
+	}
+	return b.AddField(fs)
+}
+
+// Build returns the Template, or the first error of its fields.
+func (b *TemplateBuilder) Build() (*Template, error) {
+	if b.err != nil {
+		return nil, fmt.Errorf("template %d: %w", b.templateID, b.err)
+	}
+	if b.templateID < MIN_DATA_SETS_ID {
+		return nil, fmt.Errorf("invalid template id: %d", b.templateID)
+	}
+	if len(b.fieldSpecifiers) == 0 {
+		return nil, fmt.Errorf("template %d has no fields", b.templateID)
+	}
+	return &Template{
+		TemplateID:      b.templateID,
+		ScopeFieldCount: b.scopeFieldCount,
+		FieldSpecifiers: b.fieldSpecifiers,
+	}, nil
+}
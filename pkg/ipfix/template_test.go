@@ -0,0 +1,125 @@
+package ipfix
+
+import (
+	"net/netip"
+	"testing"
+)
+
+// shortFieldValue declares a length of one more octet than it encodes.
+type shortFieldValue struct {
+	PacketDeltaCount
+}
+
+func (fv *shortFieldValue) Len() uint16 {
+	return fv.PacketDeltaCount.Len() + 1
+}
+
+func (fv *shortFieldValue) Serialize() []uint8 {
+	return fv.PacketDeltaCount.Serialize()
+}
+
+func TestTemplateBuilder(t *testing.T) {
+	tmpl, err := NewTemplateBuilder(256).
+		AddScopeField(*(&SRHActiveSegmentIPv6{}).FieldSpecifier()).
+		AddFieldName("octetDeltaCount", 4).
+		AddFieldName("packetDeltaCount", 0).
+		AddField(*(&SRHSegmentIPv6BasicList{}).FieldSpecifier()).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tmpl.TemplateID != 256 || tmpl.ScopeFieldCount != 1 || len(tmpl.FieldSpecifiers) != 4 {
+		t.Errorf("got %+v", tmpl)
+	}
+	if fs := tmpl.FieldSpecifiers[1]; fs.InformationElementID != 1 || fs.FieldLength != 4 {
+		t.Errorf("got %+v", fs)
+	}
+	if fs := tmpl.FieldSpecifiers[2]; fs.InformationElementID != 2 || fs.FieldLength != 8 {
+		t.Errorf("got %+v", fs)
+	}
+	if _, ok := tmpl.Record().(*OptionsTemplateRecord); !ok {
+		t.Errorf("got %T want *OptionsTemplateRecord", tmpl.Record())
+	}
+
+	r := &DataRecord{FieldValues: []FieldValue{
+		&SRHActiveSegmentIPv6{Val: netip.MustParseAddr("2001:db8::1")},
+		&OctetDeltaCount{Val: 1500, Length: 4},
+		&PacketDeltaCount{Val: 1},
+		&SRHSegmentIPv6BasicList{SegmentList: []SRHSegmentIPv6{{Val: netip.MustParseAddr("2001:db8::2")}}},
+	}}
+	if err := tmpl.Validate(r); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTemplateBuilderError(t *testing.T) {
+	ipv4 := *NewFieldSpecifier(false, 8, 4, 0) // sourceIPv4Address
+	for _, tc := range []struct {
+		name string
+		b    *TemplateBuilder
+	}{
+		{
+			name: "invalid length",
+			b:    NewTemplateBuilder(256).AddField(*NewFieldSpecifier(false, 8, 2, 0)),
+		},
+		{
+			name: "zero length",
+			b:    NewTemplateBuilder(256).AddField(*NewFieldSpecifier(false, 1, 0, 0)),
+		},
+		{
+			name: "scope field after a field",
+			b:    NewTemplateBuilder(256).AddField(ipv4).AddScopeField(ipv4),
+		},
+		{
+			name: "unknown name",
+			b:    NewTemplateBuilder(256).AddFieldName("unknown", 0),
+		},
+		{
+			name: "invalid template id",
+			b:    NewTemplateBuilder(255).AddField(ipv4),
+		},
+		{
+			name: "no fields",
+			b:    NewTemplateBuilder(256),
+		},
+	} {
+		if _, err := tc.b.Build(); err == nil {
+			t.Errorf("%s: got no error", tc.name)
+		}
+	}
+}
+
+func TestTemplateValidateError(t *testing.T) {
+	tmpl, err := NewTemplateBuilder(256).
+		AddField(*(&PacketDeltaCount{}).FieldSpecifier()).
+		AddField(*(&OctetDeltaCount{}).FieldSpecifier()).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name string
+		fvs  []FieldValue
+	}{
+		{
+			name: "field count",
+			fvs:  []FieldValue{&PacketDeltaCount{Val: 1}},
+		},
+		{
+			name: "element",
+			fvs:  []FieldValue{&PacketDeltaCount{Val: 1}, &SRHFlagsIPv6{Val: 1}},
+		},
+		{
+			name: "reduced size",
+			fvs:  []FieldValue{&PacketDeltaCount{Val: 1}, &OctetDeltaCount{Val: 1, Length: 4}},
+		},
+		{
+			name: "length",
+			fvs:  []FieldValue{&shortFieldValue{PacketDeltaCount{Val: 1}}, &OctetDeltaCount{Val: 1}},
+		},
+	} {
+		if err := tmpl.Validate(&DataRecord{FieldValues: tc.fvs}); err == nil {
+			t.Errorf("%s: got no error", tc.name)
+		}
+	}
+}
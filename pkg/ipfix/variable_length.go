@@ -0,0 +1,78 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package ipfix
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Lengths of 255 or more are encoded in 3 bytes (RFC7011 7.)
+const VARIABLE_LENGTH_LONG uint8 = 255
+
+// EncodeVariableLength prefixes the value of a variable-length Information
+// Element with its length, in 1 byte if it is less than 255 bytes and in 3
+// bytes otherwise (RFC7011 7.). The value must not exceed 65535 bytes.
+func EncodeVariableLength(value []uint8) []uint8 {
+	return AppendVariableLength(make([]uint8, 0, VariableLengthLen(len(value))), value)
+}
+
+// AppendVariableLength appends the value of a variable-length Information
+// Element prefixed with its length to dst, as EncodeVariableLength.
+func AppendVariableLength(dst []uint8, value []uint8) []uint8 {
+	return append(appendVariableLengthPrefix(dst, len(value)), value...)
+}
+
+// appendVariableLengthPrefix appends the length prefix of a variable-length
+// value of valueLen bytes to dst.
+func appendVariableLengthPrefix(dst []uint8, valueLen int) []uint8 {
+	if valueLen < int(VARIABLE_LENGTH_LONG) {
+		return append(dst, uint8(valueLen))
+	}
+	dst = append(dst, VARIABLE_LENGTH_LONG)
+	return binary.BigEndian.AppendUint16(dst, uint16(valueLen))
+}
+
+// VariableLengthLen returns the encoded length of a variable-length value
+// of valueLen bytes, including its length prefix.
+func VariableLengthLen(valueLen int) uint16 {
+	if valueLen < int(VARIABLE_LENGTH_LONG) {
+		return uint16(1 + valueLen)
+	}
+	return uint16(3 + valueLen)
+}
+
+// DecodeVariableLength decodes the variable-length value at the head of data,
+// returning the value and the number of bytes consumed with the prefix.
+func DecodeVariableLength(data []uint8) ([]uint8, int, error) {
+	if len(data) < 1 {
+		return nil, 0, fmt.Errorf("no length: %w", ErrTruncated)
+	}
+	hdrLen, length := 1, int(data[0])
+	if data[0] == VARIABLE_LENGTH_LONG {
+		if len(data) < 3 {
+			return nil, 0, fmt.Errorf("no length: %w", ErrTruncated)
+		}
+		hdrLen, length = 3, int(binary.BigEndian.Uint16(data[1:3]))
+	}
+	if len(data) < hdrLen+length {
+		return nil, 0, fmt.Errorf("value is less than %d bytes: %w", length, ErrTruncated)
+	}
+	return data[hdrLen : hdrLen+length], hdrLen + length, nil
+}
+
+// decodeVariableLengthField decodes the whole encoded field data of a
+// variable-length FieldValue into its value.
+func decodeVariableLengthField(data []uint8) ([]uint8, error) {
+	value, n, err := DecodeVariableLength(data)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(data) {
+		return nil, fmt.Errorf("invalid field length: got %d want %d", len(data), n)
+	}
+	return value, nil
+}
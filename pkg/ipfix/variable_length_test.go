@@ -0,0 +1,69 @@
+package ipfix
+
+import (
+	"bytes"
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestVariableLength(t *testing.T) {
+	for _, tt := range []struct {
+		valueLen int
+		hdrLen   int
+	}{
+		{0, 1},
+		{254, 1},
+		{255, 3},
+		{65000, 3},
+	} {
+		value := bytes.Repeat([]uint8{0xaa}, tt.valueLen)
+		data := EncodeVariableLength(value)
+		if len(data) != tt.hdrLen+tt.valueLen || int(VariableLengthLen(tt.valueLen)) != len(data) {
+			t.Errorf("length %d: got %d bytes, VariableLengthLen %d want %d", tt.valueLen, len(data), VariableLengthLen(tt.valueLen), tt.hdrLen+tt.valueLen)
+		}
+
+		got, n, err := DecodeVariableLength(append(data, 0xbb))
+		if err != nil {
+			t.Fatalf("length %d: %v", tt.valueLen, err)
+		}
+		if n != len(data) || !bytes.Equal(got, value) {
+			t.Errorf("length %d: got %d bytes consumed want %d", tt.valueLen, n, len(data))
+		}
+
+		if _, _, err := DecodeVariableLength(data[:len(data)-1]); tt.valueLen > 0 && !errors.Is(err, ErrTruncated) {
+			t.Errorf("length %d: got %v want %v", tt.valueLen, err, ErrTruncated)
+		}
+	}
+}
+
+func TestVariableLengthFieldValues(t *testing.T) {
+	for _, segments := range []int{0, 15, 16, 100} {
+		var addrs []netip.Addr
+		var sl []SRHSegmentIPv6
+		for i := 0; i < segments; i++ {
+			addr := netip.AddrFrom16([16]uint8{0x20, 0x01, 0x0d, 0xb8, 15: uint8(i)})
+			addrs = append(addrs, addr)
+			sl = append(sl, SRHSegmentIPv6{Val: addr})
+		}
+
+		for _, fv := range []FieldValue{
+			&SRHSegmentIPv6BasicList{SegmentList: sl},
+			&SRHSegmentIPv6ListSection{SegmentList: addrs},
+		} {
+			data := fv.Serialize()
+			if int(fv.Len()) != len(data) {
+				t.Errorf("%T with %d segments: got Len %d want %d", fv, segments, fv.Len(), len(data))
+			}
+
+			got := DecodeFieldValue(data, *fv.FieldSpecifier())
+			if _, ok := got.(*UndefinedFieldValue); ok {
+				t.Errorf("%T with %d segments is not decoded", fv, segments)
+				continue
+			}
+			if !bytes.Equal(got.Serialize(), data) {
+				t.Errorf("%T with %d segments: got %x want %x", fv, segments, got.Serialize(), data)
+			}
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package netflow9
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+const (
+	VERSION    uint16 = 9  // RFC3954 5.1
+	HEADER_LEN        = 20 // RFC3954 5.1
+)
+
+const (
+	TEMPLATE_FLOWSET_ID         uint16 = 0   // RFC3954 5.2
+	OPTIONS_TEMPLATE_FLOWSET_ID uint16 = 1   // RFC3954 6.1
+	MIN_DATA_FLOWSET_ID         uint16 = 256 // RFC3954 5.3
+)
+
+const ( // RFC3954 6.1
+	SCOPE_SYSTEM    uint16 = 1
+	SCOPE_INTERFACE uint16 = 2
+	SCOPE_LINE_CARD uint16 = 3
+	SCOPE_CACHE     uint16 = 4
+	SCOPE_TEMPLATE  uint16 = 5
+)
+
+// Scope field types of the Information Elements used as scope in IPFIX,
+// whose IDs mean other things in NetFlow v9 options templates
+var scopeFieldTypes = map[uint16]uint16{
+	ipfix.IEID_OBSERVATION_DOMAIN_ID: SCOPE_SYSTEM,
+	ipfix.IEID_EXPORTING_PROCESS_ID:  SCOPE_SYSTEM,
+	ipfix.IEID_INGRESS_INTERFACE:     SCOPE_INTERFACE,
+	ipfix.IEID_EGRESS_INTERFACE:      SCOPE_INTERFACE,
+	ipfix.IEID_LINE_CARD_ID:          SCOPE_LINE_CARD,
+	ipfix.IEID_TEMPLATE_ID:           SCOPE_TEMPLATE,
+}
+
+// Element identifies an enterprise-specific Information Element.
+type Element struct {
+	EnterpriseNumber uint32
+	ElementID        uint16
+}
+
+// Encoder encodes ipfix.Messages as NetFlow v9 Export Packets (RFC3954), for
+// collectors that do not understand IPFIX. Field types are the Information
+// Element IDs, which NetFlow v9 shares with IPFIX, so field values and
+// templates are encoded as in IPFIX. NetFlow v9 carries neither
+// enterprise-specific Information Elements, unless they are remapped to
+// field types, nor variable-length fields.
+type Encoder struct {
+	boot       time.Time // origin of sysUpTime
+	sequence   uint32
+	fieldTypes map[Element]uint16
+}
+
+// NewEncoder returns an Encoder whose sysUpTime counts from now.
+func NewEncoder() *Encoder {
+	return &Encoder{boot: time.Now(), fieldTypes: make(map[Element]uint16)}
+}
+
+// Remap exports an enterprise-specific Information Element as fieldType,
+// e.g. a type the collector is configured to understand.
+func (e *Encoder) Remap(el Element, fieldType uint16) {
+	e.fieldTypes[el] = fieldType
+}
+
+// FieldType returns the field type of a Field Specifier, or an error if
+// NetFlow v9 cannot carry it.
+func (e *Encoder) FieldType(fs ipfix.FieldSpecifier) (uint16, error) {
+	if fs.FieldLength == ipfix.VARIABLE_LENGTH {
+		return 0, fmt.Errorf("variable-length field %d is not supported", fs.InformationElementID)
+	}
+	if !fs.E {
+		return fs.InformationElementID, nil
+	}
+	fieldType, ok := e.fieldTypes[Element{fs.EnterpriseNumber, fs.InformationElementID}]
+	if !ok {
+		return 0, fmt.Errorf("enterprise-specific field %d.%d is not remapped", fs.EnterpriseNumber, fs.InformationElementID)
+	}
+	return fieldType, nil
+}
+
+// ScopeFieldType returns the scope field type of the Field Specifier of a
+// scope field, or an error if NetFlow v9 has none.
+func (e *Encoder) ScopeFieldType(fs ipfix.FieldSpecifier) (uint16, error) {
+	if !fs.E {
+		if fieldType, ok := scopeFieldTypes[fs.InformationElementID]; ok {
+			return fieldType, nil
+		}
+	}
+	return 0, fmt.Errorf("scope field %d has no scope field type", fs.InformationElementID)
+}
+
+// AppendMessage appends the Export Packet of m to dst. Its Sequence Number
+// counts the Export Packets of the Encoder (RFC3954 5.1), and m.SequenceNumber
+// is ignored. The Source ID is m.ObservationDomainID.
+func (e *Encoder) AppendMessage(dst []uint8, m *ipfix.Message) ([]uint8, error) {
+	start := len(dst)
+	count := 0
+	for _, s := range m.Sets {
+		count += len(s.Records)
+	}
+	exportTime := m.ExportTime
+	if exportTime == 0 {
+		exportTime = uint32(time.Now().Unix())
+	}
+
+	dst = binary.BigEndian.AppendUint16(dst, VERSION)
+	dst = binary.BigEndian.AppendUint16(dst, uint16(count))
+	dst = binary.BigEndian.AppendUint32(dst, uint32(time.Since(e.boot).Milliseconds()))
+	dst = binary.BigEndian.AppendUint32(dst, exportTime)
+	dst = binary.BigEndian.AppendUint32(dst, e.sequence)
+	dst = binary.BigEndian.AppendUint32(dst, m.ObservationDomainID)
+	for i := range m.Sets {
+		var err error
+		if dst, err = e.appendFlowSet(dst, &m.Sets[i]); err != nil {
+			return dst[:start], err
+		}
+	}
+	e.sequence++
+	return dst, nil
+}
+
+// appendFlowSet appends a set as a FlowSet padded to 4 octets.
+func (e *Encoder) appendFlowSet(dst []uint8, s *ipfix.Set) ([]uint8, error) {
+	start := len(dst)
+	switch s.SetID {
+	case ipfix.TEMPLATE_SETS_ID:
+		dst = binary.BigEndian.AppendUint16(dst, TEMPLATE_FLOWSET_ID)
+	case ipfix.OPTIONS_TEMPLATE_SETS_ID:
+		dst = binary.BigEndian.AppendUint16(dst, OPTIONS_TEMPLATE_FLOWSET_ID)
+	default:
+		if s.SetID < ipfix.MIN_DATA_SETS_ID {
+			return dst, fmt.Errorf("invalid set id: %d", s.SetID)
+		}
+		dst = binary.BigEndian.AppendUint16(dst, s.SetID)
+	}
+	dst = append(dst, 0, 0) // length
+
+	for _, r := range s.Records {
+		var err error
+		switch r := r.(type) {
+		case *ipfix.TemplateRecord:
+			dst, err = e.appendTemplate(dst, r)
+		case *ipfix.OptionsTemplateRecord:
+			dst, err = e.appendOptionsTemplate(dst, r)
+		default:
+			dst = ipfix.AppendRecord(dst, r)
+		}
+		if err != nil {
+			return dst, err
+		}
+	}
+	for (len(dst)-start)%4 != 0 {
+		dst = append(dst, 0)
+	}
+	if len(dst)-start > 0xffff {
+		return dst, fmt.Errorf("flowset %d is too long: %d", s.SetID, len(dst)-start)
+	}
+	binary.BigEndian.PutUint16(dst[start+2:], uint16(len(dst)-start))
+	return dst, nil
+}
+
+// RFC3954 5.2
+func (e *Encoder) appendTemplate(dst []uint8, r *ipfix.TemplateRecord) ([]uint8, error) {
+	dst = binary.BigEndian.AppendUint16(dst, r.TemplateID)
+	dst = binary.BigEndian.AppendUint16(dst, uint16(len(r.FieldSpecifiers)))
+	for _, fs := range r.FieldSpecifiers {
+		fieldType, err := e.FieldType(fs)
+		if err != nil {
+			return dst, fmt.Errorf("template %d: %w", r.TemplateID, err)
+		}
+		dst = binary.BigEndian.AppendUint16(dst, fieldType)
+		dst = binary.BigEndian.AppendUint16(dst, fs.FieldLength)
+	}
+	return dst, nil
+}
+
+// RFC3954 6.1. The padding is that of the FlowSet.
+func (e *Encoder) appendOptionsTemplate(dst []uint8, r *ipfix.OptionsTemplateRecord) ([]uint8, error) {
+	scopeCount := int(r.ScopeFieldCount)
+	if scopeCount > len(r.FieldSpecifiers) {
+		return dst, fmt.Errorf("options template %d: invalid scope field count: %d", r.TemplateID, scopeCount)
+	}
+	dst = binary.BigEndian.AppendUint16(dst, r.TemplateID)
+	dst = binary.BigEndian.AppendUint16(dst, uint16(4*scopeCount))
+	dst = binary.BigEndian.AppendUint16(dst, uint16(4*(len(r.FieldSpecifiers)-scopeCount)))
+	for i, fs := range r.FieldSpecifiers {
+		var fieldType uint16
+		var err error
+		if i < scopeCount {
+			fieldType, err = e.ScopeFieldType(fs)
+		} else {
+			fieldType, err = e.FieldType(fs)
+		}
+		if err != nil {
+			return dst, fmt.Errorf("options template %d: %w", r.TemplateID, err)
+		}
+		dst = binary.BigEndian.AppendUint16(dst, fieldType)
+		dst = binary.BigEndian.AppendUint16(dst, fs.FieldLength)
+	}
+	return dst, nil
+}
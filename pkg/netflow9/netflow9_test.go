@@ -0,0 +1,116 @@
+package netflow9
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/netip"
+	"testing"
+
+	"github.com/nttcom/fluvia/pkg/ipfix"
+)
+
+func TestEncoderAppendMessage(t *testing.T) {
+	fvs := []ipfix.FieldValue{
+		&ipfix.PacketDeltaCount{Val: 100},
+		&ipfix.SRHActiveSegmentIPv6{Val: netip.MustParseAddr("2001:db8::1")},
+		&ipfix.UndefinedFieldValue{ElemID: 1, Value: []uint8{0xab}, TemplateLen: 1, EnterpriseNumber: 29319},
+	}
+	var fss []ipfix.FieldSpecifier
+	for _, fv := range fvs {
+		fss = append(fss, *fv.FieldSpecifier())
+	}
+	scope := &ipfix.ObservationDomainId{Val: 61166}
+	stats := &ipfix.ExportedMessageTotalCount{Val: 1}
+	m := ipfix.NewMessage(100, 61166, []ipfix.Set{
+		*ipfix.NewSet(ipfix.TEMPLATE_SETS_ID, []ipfix.Record{ipfix.NewTemplateRecord(256, fss)}),
+		*ipfix.NewSet(ipfix.OPTIONS_TEMPLATE_SETS_ID, []ipfix.Record{
+			ipfix.NewOptionTemplateRecord(257, 1, []ipfix.FieldSpecifier{*scope.FieldSpecifier(), *stats.FieldSpecifier()}),
+		}),
+		*ipfix.NewSet(256, []ipfix.Record{&ipfix.DataRecord{FieldValues: fvs}}),
+		*ipfix.NewSet(257, []ipfix.Record{&ipfix.DataRecord{FieldValues: []ipfix.FieldValue{scope, stats}}}),
+	})
+	m.ExportTime = 0x6538d5f6
+
+	e := NewEncoder()
+	if _, err := e.AppendMessage(nil, m); err == nil {
+		t.Fatal("got no error for an enterprise-specific field")
+	}
+
+	e.Remap(Element{EnterpriseNumber: 29319, ElementID: 1}, 40001)
+	got, err := e.AppendMessage([]uint8{0xff}, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[0] != 0xff {
+		t.Fatalf("dst is overwritten")
+	}
+	got = got[1:]
+
+	header := got[:HEADER_LEN]
+	if v := binary.BigEndian.Uint16(header[0:2]); v != VERSION {
+		t.Errorf("got version %d want %d", v, VERSION)
+	}
+	if count := binary.BigEndian.Uint16(header[2:4]); count != 4 {
+		t.Errorf("got count %d want %d", count, 4)
+	}
+	if secs := binary.BigEndian.Uint32(header[8:12]); secs != m.ExportTime {
+		t.Errorf("got unix secs %x want %x", secs, m.ExportTime)
+	}
+	// The failed message is not counted
+	if seq := binary.BigEndian.Uint32(header[12:16]); seq != 0 {
+		t.Errorf("got sequence %d want %d", seq, 0)
+	}
+	if id := binary.BigEndian.Uint32(header[16:20]); id != 61166 {
+		t.Errorf("got source id %d want %d", id, 61166)
+	}
+
+	template := []uint8{
+		0x00, 0x00, 0x00, 0x14, // FlowSet ID 0, length 20
+		0x01, 0x00, 0x00, 0x03, // template 256, 3 fields
+		0x00, 0x02, 0x00, 0x08, // packetDeltaCount
+		0x01, 0xef, 0x00, 0x10, // srhActiveSegmentIPv6
+		0x9c, 0x41, 0x00, 0x01, // 29319.1 remapped
+	}
+	options := []uint8{
+		0x00, 0x01, 0x00, 0x14, // FlowSet ID 1, length 20 with the padding
+		0x01, 0x01, 0x00, 0x04, 0x00, 0x04, // template 257, scope and option lengths
+		0x00, 0x01, 0x00, 0x04, // System scope
+		0x00, 0x29, 0x00, 0x08, // exportedMessageTotalCount
+		0x00, 0x00, // padding
+	}
+	rest := got[HEADER_LEN:]
+	if !bytes.HasPrefix(rest, template) {
+		t.Fatalf("got %x want template flowset %x", rest, template)
+	}
+	rest = rest[len(template):]
+	if !bytes.HasPrefix(rest, options) {
+		t.Fatalf("got %x want options template flowset %x", rest, options)
+	}
+	rest = rest[len(options):]
+
+	data := ipfix.NewSet(256, []ipfix.Record{&ipfix.DataRecord{FieldValues: fvs}}).Serialize()
+	if !bytes.HasPrefix(rest, data) {
+		t.Errorf("got %x want data flowset %x", rest, data)
+	}
+
+	if _, err := e.AppendMessage(nil, m); err != nil {
+		t.Fatal(err)
+	}
+	next, _ := e.AppendMessage(nil, m)
+	if seq := binary.BigEndian.Uint32(next[12:16]); seq != 2 {
+		t.Errorf("got sequence %d want %d", seq, 2)
+	}
+}
+
+func TestEncoderFieldType(t *testing.T) {
+	e := NewEncoder()
+	if _, err := e.FieldType(*(&ipfix.SRHSegmentIPv6BasicList{}).FieldSpecifier()); err == nil {
+		t.Error("got no error for a variable-length field")
+	}
+	if _, err := e.ScopeFieldType(*(&ipfix.PacketDeltaCount{}).FieldSpecifier()); err == nil {
+		t.Error("got no error for a scope without a scope field type")
+	}
+	if ft, err := e.FieldType(*(&ipfix.PacketDeltaCount{}).FieldSpecifier()); err != nil || ft != ipfix.IEID_PACKET_DELTA_COUNT {
+		t.Errorf("got %d, %v", ft, err)
+	}
+}
@@ -45,8 +45,238 @@ static inline int parse_ioam6_trace_header(struct ioam6_trace_hdr *ith, int hdr_
     return 0;
 }
 
-SEC("xdp")
-int xdp_prog(struct xdp_md *ctx)
+static inline void count_lost(__u32 reason)
+{
+    __u64 *lost = bpf_map_lookup_elem(&probe_lost, &reason);
+    if (lost)
+        (*lost)++;
+}
+
+#define bob_mix(a, b, c)                                                                           \
+    {                                                                                              \
+        a -= b; a -= c; a ^= (c >> 13);                                                            \
+        b -= c; b -= a; b ^= (a << 8);                                                             \
+        c -= a; c -= b; c ^= (b >> 13);                                                            \
+        a -= b; a -= c; a ^= (c >> 12);                                                            \
+        b -= c; b -= a; b ^= (a << 16);                                                            \
+        c -= a; c -= b; c ^= (b >> 5);                                                             \
+        a -= b; a -= c; a ^= (c >> 3);                                                             \
+        b -= c; b -= a; b ^= (a << 10);                                                            \
+        c -= a; c -= b; c ^= (b >> 15);                                                            \
+    }
+
+static __always_inline __u32 bob_word(const __u8 *k)
+{
+    return k[0] + ((__u32)k[1] << 8) + ((__u32)k[2] << 16) + ((__u32)k[3] << 24);
+}
+
+// BOB hash function (RFC5475 A.2) of struct hash_input, whose size is a
+// multiple of 12 octets
+static __always_inline __u32 bob_hash(const struct hash_input *in, __u32 initval)
+{
+    const __u8 *k = (const __u8 *)in;
+    __u32 a = BOB_GOLDEN_RATIO, b = BOB_GOLDEN_RATIO, c = initval;
+    int i;
+
+#pragma clang loop unroll(full)
+    for (i = 0; i < sizeof(*in) / 12; i++) {
+        a += bob_word(k + 12 * i);
+        b += bob_word(k + 12 * i + 4);
+        c += bob_word(k + 12 * i + 8);
+        bob_mix(a, b, c);
+    }
+    c += sizeof(*in);
+    bob_mix(a, b, c);
+    return c;
+}
+
+static __always_inline int packet_digest(struct ipv6hdr *ipv6, struct srhhdr *srh, struct metadata *md,
+                                         __u32 initval, void *data_end, __u32 *digest)
+{
+    struct hash_input in = {};
+
+    if ((void *)(srh->segments + 1) > data_end)
+        return -1;
+
+    in.saddr = ipv6->saddr;
+    in.flow_lbl[1] = ipv6->flow_lbl[0] & 0x0f;
+    in.flow_lbl[2] = ipv6->flow_lbl[1];
+    in.flow_lbl[3] = ipv6->flow_lbl[2];
+    in.sent_second = bpf_htonl(md->sent_second);
+    in.sent_subsecond = bpf_htonl(md->sent_subsecond);
+    in.last_segment = srh->segments[0];
+    in.tag = srh->tag;
+    in.last_entry = srh->lastEntry;
+
+    *digest = bob_hash(&in, initval);
+    return 0;
+}
+
+static inline bool select_systematic(__u32 interval)
+{
+    struct sampling_count *sc;
+    __u32 key = 0;
+    bool ok;
+
+    if (interval <= 1)
+        return true;
+
+    sc = bpf_map_lookup_elem(&sampling_count, &key);
+    if (!sc)
+        return true;
+
+    bpf_spin_lock(&sc->lock);
+    ok = sc->count == 0;
+    if (++sc->count >= interval)
+        sc->count = 0;
+    bpf_spin_unlock(&sc->lock);
+    return ok;
+}
+
+static inline bool select_packet(struct xdp_config *cfg, struct ipv6hdr *ipv6, struct srhhdr *srh,
+                                 struct metadata *md, void *data_end)
+{
+    __u32 key = SAMPLING_STATS_OBSERVED;
+    __u32 interval = cfg->sampling_interval;
+    __u64 *observed, *selected;
+    __u32 digest;
+    bool ok = true;
+
+    observed = bpf_map_lookup_elem(&sampling_stats, &key);
+    if (!observed)
+        return true;
+
+    switch (cfg->selector_algorithm) {
+    case SELECTOR_ALGORITHM_SYSTEMATIC_COUNT:
+        ok = select_systematic(interval);
+        break;
+    case SELECTOR_ALGORITHM_UNIFORM_PROBABILISTIC:
+        ok = interval <= 1 || bpf_get_prandom_u32() % interval == 0;
+        break;
+    case SELECTOR_ALGORITHM_HASH_BOB:
+        ok = packet_digest(ipv6, srh, md, cfg->hash_initialiser, data_end, &digest) == 0 &&
+             digest >= cfg->hash_range_min && digest <= cfg->hash_range_max;
+        break;
+    }
+    (*observed)++;
+
+    if (ok) {
+        key = SAMPLING_STATS_SELECTED;
+        selected = bpf_map_lookup_elem(&sampling_stats, &key);
+        if (selected)
+            (*selected)++;
+    }
+    return ok;
+}
+
+static inline void update_probe_stats(struct probe_stats *stats, __s64 delay)
+{
+    stats->count++;
+    stats->delay_sum += delay;
+    if (delay < stats->delay_min)
+        stats->delay_min = delay;
+    if (delay > stats->delay_max)
+        stats->delay_max = delay;
+}
+
+static __always_inline void aggregate_probe(struct ethhdr *eth, struct ipv6hdr *ipv6, struct srhhdr *srh,
+                                            struct metadata *md, struct xdp_config *cfg, void *data_end)
+{
+    struct probe_key key = {};
+    struct probe_stats *stats;
+    __s64 delay;
+    int i;
+
+    __builtin_memcpy(key.h_source, eth->h_source, ETH_ALEN);
+    __builtin_memcpy(key.h_dest, eth->h_dest, ETH_ALEN);
+    key.v6_srcaddr = ipv6->saddr;
+    key.v6_dstaddr = ipv6->daddr;
+    key.next_hdr = srh->nextHdr;
+    key.hdr_ext_len = srh->hdrExtLen;
+    key.routing_type = srh->routingType;
+    key.segments_left = srh->segmentsLeft;
+    key.last_entry = srh->lastEntry;
+    key.flags = srh->flags;
+    key.tag = bpf_ntohs(srh->tag);
+
+    // Without an early exit, the trip count is constant and the loop unrolls
+#pragma clang loop unroll(full)
+    for (i = 0; i < MAX_SEGMENTLIST_ENTRIES; i++) {
+        if (i > srh->lastEntry || (void *)(srh->segments + i + 1) > data_end)
+            continue;
+        key.segments[i] = srh->segments[i];
+    }
+
+    delay = (__s64)md->received_nanosecond + cfg->boot_time_ns -
+            ((__s64)md->sent_second * NSEC_PER_SEC + md->sent_subsecond);
+
+    stats = bpf_map_lookup_elem(&probe_stats, &key);
+    if (stats) {
+        update_probe_stats(stats, delay);
+        return;
+    }
+
+    struct probe_stats init = {
+        .count = 1,
+        .delay_min = delay,
+        .delay_max = delay,
+        .delay_sum = delay,
+    };
+    if (bpf_map_update_elem(&probe_stats, &key, &init, BPF_NOEXIST) == 0)
+        return;
+
+    // Another CPU may have added the key in the meantime
+    stats = bpf_map_lookup_elem(&probe_stats, &key);
+    if (stats) {
+        update_probe_stats(stats, delay);
+        return;
+    }
+
+    count_lost(PROBE_LOST_STATS);
+}
+
+// Bytes of a packet sent to user space, the same for both transports
+static __always_inline __u32 capture_length(__u32 packet_len, __u32 snap_len)
+{
+    __u32 cap_len = packet_len;
+
+    if (snap_len && snap_len < cap_len)
+        cap_len = snap_len;
+    if (cap_len > MAX_SNAP_LEN)
+        cap_len = MAX_SNAP_LEN;
+    return cap_len;
+}
+
+static __always_inline void output_ringbuf(void *data, void *data_end, struct metadata *md, __u32 snap_len)
+{
+    struct probe_sample *sample;
+    __u32 packet_len = data_end - data;
+    __u32 cap_len = capture_length(packet_len, snap_len);
+    __u32 i;
+
+    sample = bpf_ringbuf_reserve(&packet_probe_ringbuf, sizeof(*sample), 0);
+    if (!sample) {
+        count_lost(PROBE_LOST_RINGBUF);
+        return;
+    }
+
+    sample->hdr.md = *md;
+    sample->hdr.packet_len = packet_len;
+    sample->hdr.cap_len = cap_len;
+    for (i = 0; i < MAX_SNAP_LEN; i++) {
+        if (i >= cap_len)
+            break;
+        if (data + i + 1 > data_end)
+            break;
+        sample->data[i] = *(__u8 *)(data + i);
+    }
+
+    bpf_ringbuf_submit(sample, 0);
+}
+
+// ringbuf is a constant so that each program only calls the helpers of
+// its transport, and xdp_prog still loads on kernels without ring buffers.
+static __always_inline int probe_packet(struct xdp_md *ctx, bool ringbuf)
 {
     void *data_end = (void *)(long)ctx->data_end;
     void *data = (void *)(long)ctx->data;
@@ -132,10 +362,48 @@ int xdp_prog(struct xdp_md *ctx)
     if (srh->routingType != IPV6_SRCRT_TYPE_4) // IPV6_SRCRT_TYPE_4 = SRH
         return -1;
 
-    __u64 flags = BPF_F_CURRENT_CPU | (packet_size << 32);
-    bpf_perf_event_output(ctx, &packet_probe_perf, flags, &md, sizeof(md));
+    __u32 zero = 0;
+    __u32 snap_len = 0;
+    struct xdp_config *cfg = bpf_map_lookup_elem(&xdp_config, &zero);
+    if (cfg) {
+        if (!select_packet(cfg, ipv6, srh, &md, data_end))
+            return XDP_PASS;
+        if (cfg->flags & XDP_CONFIG_F_AGGREGATE) {
+            aggregate_probe(eth, ipv6, srh, &md, cfg, data_end);
+            if (!(cfg->flags & XDP_CONFIG_F_REPORT))
+                return XDP_PASS;
+        }
+        snap_len = cfg->snap_len;
+    }
+
+    if (ringbuf) {
+        output_ringbuf(data, data_end, &md, snap_len);
+        return XDP_PASS;
+    }
+
+    struct probe_sample_header hdr = {
+        .md = md,
+        .packet_len = packet_size,
+        .cap_len = capture_length(packet_size, snap_len),
+    };
+    __u64 flags = BPF_F_CURRENT_CPU | ((__u64)hdr.cap_len << 32);
+    bpf_perf_event_output(ctx, &packet_probe_perf, flags, &hdr, sizeof(hdr));
 
     return XDP_PASS;
 }
 
+// Sends the probe packets as perf events
+SEC("xdp")
+int xdp_prog(struct xdp_md *ctx)
+{
+    return probe_packet(ctx, false);
+}
+
+// Sends the probe packets to packet_probe_ringbuf, needs Linux 5.8
+SEC("xdp")
+int xdp_prog_ringbuf(struct xdp_md *ctx)
+{
+    return probe_packet(ctx, true);
+}
+
 char _license[] SEC("license") = "Dual MIT/GPL";
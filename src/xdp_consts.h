@@ -8,6 +8,32 @@
 #define __XDP_CONSTS_H
 
 #define MAX_MAP_ENTRIES 1024
+#define MAX_PROBE_ENTRIES 65536
+#define MAX_SEGMENTLIST_ENTRIES 10
+#define MAX_SNAP_LEN 512 // bytes of a packet sent to user space
 #define IPPROTO_IPV6ROUTE 43
+#define NSEC_PER_SEC 1000000000LL
+
+// xdp_config flags
+#define XDP_CONFIG_F_AGGREGATE (1 << 0) // aggregate into probe_stats instead of sending packets
+#define XDP_CONFIG_F_REPORT (1 << 1)    // send the selected packets also when aggregating
+
+// xdp_config selector_algorithm, the values of selectorAlgorithm (RFC5477 8.2.1)
+#define SELECTOR_ALGORITHM_ALL 0 // every packet is selected
+#define SELECTOR_ALGORITHM_SYSTEMATIC_COUNT 1
+#define SELECTOR_ALGORITHM_UNIFORM_PROBABILISTIC 4
+#define SELECTOR_ALGORITHM_HASH_BOB 6
+
+#define BOB_GOLDEN_RATIO 0x9e3779b9
+
+// sampling_stats indexes
+#define SAMPLING_STATS_OBSERVED 0
+#define SAMPLING_STATS_SELECTED 1
+#define SAMPLING_STATS_MAX 2
+
+// probe_lost indexes
+#define PROBE_LOST_STATS 0   // probe_stats is full
+#define PROBE_LOST_RINGBUF 1 // packet_probe_ringbuf is full
+#define PROBE_LOST_MAX 2
 
 #endif
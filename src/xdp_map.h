@@ -19,4 +19,55 @@ struct
     __uint(max_entries, MAX_MAP_ENTRIES);
 } packet_probe_perf SEC(".maps");
 
+struct
+{
+    __uint(type, BPF_MAP_TYPE_PERCPU_HASH);
+    __uint(max_entries, MAX_PROBE_ENTRIES);
+    __type(key, struct probe_key);
+    __type(value, struct probe_stats);
+} probe_stats SEC(".maps");
+
+// Size is set by user space
+struct
+{
+    __uint(type, BPF_MAP_TYPE_RINGBUF);
+    __uint(max_entries, 256 * 1024);
+} packet_probe_ringbuf SEC(".maps");
+
+// Probe packets observed and selected by the sampling
+struct
+{
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(max_entries, SAMPLING_STATS_MAX);
+    __type(key, __u32);
+    __type(value, __u64);
+} sampling_stats SEC(".maps");
+
+// The systematic sampling counts the packets of all CPUs in one entry so that
+// 1 in sampling_interval packets is selected wherever they are processed
+struct
+{
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(max_entries, 1);
+    __type(key, __u32);
+    __type(value, struct sampling_count);
+} sampling_count SEC(".maps");
+
+// Probe packets lost for each PROBE_LOST_* reason
+struct
+{
+    __uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+    __uint(max_entries, PROBE_LOST_MAX);
+    __type(key, __u32);
+    __type(value, __u64);
+} probe_lost SEC(".maps");
+
+struct
+{
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(max_entries, 1);
+    __type(key, __u32);
+    __type(value, struct xdp_config);
+} xdp_config SEC(".maps");
+
 #endif
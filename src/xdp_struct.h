@@ -10,6 +10,7 @@
 #include <linux/types.h>
 #include <linux/if_ether.h>
 #include <linux/in6.h> /* For struct in6_addr. */
+#include <linux/bpf.h> /* For struct bpf_spin_lock. */
 #include "xdp_consts.h"
 
 // Segment Routing Extension Header (SRH)
@@ -33,4 +34,83 @@ struct metadata
     __u32 sent_subsecond;
 };
 
+// Header of the probe packets sent as perf events and to packet_probe_ringbuf
+struct probe_sample_header
+{
+    struct metadata md;
+    __u32 packet_len;
+    __u32 cap_len; // bytes of the packet following the header
+};
+
+// Record of packet_probe_ringbuf
+struct probe_sample
+{
+    struct probe_sample_header hdr;
+    __u8 data[MAX_SNAP_LEN];
+};
+
+// Key of the statistics aggregated in the kernel, the fields of
+// meter.ProbeData. Unused segments are zero.
+struct probe_key
+{
+    __u8 h_source[ETH_ALEN];
+    __u8 h_dest[ETH_ALEN];
+    struct in6_addr v6_srcaddr;
+    struct in6_addr v6_dstaddr;
+    __u8 next_hdr;
+    __u8 hdr_ext_len;
+    __u8 routing_type;
+    __u8 segments_left;
+    __u8 last_entry;
+    __u8 flags;
+    __u16 tag; // host byte order
+    struct in6_addr segments[MAX_SEGMENTLIST_ENTRIES];
+};
+
+// Delays are in nanoseconds
+struct probe_stats
+{
+    __u64 count;
+    __s64 delay_min;
+    __s64 delay_max;
+    __s64 delay_sum;
+};
+
+// Packets counted by SELECTOR_ALGORITHM_SYSTEMATIC_COUNT, shared by all CPUs
+struct sampling_count
+{
+    struct bpf_spin_lock lock;
+    __u32 count; // packets since the last selected one
+};
+
+// Set by user space
+struct xdp_config
+{
+    __u32 flags;
+    __u32 snap_len; // bytes of a packet sent to user space, 0 for the whole packet
+    __s64 boot_time_ns; // CLOCK_REALTIME at boot, the origin of bpf_ktime_get_ns
+    __u32 selector_algorithm;
+    __u32 sampling_interval; // 1 in sampling_interval packets is selected
+    // SELECTOR_ALGORITHM_HASH_BOB selects the digests in [hash_range_min, hash_range_max]
+    __u32 hash_initialiser;
+    __u32 hash_range_min;
+    __u32 hash_range_max;
+    __u32 reserved;
+};
+
+// Fields of a probe packet hashed by SELECTOR_ALGORITHM_HASH_BOB, which are
+// invariant along the SR policy so that every node selects the same packets
+// (RFC5475 6.2.3). Multi-octet fields are in network byte order.
+struct hash_input
+{
+    struct in6_addr saddr;
+    __u8 flow_lbl[4];   // 0, then the 20 bits of the flow label
+    __u32 sent_second;  // of the IOAM trace
+    __u32 sent_subsecond;
+    struct in6_addr last_segment; // segments[0], the end of the SR policy
+    __u16 tag;
+    __u8 last_entry;
+    __u8 reserved;
+};
+
 #endif
@@ -7,7 +7,6 @@ package main
 
 import (
 	"log"
-	"net"
 	"net/netip"
 
 	"github.com/nttcom/fluvia/pkg/client"
@@ -16,13 +15,9 @@ import (
 
 func main() {
 	flowChan := make(chan []ipfix.FieldValue)
-	raddr, err := net.ResolveUDPAddr("udp", "127.0.0.1:4739")
-	if err != nil {
-		log.Panic(err)
-	}
-	e := client.NewExporter()
+	e := client.NewExporter(client.ExporterOptions{})
 	go func() {
-		err := e.Run(raddr, flowChan)
+		err := e.Run("127.0.0.1:4739", flowChan)
 		if err != nil {
 			log.Panic(err)
 		}
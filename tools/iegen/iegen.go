@@ -0,0 +1,524 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+// iegen generates the typed field values of the Information Elements in the
+// IANA registry (https://www.iana.org/assignments/ipfix/ipfix-information-elements.csv)
+// for pkg/ipfix. Information Elements whose field value is written by hand in
+// the package, i.e. an ElementID method returns their IEID_ constant, are
+// only added to the registry table.
+//
+//	go run ./tools/iegen -i pkg/ipfix/ipfix-information-elements.csv -o pkg/ipfix/iana_field_value.go
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// dataType is how a field value of an abstract data type is generated.
+type dataType struct {
+	constant string
+	goType   string
+	length   int // 0 for variable length
+	// Whether the field value may be encoded in fewer octets (RFC7011 6.2)
+	reducible bool
+	// Expressions of fv.Val appended to dst and of the decoded data, of which
+	// the variable-length types have no append expression
+	appendTo string
+	decode   string
+	imports  []string
+}
+
+var dataTypes = map[string]dataType{
+	"octetArray": {
+		constant: "DATA_TYPE_OCTET_ARRAY", goType: "[]uint8",
+		decode: "value",
+	},
+	"unsigned8": {
+		constant: "DATA_TYPE_UNSIGNED8", goType: "uint8", length: 1, reducible: true,
+		appendTo: "appendUnsigned(dst, uint64(fv.Val), fv.Len())", decode: "uint8(decodeUnsigned(data))",
+	},
+	"unsigned16": {
+		constant: "DATA_TYPE_UNSIGNED16", goType: "uint16", length: 2, reducible: true,
+		appendTo: "appendUnsigned(dst, uint64(fv.Val), fv.Len())", decode: "uint16(decodeUnsigned(data))",
+	},
+	"unsigned32": {
+		constant: "DATA_TYPE_UNSIGNED32", goType: "uint32", length: 4, reducible: true,
+		appendTo: "appendUnsigned(dst, uint64(fv.Val), fv.Len())", decode: "uint32(decodeUnsigned(data))",
+	},
+	"unsigned64": {
+		constant: "DATA_TYPE_UNSIGNED64", goType: "uint64", length: 8, reducible: true,
+		appendTo: "appendUnsigned(dst, fv.Val, fv.Len())", decode: "decodeUnsigned(data)",
+	},
+	"signed8": {
+		constant: "DATA_TYPE_SIGNED8", goType: "int8", length: 1, reducible: true,
+		appendTo: "appendSigned(dst, int64(fv.Val), fv.Len())", decode: "int8(decodeSigned(data))",
+	},
+	"signed16": {
+		constant: "DATA_TYPE_SIGNED16", goType: "int16", length: 2, reducible: true,
+		appendTo: "appendSigned(dst, int64(fv.Val), fv.Len())", decode: "int16(decodeSigned(data))",
+	},
+	"signed32": {
+		constant: "DATA_TYPE_SIGNED32", goType: "int32", length: 4, reducible: true,
+		appendTo: "appendSigned(dst, int64(fv.Val), fv.Len())", decode: "int32(decodeSigned(data))",
+	},
+	"signed64": {
+		constant: "DATA_TYPE_SIGNED64", goType: "int64", length: 8, reducible: true,
+		appendTo: "appendSigned(dst, fv.Val, fv.Len())", decode: "decodeSigned(data)",
+	},
+	"float32": {
+		constant: "DATA_TYPE_FLOAT32", goType: "float32", length: 4,
+		appendTo: "binary.BigEndian.AppendUint32(dst, math.Float32bits(fv.Val))", decode: "math.Float32frombits(binary.BigEndian.Uint32(data))",
+		imports: []string{"encoding/binary", "math"},
+	},
+	"float64": {
+		constant: "DATA_TYPE_FLOAT64", goType: "float64", length: 8, reducible: true,
+		appendTo: "appendFloat64(dst, fv.Val, fv.Len())", decode: "decodeFloat64(data)",
+	},
+	"boolean": {
+		constant: "DATA_TYPE_BOOLEAN", goType: "bool", length: 1,
+		appendTo: "appendBoolean(dst, fv.Val)", decode: "decodeBoolean(data)",
+	},
+	"macAddress": {
+		constant: "DATA_TYPE_MAC_ADDRESS", goType: "net.HardwareAddr", length: 6,
+		appendTo: "appendMacAddress(dst, fv.Val)", decode: "decodeMacAddress(data)",
+		imports: []string{"net"},
+	},
+	"string": {
+		constant: "DATA_TYPE_STRING", goType: "string",
+		decode: "string(value)",
+	},
+	"dateTimeSeconds": {
+		constant: "DATA_TYPE_DATE_TIME_SECONDS", goType: "time.Time", length: 4,
+		appendTo: "appendDateTimeSeconds(dst, fv.Val)", decode: "decodeDateTimeSeconds(data)",
+		imports: []string{"time"},
+	},
+	"dateTimeMilliseconds": {
+		constant: "DATA_TYPE_DATE_TIME_MILLISECONDS", goType: "time.Time", length: 8,
+		appendTo: "appendDateTimeMilliseconds(dst, fv.Val)", decode: "decodeDateTimeMilliseconds(data)",
+		imports: []string{"time"},
+	},
+	"dateTimeMicroseconds": {
+		constant: "DATA_TYPE_DATE_TIME_MICROSECONDS", goType: "time.Time", length: 8,
+		appendTo: "appendDateTimeMicroseconds(dst, fv.Val)", decode: "decodeDateTimeMicroseconds(data)",
+		imports: []string{"time"},
+	},
+	"dateTimeNanoseconds": {
+		constant: "DATA_TYPE_DATE_TIME_NANOSECONDS", goType: "time.Time", length: 8,
+		appendTo: "appendDateTimeNanoseconds(dst, fv.Val)", decode: "decodeDateTimeNanoseconds(data)",
+		imports: []string{"time"},
+	},
+	"ipv4Address": {
+		constant: "DATA_TYPE_IPV4_ADDRESS", goType: "netip.Addr", length: 4,
+		appendTo: "appendIPv4Address(dst, fv.Val)", decode: "netip.AddrFrom4([4]uint8(data))",
+		imports: []string{"net/netip"},
+	},
+	"ipv6Address": {
+		constant: "DATA_TYPE_IPV6_ADDRESS", goType: "netip.Addr", length: 16,
+		appendTo: "appendIPv6Address(dst, fv.Val)", decode: "netip.AddrFrom16([16]uint8(data))",
+		imports: []string{"net/netip"},
+	},
+	// The structured data delegate to the generic field values
+	"basicList":            {constant: "DATA_TYPE_BASIC_LIST", goType: "BasicList"},
+	"subTemplateList":      {constant: "DATA_TYPE_SUB_TEMPLATE_LIST", goType: "SubTemplateList"},
+	"subTemplateMultiList": {constant: "DATA_TYPE_SUB_TEMPLATE_MULTI_LIST", goType: "SubTemplateMultiList"},
+}
+
+var semantics = map[string]string{
+	"":             "DATA_TYPE_SEMANTICS_DEFAULT",
+	"default":      "DATA_TYPE_SEMANTICS_DEFAULT",
+	"quantity":     "DATA_TYPE_SEMANTICS_QUANTITY",
+	"totalCounter": "DATA_TYPE_SEMANTICS_TOTAL_COUNTER",
+	"deltaCounter": "DATA_TYPE_SEMANTICS_DELTA_COUNTER",
+	"identifier":   "DATA_TYPE_SEMANTICS_IDENTIFIER",
+	"flags":        "DATA_TYPE_SEMANTICS_FLAGS",
+	"list":         "DATA_TYPE_SEMANTICS_LIST",
+	"snmpCounter":  "DATA_TYPE_SEMANTICS_SNMP_COUNTER",
+	"snmpGauge":    "DATA_TYPE_SEMANTICS_SNMP_GAUGE",
+}
+
+type element struct {
+	ID         uint16
+	IDExpr     string // IEID_ constant or the ID
+	Name       string
+	TypeName   string // Go type, empty if written by hand
+	DataType   string
+	Semantics  string
+	Units      string
+	Deprecated bool
+	dataType
+}
+
+func (e element) GoType() string     { return e.goType }
+func (e element) Length() int        { return e.length }
+func (e element) Reducible() bool    { return e.reducible }
+func (e element) AppendExpr() string { return e.appendTo }
+func (e element) DecodeExpr() string { return e.decode }
+func (e element) Constant() string   { return e.constant }
+func (e element) SemanticsConstant() string {
+	return semantics[e.Semantics]
+}
+
+func (e element) IsList() bool {
+	return strings.HasSuffix(e.DataType, "List")
+}
+
+// Doc is the description in the doc comment of the type.
+func (e element) Doc() string {
+	doc := e.DataType
+	if e.Semantics != "" && e.Semantics != "default" {
+		doc += " " + e.Semantics
+	}
+	if e.Units != "" {
+		doc += " in " + e.Units
+	}
+	return doc
+}
+
+// pkg is what iegen needs to know of the package being generated.
+type pkg struct {
+	name        string
+	constants   map[uint16]string // IEID_ constants by value
+	handWritten map[uint16]bool   // IEs with a field value written by hand
+	declared    map[string]bool   // package-level identifiers
+}
+
+func parsePackage(dir, output string) (*pkg, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pkg{
+		constants:   make(map[uint16]string),
+		handWritten: make(map[uint16]bool),
+		declared:    make(map[string]bool),
+	}
+	values := make(map[string]uint16)
+	var returned []string
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || filepath.Base(file) == filepath.Base(output) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		p.name = f.Name.Name
+
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						p.declared[spec.Name.Name] = true
+					case *ast.ValueSpec:
+						for i, name := range spec.Names {
+							p.declared[name.Name] = true
+							if decl.Tok != token.CONST || !strings.HasPrefix(name.Name, "IEID_") || i >= len(spec.Values) {
+								continue
+							}
+							lit, ok := spec.Values[i].(*ast.BasicLit)
+							if !ok || lit.Kind != token.INT {
+								continue
+							}
+							v, err := strconv.ParseUint(lit.Value, 0, 16)
+							if err != nil {
+								return nil, err
+							}
+							values[name.Name] = uint16(v)
+							// Either constant would export the other element
+							if other, ok := p.constants[uint16(v)]; ok {
+								return nil, fmt.Errorf("%s and %s are both %d", other, name.Name, v)
+							}
+							p.constants[uint16(v)] = name.Name
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					p.declared[decl.Name.Name] = true
+					continue
+				}
+				if decl.Name.Name != "ElementID" || decl.Body == nil {
+					continue
+				}
+				ast.Inspect(decl.Body, func(n ast.Node) bool {
+					if ret, ok := n.(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+						if id, ok := ret.Results[0].(*ast.Ident); ok && strings.HasPrefix(id.Name, "IEID_") {
+							returned = append(returned, id.Name)
+						}
+					}
+					return true
+				})
+			}
+		}
+	}
+
+	for _, name := range returned {
+		v, ok := values[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown constant %s returned by ElementID", name)
+		}
+		p.handWritten[v] = true
+	}
+	return p, nil
+}
+
+// readRegistry reads the Information Elements of the IANA registry CSV,
+// except for the reserved and unassigned ranges.
+func readRegistry(r io.Reader, p *pkg) ([]element, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[name] = i
+	}
+	// The column has been renamed in the registry
+	if _, ok := columns["Abstract Data Type"]; !ok {
+		columns["Abstract Data Type"] = columns["Data Type"]
+	}
+	for _, name := range []string{"ElementID", "Name", "Abstract Data Type", "Data Type Semantics", "Status", "Units"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("no column %s", name)
+		}
+	}
+
+	var elements []element
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		id, err := strconv.ParseUint(record[columns["ElementID"]], 10, 16)
+		if err != nil {
+			continue // range of IDs
+		}
+		adt := strings.TrimSpace(record[columns["Abstract Data Type"]])
+		if adt == "" {
+			continue // reserved
+		}
+
+		e := element{
+			ID:         uint16(id),
+			IDExpr:     strconv.FormatUint(id, 10),
+			Name:       strings.TrimSpace(record[columns["Name"]]),
+			DataType:   adt,
+			Semantics:  strings.TrimSpace(record[columns["Data Type Semantics"]]),
+			Units:      strings.TrimSpace(record[columns["Units"]]),
+			Deprecated: strings.TrimSpace(record[columns["Status"]]) == "deprecated",
+		}
+		var ok bool
+		if e.dataType, ok = dataTypes[adt]; !ok {
+			return nil, fmt.Errorf("%s: unknown abstract data type %s", e.Name, adt)
+		}
+		if _, ok := semantics[e.Semantics]; !ok {
+			return nil, fmt.Errorf("%s: unknown data type semantics %s", e.Name, e.Semantics)
+		}
+		if name, ok := p.constants[e.ID]; ok {
+			e.IDExpr = name
+		}
+		if !p.handWritten[e.ID] {
+			e.TypeName = strings.ToUpper(e.Name[:1]) + e.Name[1:]
+			if p.declared[e.TypeName] {
+				return nil, fmt.Errorf("%s: %s is already declared in package %s", e.Name, e.TypeName, p.name)
+			}
+		}
+		elements = append(elements, e)
+	}
+	return elements, nil
+}
+
+var code = template.Must(template.New("code").Parse(`// Code generated by iegen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{range .Elements}}{{if .TypeName}}
+// {{.TypeName}} is {{.Name}} ({{.ID}}), {{.Doc}}.
+{{- if .Deprecated}}
+//
+// Deprecated: {{.Name}} is deprecated in the IANA registry.
+{{- end}}
+type {{.TypeName}} struct {
+	Val {{.GoType}}
+{{- if .Reducible}}
+	// Length of the reduced-size encoding (RFC7011 6.2), or zero for {{.Length}}-octet encodings
+	Length uint16
+{{- end}}
+}
+
+func (fv *{{.TypeName}}) ElementID() uint16 {
+	return {{.IDExpr}}
+}
+
+func (fv *{{.TypeName}}) Serialize() []uint8 {
+	return fv.AppendTo(make([]uint8, 0, fv.Len()))
+}
+{{if .IsList}}
+func (fv *{{.TypeName}}) AppendTo(dst []uint8) []uint8 {
+	return fv.Val.AppendTo(dst)
+}
+
+func (fv *{{.TypeName}}) DecodeFromBytes(data []uint8) error {
+	return fv.Val.DecodeFromBytes(data)
+}
+
+func (fv *{{.TypeName}}) Len() uint16 {
+	return fv.Val.Len()
+}
+{{else if .Reducible}}
+func (fv *{{.TypeName}}) AppendTo(dst []uint8) []uint8 {
+	return {{.AppendExpr}}
+}
+
+func (fv *{{.TypeName}}) DecodeFromBytes(data []uint8) error {
+	if err := checkReducedSize(data, {{.Constant}}); err != nil {
+		return err
+	}
+	fv.Val = {{.DecodeExpr}}
+	fv.Length = reducedSize(data, {{.Constant}})
+	return nil
+}
+
+func (fv *{{.TypeName}}) Len() uint16 {
+	return {{.Constant}}.reducedLength(fv.Length)
+}
+{{else if .Length}}
+func (fv *{{.TypeName}}) AppendTo(dst []uint8) []uint8 {
+	return {{.AppendExpr}}
+}
+
+func (fv *{{.TypeName}}) DecodeFromBytes(data []uint8) error {
+	if err := checkFieldLength(data, {{.Length}}); err != nil {
+		return err
+	}
+	fv.Val = {{.DecodeExpr}}
+	return nil
+}
+
+func (fv *{{.TypeName}}) Len() uint16 {
+	return {{.Length}}
+}
+{{else}}
+func (fv *{{.TypeName}}) AppendTo(dst []uint8) []uint8 {
+	dst = appendVariableLengthPrefix(dst, len(fv.Val))
+	return append(dst, fv.Val...)
+}
+
+func (fv *{{.TypeName}}) DecodeFromBytes(data []uint8) error {
+	value, err := decodeVariableLengthField(data)
+	if err != nil {
+		return err
+	}
+	fv.Val = {{.DecodeExpr}}
+	return nil
+}
+
+func (fv *{{.TypeName}}) Len() uint16 {
+	return VariableLengthLen(len(fv.Val))
+}
+{{end}}
+func (fv *{{.TypeName}}) FieldSpecifier() *FieldSpecifier {
+	templateLen := {{if .Length}}fv.Len(){{else}}VARIABLE_LENGTH{{end}}
+	fs := NewFieldSpecifier(false, fv.ElementID(), templateLen, 0)
+	return fs
+}
+{{end}}{{end}}
+var ianaFieldValueTypes = map[uint16]func() FieldValue{
+{{- range .Elements}}{{if .TypeName}}
+	{{.IDExpr}}: func() FieldValue { return &{{.TypeName}}{} },
+{{- end}}{{end}}
+}
+
+var ianaInformationElements = map[uint16]InformationElement{
+{{- range .Elements}}
+	{{.IDExpr}}: {Name: "{{.Name}}", ElementID: {{.IDExpr}}, DataType: {{.Constant}}, Semantics: {{.SemanticsConstant}},
+		{{- if .Units}} Units: {{printf "%q" .Units}},{{end}} Length: {{if .Length}}{{.Length}}{{else}}VARIABLE_LENGTH{{end}}
+		{{- if .Deprecated}}, Deprecated: true{{end}}},
+{{- end}}
+}
+`))
+
+func main() {
+	input := flag.String("i", "ipfix-information-elements.csv", "Specify the IANA registry CSV")
+	output := flag.String("o", "iana_field_value.go", "Specify the output file")
+	flag.Parse()
+
+	p, err := parsePackage(filepath.Dir(*output), *output)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	f, err := os.Open(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	elements, err := readRegistry(f, p)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	importSet := make(map[string]bool)
+	for _, e := range elements {
+		if e.TypeName == "" {
+			continue
+		}
+		for _, i := range e.imports {
+			importSet[i] = true
+		}
+	}
+	var imports []string
+	for i := range importSet {
+		imports = append(imports, i)
+	}
+	sort.Strings(imports)
+
+	var buf bytes.Buffer
+	err = code.Execute(&buf, map[string]any{
+		"Package":  p.name,
+		"Imports":  imports,
+		"Elements": elements,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format generated code: %v", err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
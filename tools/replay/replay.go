@@ -0,0 +1,39 @@
+// Copyright (c) 2023 NTT Communications Corporation
+//
+// This software is released under the MIT License.
+// see https://github.com/nttcom/fluvia/blob/main/LICENSE
+
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"github.com/nttcom/fluvia/pkg/client"
+)
+
+func main() {
+	address := flag.String("a", "127.0.0.1:4739", "Specify a collector address")
+	transport := flag.String("t", "udp", "Specify a transport (udp or tcp)")
+	mtu := flag.Int("m", client.DEFAULT_MTU, "Specify the MTU, messages exceeding it are skipped over udp")
+	flag.Parse()
+
+	for _, name := range flag.Args() {
+		conn, err := net.Dial(*transport, *address)
+		if err != nil {
+			log.Panic(err)
+		}
+
+		// Each file is replayed on its own Transport Session
+		n, err := client.ReplayFile(name, conn, *mtu)
+		if err != nil {
+			log.Panic(err)
+		}
+		log.Printf("Replayed %d messages from %s", n, name)
+
+		if err := conn.Close(); err != nil {
+			log.Printf("failed to close connection: %v", err)
+		}
+	}
+}